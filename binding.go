@@ -0,0 +1,149 @@
+package cuto
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+// 绑定名称已被使用
+var ErrBindingExists = errors.New("cuto: binding already exists")
+
+// BindingFunc is a Go function exposed to the page, it receives the JSON
+// of the arguments and its result is sent back as JSON.
+type BindingFunc func(args ...json.RawMessage) (interface{}, error)
+
+type bindings struct {
+	mu  sync.Mutex
+	fns map[string]BindingFunc
+}
+
+// bindingScript replaces the raw binding, which only takes a string, with
+// a function that sends its arguments as JSON and returns a Promise of
+// the result delivered by deliverScript.
+const bindingScript = `(name => {
+	const binding = window[name];
+	if (typeof binding !== 'function' || binding.__cuto) return;
+	const callbacks = new Map();
+	let seq = 0;
+	const fn = (...args) => new Promise((resolve, reject) => {
+		const id = ++seq;
+		callbacks.set(id, {resolve, reject});
+		binding(JSON.stringify({id, args}));
+	});
+	fn.__cuto = (id, result, error) => {
+		const callback = callbacks.get(id);
+		if (!callback) return;
+		callbacks.delete(id);
+		if (error === undefined) callback.resolve(result);
+		else callback.reject(new Error(error));
+	};
+	window[name] = fn;
+})(%q)`
+
+// deliverScript settles the Promise of a call.
+const deliverScript = `function(name, id, result, error){ window[name].__cuto(id, result, error); }`
+
+// 暴露Go函数给页面，页面中调用window[name](...args)返回Promise，
+// 参数以JSON传给fn，fn的结果以JSON返回，出错时Promise被拒绝
+func (tab *Tab) Expose(name string, fn BindingFunc) error {
+	if _, err := tab.MainFrame(); err != nil {
+		return err
+	}
+	tab.bindings.mu.Lock()
+	if _, ok := tab.bindings.fns[name]; ok {
+		tab.bindings.mu.Unlock()
+		return fmt.Errorf("%w %s", ErrBindingExists, name)
+	}
+	if tab.bindings.fns == nil {
+		tab.bindings.fns = make(map[string]BindingFunc)
+		tab.On(runtime.BindingCalledEvent, tab.bindingCalled)
+	}
+	tab.bindings.fns[name] = fn
+	tab.bindings.mu.Unlock()
+	if err := tab.addBinding(name); err != nil {
+		tab.bindings.mu.Lock()
+		delete(tab.bindings.fns, name)
+		tab.bindings.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// addBinding adds the binding and installs its wrapper in new documents
+// and the documents of the tab's own process.
+func (tab *Tab) addBinding(name string) error {
+	ctx, cancel := tab.context()
+	defer cancel()
+	if _, err := Do(ctx, tab, runtime.AddBindingCommand, runtime.AddBindingParams{Name: name}); err != nil {
+		return err
+	}
+	script := fmt.Sprintf(bindingScript, name)
+	if _, err := Do(ctx, tab, page.AddScriptToEvaluateOnNewDocumentCommand, page.AddScriptToEvaluateOnNewDocumentParams{Source: script}); err != nil {
+		return err
+	}
+	// 已加载的文档
+	frames, err := tab.Frames()
+	if err != nil {
+		return err
+	}
+	for _, f := range frames {
+		tab.frames.mu.Lock()
+		sid := f.session
+		tab.frames.mu.Unlock()
+		if sid != "" {
+			continue
+		}
+		if err := f.Evaluate(script, nil); err != nil && f.Parent() == nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindingCalled runs the function of a call on its own goroutine, the
+// page waits for the result while the events keep flowing.
+func (tab *Tab) bindingCalled(params json.RawMessage) {
+	var called runtime.BindingCalledParams
+	if err := json.Unmarshal(params, &called); err != nil {
+		return
+	}
+	tab.bindings.mu.Lock()
+	fn, ok := tab.bindings.fns[called.Name]
+	tab.bindings.mu.Unlock()
+	if !ok {
+		return
+	}
+	var payload struct {
+		Id   int               `json:"id"`
+		Args []json.RawMessage `json:"args"`
+	}
+	// 页面直接调用原始绑定时不是我们的格式
+	if err := json.Unmarshal([]byte(called.Payload), &payload); err != nil || payload.Id == 0 {
+		return
+	}
+	go func() {
+		var args = []interface{}{called.Name, payload.Id, nil, nil}
+		result, err := fn(payload.Args...)
+		if err == nil {
+			var value json.RawMessage
+			if value, err = json.Marshal(result); err == nil {
+				args[2] = value
+			}
+		}
+		if err != nil {
+			args[3] = err.Error()
+		}
+		ctx, cancel := tab.context()
+		defer cancel()
+		_, _ = Do(ctx, tab, runtime.CallFunctionOnCommand, runtime.CallFunctionOnParams{
+			FunctionDeclaration: deliverScript,
+			Arguments:           callArguments(args),
+			ExecutionContextId:  called.ExecutionContextId,
+		})
+	}()
+}
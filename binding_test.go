@@ -0,0 +1,81 @@
+package cuto
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestExpose(t *testing.T) {
+	var delivered = make(chan []interface{}, 2)
+	var methods = make(chan string, 8)
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case runtime.AddBinding:
+			methods <- method + " " + params["name"].(string)
+		case page.AddScriptToEvaluateOnNewDocument:
+			if !strings.Contains(params["source"].(string), `"fixture"`) {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			methods <- method
+		case runtime.Evaluate:
+			methods <- method
+			// 页面调用绑定
+			emit(runtime.BindingCalledEvent, map[string]interface{}{"name": "fixture", "executionContextId": 1, "payload": `{"id":1,"args":["user",2]}`})
+			emit(runtime.BindingCalledEvent, map[string]interface{}{"name": "fixture", "executionContextId": 1, "payload": `{"id":2,"args":[]}`})
+			emit(runtime.BindingCalledEvent, map[string]interface{}{"name": "fixture", "executionContextId": 1, "payload": "raw"})
+		case runtime.CallFunctionOn:
+			if params["executionContextId"] != float64(1) {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			var args []interface{}
+			for _, arg := range params["arguments"].([]interface{}) {
+				args = append(args, arg.(map[string]interface{})["value"])
+			}
+			delivered <- args
+		}
+		return map[string]interface{}{}, nil
+	}))
+	fixture := func(args ...json.RawMessage) (interface{}, error) {
+		if len(args) == 0 {
+			return nil, errors.New("no fixture")
+		}
+		var name string
+		if err := json.Unmarshal(args[0], &name); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"name": name, "count": len(args)}, nil
+	}
+	if err := tab.Expose("fixture", fixture); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{runtime.AddBinding + " fixture", page.AddScriptToEvaluateOnNewDocument, runtime.Evaluate} {
+		if method := <-methods; method != expected {
+			t.Errorf("sent %s, expected %s", method, expected)
+		}
+	}
+	var results = make(map[float64][]interface{})
+	for i := 0; i < 2; i++ {
+		select {
+		case args := <-delivered:
+			results[args[1].(float64)] = args
+		case <-time.After(time.Second):
+			t.Fatal("no result delivered")
+		}
+	}
+	if expected := []interface{}{"fixture", float64(1), map[string]interface{}{"name": "user", "count": float64(2)}, nil}; !reflect.DeepEqual(results[1], expected) {
+		t.Errorf("delivered %v", results[1])
+	}
+	if expected := []interface{}{"fixture", float64(2), nil, "no fixture"}; !reflect.DeepEqual(results[2], expected) {
+		t.Errorf("delivered %v", results[2])
+	}
+	if err := tab.Expose("fixture", fixture); !errors.Is(err, ErrBindingExists) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
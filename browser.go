@@ -27,8 +27,14 @@ type Browser struct {
 	timeout time.Duration
 	// debug flag
 	debug bool
+	// validate params against the protocol schema before sending
+	validate bool
+	// interceptors of commands and events
+	interceptors []Interceptor
 	// headless
 	commands []string
+	// scripts added to every page
+	scripts initScripts
 }
 
 // NewBrowser chrome client
@@ -77,7 +83,7 @@ func NewBrowser(options ...Option) (*Browser, error) {
 	}
 	_ = resp.Body.Close()
 
-	var s = make(chan os.Signal)
+	var s = make(chan os.Signal, 1)
 	go func() {
 		signal.Notify(s, os.Interrupt, syscall.SIGQUIT, syscall.SIGTERM)
 		<-s
@@ -96,7 +102,7 @@ func (b *Browser) Open(url string) (*Tab, error) {
 	}
 	defer r.Body.Close()
 	tab := new(Tab)
-	if err := tab.init(r.Body, b.debug); err != nil {
+	if err := tab.init(r.Body, b); err != nil {
 		return nil, err
 	}
 	return tab, nil
@@ -107,6 +113,11 @@ func (b *Browser) Close() error {
 	defer func() {
 		_ = os.RemoveAll(b.dataDir)
 	}()
+	b.scripts.mu.Lock()
+	if b.scripts.conn != nil {
+		_ = b.scripts.conn.Channel.Close()
+	}
+	b.scripts.mu.Unlock()
 	return b.process.Kill()
 }
 
@@ -123,9 +134,9 @@ func (b *Browser) Find(kw string) (*Tab, error) {
 	}
 	for _, tab := range tabs {
 		if strings.Contains(tab.Url, kw) || strings.Contains(tab.Id, kw) || strings.Contains(tab.Title, kw) {
-			tab.Channel.events = make(chan []byte, 1024)
-			tab.Channel.returns = make(chan []byte, 1024)
-			tab.Channel.errors = make(chan []byte, 1024)
+			if err := tab.connect(b); err != nil {
+				return nil, err
+			}
 			return tab, nil
 		}
 	}
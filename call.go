@@ -0,0 +1,22 @@
+package cuto
+
+import (
+	"context"
+
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+// Call sends method with params to tab and decodes the result into R.
+// It works for any command of the protocol, including ones without helpers.
+func Call[P, R any](ctx context.Context, tab *Tab, method string, params P) (R, error) {
+	var result R
+	err := tab.call(ctx, method, params, &result)
+	return result, err
+}
+
+// Do runs a generated command descriptor, e.g.
+//
+//	result, err := cuto.Do(ctx, tab, page.NavigateCommand, page.NavigateParams{Url: url})
+func Do[P, R any](ctx context.Context, tab *Tab, cmd cdp.Command[P, R], params P) (R, error) {
+	return Call[P, R](ctx, tab, cmd.Method, params)
+}
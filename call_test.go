@@ -0,0 +1,131 @@
+package cuto
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+	"github.com/gorilla/websocket"
+)
+
+// handler of the fake DevTools endpoint, emit sends an event before the
+// response of the command.
+type handler func(emit func(method string, params interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError)
+
+// sessionHandler is a handler also receiving the session of the command,
+// empty for the root session.
+type sessionHandler func(emit func(method string, params interface{}), session, method string, params map[string]interface{}) (interface{}, *RemoteError)
+
+// devtools serves a fake DevTools endpoint answering every command with
+// the result returned by handler.
+func devtools(t *testing.T, b *Browser, handler handler) *Tab {
+	return devtoolsSessions(t, b, func(emit func(string, interface{}), session, method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		return handler(emit, method, params)
+	})
+}
+
+// mainFrameHandler answers the commands tracking the frames of a page with
+// a single main frame "main", whose main world is execution context 1 and
+// whose isolated world is 2. The other commands are passed to next.
+func mainFrameHandler(next handler) handler {
+	return func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case page.GetFrameTree:
+			return map[string]interface{}{"frameTree": map[string]interface{}{
+				"frame": map[string]interface{}{"id": "main", "url": "https://www.baidu.com/"},
+			}}, nil
+		case runtime.Enable:
+			emit(runtime.ExecutionContextCreatedEvent, map[string]interface{}{"context": map[string]interface{}{"id": 1, "auxData": map[string]interface{}{"frameId": "main", "isDefault": true}}})
+			return map[string]interface{}{}, nil
+		case page.CreateIsolatedWorld:
+			return map[string]interface{}{"executionContextId": 2}, nil
+		}
+		return next(emit, method, params)
+	}
+}
+
+// devtoolsSessions serves a fake DevTools endpoint with child sessions,
+// events emitted while handling a command belong to its session.
+func devtoolsSessions(t *testing.T, b *Browser, handler sessionHandler) *Tab {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var mu sync.Mutex
+		for {
+			var request struct {
+				Id        int
+				SessionId string
+				Method    string
+				Params    map[string]interface{}
+			}
+			if err := conn.ReadJSON(&request); err != nil {
+				return
+			}
+			emit := func(method string, params interface{}) {
+				mu.Lock()
+				defer mu.Unlock()
+				var event = map[string]interface{}{"method": method, "params": params}
+				if request.SessionId != "" {
+					event["sessionId"] = request.SessionId
+				}
+				_ = conn.WriteJSON(event)
+			}
+			result, remoteErr := handler(emit, request.SessionId, request.Method, request.Params)
+			var response = map[string]interface{}{"id": request.Id}
+			if request.SessionId != "" {
+				response["sessionId"] = request.SessionId
+			}
+			if remoteErr != nil {
+				response["error"] = remoteErr
+			} else {
+				response["result"] = result
+			}
+			mu.Lock()
+			err = conn.WriteJSON(response)
+			mu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	tab := &Tab{WebSocketDebuggerUrl: "ws" + strings.TrimPrefix(server.URL, "http")}
+	if err := tab.connect(b); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tab.Channel.Close() })
+	return tab
+}
+
+func TestCall(t *testing.T) {
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		if method != page.Navigate {
+			return map[string]interface{}{}, nil
+		}
+		if params["url"] == "" {
+			return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+		}
+		return map[string]interface{}{"frameId": "main", "loaderId": "loader"}, nil
+	})
+	result, err := Do(context.Background(), tab, page.NavigateCommand, page.NavigateParams{Url: "https://www.baidu.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.FrameId != "main" || result.LoaderId != "loader" {
+		t.Errorf("unexpected result %+v", result)
+	}
+	_, err = Call[page.NavigateParams, page.NavigateResult](context.Background(), tab, page.Navigate, page.NavigateParams{})
+	var remote *RemoteError
+	if !errors.As(err, &remote) || remote.Method != page.Navigate || remote.Code != -32602 {
+		t.Errorf("expected remote error of %s, got %v", page.Navigate, err)
+	}
+}
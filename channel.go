@@ -0,0 +1,155 @@
+package cuto
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/diiyw/cuto/protocol/target"
+	"github.com/gorilla/websocket"
+)
+
+// 连接已关闭
+var ErrClosed = errors.New("cuto: connection closed")
+
+// RemoteError is an error returned by the browser for a command.
+type RemoteError struct {
+	Method  string `json:"-"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (e *RemoteError) Error() string {
+	s := fmt.Sprintf("cuto: %s: %s (%d)", e.Method, e.Message, e.Code)
+	if e.Data != "" {
+		s += ": " + e.Data
+	}
+	return s
+}
+
+// message is a frame of the DevTools protocol: a command response when Id
+// is set, an event otherwise.
+type message struct {
+	Id        int              `json:"id,omitempty"`
+	SessionId target.SessionID `json:"sessionId,omitempty"`
+	Method    string           `json:"method,omitempty"`
+	Params    json.RawMessage  `json:"params,omitempty"`
+	Result    json.RawMessage  `json:"result,omitempty"`
+	Error     *RemoteError     `json:"error,omitempty"`
+}
+
+// pending command waiting for its response
+type pending struct {
+	method string
+	done   chan *message
+}
+
+type Channel struct {
+	*websocket.Conn
+
+	debug   bool
+	mu      sync.Mutex
+	id      int
+	pending map[int]*pending
+	closed  chan struct{}
+}
+
+func (c *Channel) init(conn *websocket.Conn, debug bool) {
+	c.Conn = conn
+	c.debug = debug
+	c.pending = make(map[int]*pending)
+	c.closed = make(chan struct{})
+}
+
+// send writes a command to a session, the page itself when session is
+// empty, and registers the pending response of it.
+func (c *Channel) send(session target.SessionID, method string, params interface{}) (int, *pending, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.id++
+	var request = map[string]interface{}{
+		"id":     c.id,
+		"method": method,
+		"params": params,
+	}
+	if session != "" {
+		request["sessionId"] = session
+	}
+	if c.debug {
+		data, _ := json.Marshal(request)
+		log.Println("Send:", string(data))
+	}
+	var p = &pending{method: method, done: make(chan *message, 1)}
+	c.pending[c.id] = p
+	if err := c.WriteJSON(request); err != nil {
+		delete(c.pending, c.id)
+		return 0, nil, err
+	}
+	return c.id, p, nil
+}
+
+// wait blocks until the response of p arrives.
+func (c *Channel) wait(ctx context.Context, p *pending) (*message, error) {
+	select {
+	case msg := <-p.done:
+		return msg, nil
+	case <-c.closed:
+		select {
+		case msg := <-p.done:
+			return msg, nil
+		default:
+			return nil, ErrClosed
+		}
+	case <-ctx.Done():
+		return nil, fmt.Errorf("cuto: %s: %w", p.method, ctx.Err())
+	}
+}
+
+func (c *Channel) forget(id int) {
+	c.mu.Lock()
+	delete(c.pending, id)
+	c.mu.Unlock()
+}
+
+// read delivers responses to pending commands and passes events to
+// dispatch until the connection fails.
+func (c *Channel) read(dispatch func(msg *message)) error {
+	defer close(c.closed)
+	for {
+		_, b, err := c.ReadMessage()
+		if err != nil {
+			return err
+		}
+		var msg message
+		if err := json.Unmarshal(b, &msg); err != nil {
+			continue
+		}
+		// Event
+		if msg.Id == 0 {
+			dispatch(&msg)
+			continue
+		}
+		// Result or error
+		if c.debug {
+			if msg.Error != nil {
+				log.Println("Error:", string(b))
+			} else {
+				log.Println("Result:", string(b))
+			}
+		}
+		c.mu.Lock()
+		p, ok := c.pending[msg.Id]
+		c.mu.Unlock()
+		if !ok {
+			continue
+		}
+		if msg.Error != nil {
+			msg.Error.Method = p.method
+		}
+		p.done <- &msg
+	}
+}
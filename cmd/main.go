@@ -73,14 +73,29 @@ type Viewport  struct {
 type FrameId string
 
 type TimeSinceEpoch float64
-	`), 0755)
+
+// Command describes a protocol method together with the types of its
+// params and result.
+type Command[P, R any] struct {
+	Method string
+}
+	`), 0644)
+	_ = ioutil.WriteFile(protocol+"cdp/schema.go", proto.Schema(), 0644)
 	for _, domain := range proto.Domains {
 		dirname := protocol + strings.ToLower(domain.Domain)
 		if err := os.MkdirAll(dirname, 0755); err != nil {
 			panic(err)
 		}
-		_ = ioutil.WriteFile(dirname+"/type.go", domain.AllTypes(), 0755)
-		_ = ioutil.WriteFile(dirname+"/method.go", domain.AllMethods(), 0755)
-		_ = ioutil.WriteFile(dirname+"/event.go", domain.AllEvents(), 0755)
+		_ = ioutil.WriteFile(dirname+"/type.go", domain.AllTypes(), 0644)
+		_ = ioutil.WriteFile(dirname+"/method.go", domain.AllMethods(), 0644)
+		_ = ioutil.WriteFile(dirname+"/event.go", domain.AllEvents(), 0644)
+		// Stable view, see Domain.Stable
+		if stable := domain.Stable(); stable != nil {
+			stableDir := protocol + "stable/" + strings.ToLower(domain.Domain)
+			if err := os.MkdirAll(stableDir, 0755); err != nil {
+				panic(err)
+			}
+			_ = ioutil.WriteFile(stableDir+"/"+strings.ToLower(domain.Domain)+".go", stable, 0644)
+		}
 	}
 }
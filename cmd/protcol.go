@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"sort"
 	"strings"
 )
 
@@ -16,6 +17,7 @@ type Protocol struct {
 type Domain struct {
 	Domain       string    `json:"domain"`
 	Experimental bool      `json:"experimental,omitempty"`
+	Deprecated   bool      `json:"deprecated,omitempty"`
 	Types        []Type    `json:"types,omitempty"`
 	Commands     []Command `json:"commands"`
 	Events       []Event   `json:"events,omitempty"`
@@ -36,9 +38,14 @@ func (im Imports) String(domain string) string {
 		}
 		deps[s] = true
 	}
+	var pkgs = make([]string, 0, len(deps))
+	for s := range deps {
+		pkgs = append(pkgs, s)
+	}
+	sort.Strings(pkgs)
 	var buf strings.Builder
 	buf.WriteString("\n\nimport (\n")
-	for s, _ := range deps {
+	for _, s := range pkgs {
 		buf.WriteString(`	"github.com/diiyw/cuto/protocol/`)
 		buf.WriteString(s)
 		buf.WriteString("\"\n")
@@ -49,8 +56,9 @@ func (im Imports) String(domain string) string {
 
 func (d Domain) AllTypes() []byte {
 	var pkg bytes.Buffer
-	pkg.WriteString("package ")
 	domain := strings.ToLower(d.Domain)
+	pkg.WriteString(d.packageComment(domain, "implements"))
+	pkg.WriteString("package ")
 	pkg.WriteString(domain)
 	var buf strings.Builder
 	var imports = make([]string, 0)
@@ -102,18 +110,23 @@ func (d Domain) AllEvents() []byte {
 }
 
 type Type struct {
-	Id          string      `json:"id"`
-	Type        string      `json:"type"`
-	Properties  []Parameter `json:"properties,omitempty"`
-	Items       Items       `json:"items,omitempty"`
-	Description string      `json:"description"`
+	Id           string      `json:"id"`
+	Type         string      `json:"type"`
+	Enum         []string    `json:"enum,omitempty"`
+	Properties   []Parameter `json:"properties,omitempty"`
+	Items        Items       `json:"items,omitempty"`
+	Description  string      `json:"description"`
+	Experimental bool        `json:"experimental,omitempty"`
+	Deprecated   bool        `json:"deprecated,omitempty"`
 }
 
 func (t Type) String(domain string) ([]string, string) {
 	var buf strings.Builder
 	var imports = make([]string, 0)
 	comment := strings.Replace(t.Description, "\n", "\n	// ", -1)
-	buf.WriteString(`// ` + comment + "\ntype ")
+	buf.WriteString(`// ` + comment + "\n")
+	buf.WriteString(notes("", t.Experimental, t.Deprecated))
+	buf.WriteString("type ")
 	buf.WriteString(t.Id)
 	dep, typeString := t.genType(domain, t.Id)
 	imports = append(imports, dep...)
@@ -143,6 +156,7 @@ func (t Type) genType(domain string, typeID string) ([]string, string) {
 			for _, param := range t.Properties {
 				buf.WriteString("\n")
 				buf.WriteString(`	// ` + strings.Replace(param.Description, "\n", "\n	// ", -1) + "\n")
+				buf.WriteString(notes("	", param.Experimental, param.Deprecated))
 				buf.WriteString("	")
 				buf.WriteString(strings.ToUpper(param.Name[:1]))
 				buf.WriteString(param.Name[1:])
@@ -170,10 +184,12 @@ func (t Type) genType(domain string, typeID string) ([]string, string) {
 }
 
 type Command struct {
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	Parameters  []Parameter `json:"parameters,omitempty"`
-	Returns     []Parameter `json:"returns,omitempty"`
+	Name         string      `json:"name"`
+	Description  string      `json:"description"`
+	Parameters   []Parameter `json:"parameters,omitempty"`
+	Returns      []Parameter `json:"returns,omitempty"`
+	Experimental bool        `json:"experimental,omitempty"`
+	Deprecated   bool        `json:"deprecated,omitempty"`
 }
 
 func (c Command) String(domain string) ([]string, string) {
@@ -181,6 +197,7 @@ func (c Command) String(domain string) ([]string, string) {
 	buf.WriteString("\n")
 	comment := strings.Replace(c.Description, "\n", "\n// ", -1)
 	buf.WriteString(`// ` + comment + "\n")
+	buf.WriteString(notes("", c.Experimental, c.Deprecated))
 	buf.WriteString(`const `)
 	typeName := strings.ToUpper(c.Name[:1]) + c.Name[1:]
 	buf.WriteString(typeName)
@@ -220,14 +237,19 @@ func (c Command) String(domain string) ([]string, string) {
 		buf.WriteString("\"`")
 	}
 	buf.WriteString("\n")
-	buf.WriteString("}")
+	buf.WriteString("}\n\n")
+	// typed descriptor
+	buf.WriteString("var " + typeName + "Command = cdp.Command[" + typeName + "Params, " + typeName + "Result]{Method: " + typeName + "}")
+	imports = append(imports, "cdp")
 	return imports, buf.String()
 }
 
 type Event struct {
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	Parameters  []Parameter `json:"parameters,omitempty"`
+	Name         string      `json:"name"`
+	Description  string      `json:"description"`
+	Parameters   []Parameter `json:"parameters,omitempty"`
+	Experimental bool        `json:"experimental,omitempty"`
+	Deprecated   bool        `json:"deprecated,omitempty"`
 }
 
 func (e Event) String(domain string) ([]string, string) {
@@ -235,6 +257,7 @@ func (e Event) String(domain string) ([]string, string) {
 	buf.WriteString("\n")
 	comment := strings.Replace(e.Description, "\n", "\n// ", -1)
 	buf.WriteString(`// ` + comment + "\n")
+	buf.WriteString(notes("", e.Experimental, e.Deprecated))
 	buf.WriteString(`const `)
 	name := strings.ToUpper(e.Name[:1]) + e.Name[1:]
 	buf.WriteString(name)
@@ -293,13 +316,16 @@ func (items Items) String() ([]string, string) {
 }
 
 type Parameter struct {
-	Name        string   `json:"name"`
-	Type        string   `json:"type,omitempty"`
-	Ref         string   `json:"$ref,omitempty"`
-	Items       Items    `json:"items,omitempty"`
-	Enum        []string `json:"enum,omitempty"`
-	Optional    bool     `json:"optional,omitempty"`
-	Description string   `json:"description"`
+	Name         string      `json:"name"`
+	Type         string      `json:"type,omitempty"`
+	Ref          string      `json:"$ref,omitempty"`
+	Items        Items       `json:"items,omitempty"`
+	Enum         []string    `json:"enum,omitempty"`
+	Optional     bool        `json:"optional,omitempty"`
+	Description  string      `json:"description"`
+	Experimental bool        `json:"experimental,omitempty"`
+	Deprecated   bool        `json:"deprecated,omitempty"`
+	Properties   []Parameter `json:"properties,omitempty"`
 }
 
 func (param Parameter) genType(domain string, typeID string) ([]string, string) {
@@ -358,6 +384,7 @@ func (param Parameter) String(domain, typeID string) ([]string, string) {
 	buf.WriteString("\n")
 	comment := strings.Replace(param.Description, "\n", "\n	// ", -1)
 	buf.WriteString(`	// ` + comment + "\n")
+	buf.WriteString(notes("	", param.Experimental, param.Deprecated))
 	buf.WriteString(`	`)
 	buf.WriteString(strings.ToUpper(param.Name[:1]))
 	buf.WriteString(param.Name[1:])
@@ -365,3 +392,28 @@ func (param Parameter) String(domain, typeID string) ([]string, string) {
 	buf.WriteString(" " + types)
 	return imports, buf.String()
 }
+
+// notes renders the experimental and deprecated markers of a protocol item
+// as extra comment paragraphs, so tools such as staticcheck can flag their use.
+func notes(indent string, experimental, deprecated bool) string {
+	var buf strings.Builder
+	if experimental {
+		buf.WriteString(indent + "//\n")
+		buf.WriteString(indent + "// Experimental: may be changed or removed without notice.\n")
+	}
+	if deprecated {
+		buf.WriteString(indent + "//\n")
+		buf.WriteString(indent + "// Deprecated: marked as deprecated by the DevTools protocol.\n")
+	}
+	return buf.String()
+}
+
+func (d Domain) packageComment(pkg, verb string) string {
+	var buf strings.Builder
+	buf.WriteString("// Package " + pkg + " " + verb + " the " + d.Domain + " domain of the DevTools protocol.\n")
+	if d.Description != "" {
+		buf.WriteString("//\n// " + strings.Replace(d.Description, "\n", "\n// ", -1) + "\n")
+	}
+	buf.WriteString(notes("", d.Experimental, d.Deprecated))
+	return buf.String()
+}
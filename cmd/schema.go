@@ -0,0 +1,111 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Schema generates the parameter schema of every command, used by cuto to
+// validate params on the client side before they are sent to the browser.
+func (proto Protocol) Schema() []byte {
+	var buf strings.Builder
+	buf.WriteString(`package cdp
+
+// Schema of a command parameter, an object property or a named type.
+type Param struct {
+
+	// Parameter or property name, empty for named types and array items
+	Name	string
+
+	// One of string, integer, number, boolean, object, array, any or binary.
+	// Empty when Ref is set.
+	Type	string
+
+	// Qualified name of the referenced type, e.g. "Page.TransitionType"
+	Ref	string
+
+	// Allowed values of a string
+	Enum	[]string
+
+	// Whether the parameter may be omitted
+	Optional	bool
+
+	// Schema of array items
+	Items	*Param
+
+	// Properties of an object
+	Properties	[]Param
+}
+
+// Parameters of every command, keyed by method name.
+var Commands = map[string][]Param{
+`)
+	for _, d := range proto.Domains {
+		for _, c := range d.Commands {
+			buf.WriteString("	" + strconv.Quote(d.Domain+"."+c.Name) + ": {\n")
+			for _, param := range c.Parameters {
+				buf.WriteString("		" + param.schema(d.Domain) + ",\n")
+			}
+			buf.WriteString("	},\n")
+		}
+	}
+	buf.WriteString("}\n\n// Named types, keyed by qualified type name.\nvar Types = map[string]Param{\n")
+	for _, d := range proto.Domains {
+		for _, t := range d.Types {
+			buf.WriteString("	" + strconv.Quote(d.Domain+"."+t.Id) + ": ")
+			buf.WriteString(Parameter{
+				Type:       t.Type,
+				Enum:       t.Enum,
+				Items:      t.Items,
+				Properties: t.Properties,
+			}.schema(d.Domain))
+			buf.WriteString(",\n")
+		}
+	}
+	buf.WriteString("}\n")
+	return []byte(buf.String())
+}
+
+func (param Parameter) schema(domain string) string {
+	var fields []string
+	if param.Name != "" {
+		fields = append(fields, "Name: "+strconv.Quote(param.Name))
+	}
+	if param.Ref != "" {
+		fields = append(fields, "Ref: "+strconv.Quote(qualify(domain, param.Ref)))
+	} else if param.Type != "" {
+		fields = append(fields, "Type: "+strconv.Quote(param.Type))
+	}
+	if len(param.Enum) != 0 {
+		var enum = make([]string, 0, len(param.Enum))
+		for _, e := range param.Enum {
+			enum = append(enum, strconv.Quote(e))
+		}
+		fields = append(fields, "Enum: []string{"+strings.Join(enum, ", ")+"}")
+	}
+	if param.Optional {
+		fields = append(fields, "Optional: true")
+	}
+	if param.Type == "array" {
+		fields = append(fields, "Items: &"+Parameter{
+			Type: param.Items.Type,
+			Ref:  param.Items.Ref,
+		}.schema(domain))
+	}
+	if len(param.Properties) != 0 {
+		var props = make([]string, 0, len(param.Properties))
+		for _, p := range param.Properties {
+			props = append(props, p.schema(domain))
+		}
+		fields = append(fields, "Properties: []Param{"+strings.Join(props, ", ")+"}")
+	}
+	return "Param{" + strings.Join(fields, ", ") + "}"
+}
+
+// qualify prefixes a type reference local to domain with the domain name.
+func qualify(domain, ref string) string {
+	if strings.Contains(ref, ".") {
+		return ref
+	}
+	return domain + "." + ref
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Stable generates the stable view of a domain: aliases of every type,
+// command and event that is not marked experimental. Code importing
+// protocol/stable/<domain> instead of protocol/<domain> fails to compile as
+// soon as it depends on experimental protocol surface, while the aliased
+// values stay interchangeable with the ones cuto itself uses.
+//
+// Experimental domains have no stable view, Stable returns nil for them.
+func (d Domain) Stable() []byte {
+	if d.Experimental {
+		return nil
+	}
+	domain := strings.ToLower(d.Domain)
+	var buf bytes.Buffer
+	buf.WriteString(d.packageComment(domain, "exposes the stable subset of"))
+	buf.WriteString("package " + domain + "\n\n")
+	buf.WriteString("import proto \"github.com/diiyw/cuto/protocol/" + domain + "\"\n")
+	for _, t := range d.Types {
+		if t.Experimental {
+			continue
+		}
+		buf.WriteString("\n")
+		buf.WriteString(aliasComment(t.Description, t.Deprecated))
+		buf.WriteString("type " + t.Id + " = proto." + t.Id + "\n")
+	}
+	for _, c := range d.Commands {
+		if c.Experimental {
+			continue
+		}
+		name := strings.ToUpper(c.Name[:1]) + c.Name[1:]
+		buf.WriteString("\n")
+		buf.WriteString(aliasComment(c.Description, c.Deprecated))
+		buf.WriteString("const " + name + " = proto." + name + "\n\n")
+		buf.WriteString("type " + name + "Params = proto." + name + "Params\n\n")
+		buf.WriteString("type " + name + "Result = proto." + name + "Result\n\n")
+		buf.WriteString("var " + name + "Command = proto." + name + "Command\n")
+	}
+	for _, e := range d.Events {
+		if e.Experimental {
+			continue
+		}
+		name := strings.ToUpper(e.Name[:1]) + e.Name[1:]
+		buf.WriteString("\n")
+		buf.WriteString(aliasComment(e.Description, e.Deprecated))
+		buf.WriteString("const " + name + "Event = proto." + name + "Event\n\n")
+		buf.WriteString("type " + name + "Params = proto." + name + "Params\n")
+	}
+	return buf.Bytes()
+}
+
+func aliasComment(description string, deprecated bool) string {
+	return "// " + strings.Replace(description, "\n", "\n// ", -1) + "\n" + notes("", false, deprecated)
+}
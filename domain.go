@@ -0,0 +1,165 @@
+package cuto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// domains counts the users of every enabled protocol domain of a tab.
+type domains struct {
+	mu     sync.Mutex
+	counts map[string]int
+	// 正在启用或禁用的域
+	pending map[string]*transition
+}
+
+// EnableDomain enables a protocol domain, e.g. "Network", for one more
+// user. Only the first user actually sends the enable command.
+func (tab *Tab) EnableDomain(domain string) error {
+	ctx, cancel := tab.context()
+	defer cancel()
+	return tab.enable(ctx, domain)
+}
+
+// DisableDomain releases a domain enabled by EnableDomain, it is disabled
+// when its last user releases it.
+func (tab *Tab) DisableDomain(domain string) error {
+	ctx, cancel := tab.context()
+	defer cancel()
+	return tab.disable(ctx, domain)
+}
+
+// Domains returns the enabled protocol domains of the tab.
+func (tab *Tab) Domains() []string {
+	tab.domains.mu.Lock()
+	defer tab.domains.mu.Unlock()
+	var names = make([]string, 0, len(tab.domains.counts))
+	for name := range tab.domains.counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (tab *Tab) enable(ctx context.Context, domain string) error {
+	tab.domains.mu.Lock()
+	for {
+		if t := tab.domains.pending[domain]; t != nil {
+			// 等待进行中的启用或禁用，启用失败时共享其错误
+			tab.domains.mu.Unlock()
+			if err := t.wait(ctx); err != nil && (t.enable || ctx.Err() != nil) {
+				return err
+			}
+			tab.domains.mu.Lock()
+			continue
+		}
+		if tab.domains.counts[domain] > 0 {
+			tab.domains.counts[domain]++
+			tab.domains.mu.Unlock()
+			return nil
+		}
+		break
+	}
+	return tab.domains.switchDomain(ctx, tab, domain, true)
+}
+
+func (tab *Tab) disable(ctx context.Context, domain string) error {
+	tab.domains.mu.Lock()
+	for {
+		if t := tab.domains.pending[domain]; t != nil {
+			tab.domains.mu.Unlock()
+			if err := t.wait(ctx); err != nil && ctx.Err() != nil {
+				return ctx.Err()
+			}
+			tab.domains.mu.Lock()
+			continue
+		}
+		switch tab.domains.counts[domain] {
+		case 0:
+			tab.domains.mu.Unlock()
+			return errors.New("cuto: domain " + domain + " is not enabled")
+		case 1:
+			return tab.domains.switchDomain(ctx, tab, domain, false)
+		default:
+			tab.domains.counts[domain]--
+			tab.domains.mu.Unlock()
+			return nil
+		}
+	}
+}
+
+// switchDomain sends the enable or disable command of a domain outside the
+// lock, which it is called with. Callers meanwhile wait for the result.
+func (d *domains) switchDomain(ctx context.Context, tab *Tab, domain string, enable bool) error {
+	t := &transition{enable: enable, done: make(chan struct{})}
+	if d.pending == nil {
+		d.pending = make(map[string]*transition)
+	}
+	if d.counts == nil {
+		d.counts = make(map[string]int)
+	}
+	d.pending[domain] = t
+	d.mu.Unlock()
+	method := domain + ".disable"
+	if enable {
+		method = domain + ".enable"
+	}
+	t.err = tab.call(ctx, method, struct{}{}, nil)
+	d.mu.Lock()
+	delete(d.pending, domain)
+	if t.err == nil {
+		if enable {
+			d.counts[domain] = 1
+		} else {
+			delete(d.counts, domain)
+		}
+	}
+	d.mu.Unlock()
+	if t.err == nil && !enable && domain == "DOM" {
+		// 禁用后节点ID失效，需重新获取文档
+		tab.documentUpdated("")
+	}
+	close(t.done)
+	return t.err
+}
+
+// transition is an enable or disable command of a domain in flight.
+type transition struct {
+	enable bool
+	done   chan struct{}
+	err    error
+}
+
+// wait waits for the command, returning its error.
+func (t *transition) wait(ctx context.Context) error {
+	select {
+	case <-t.done:
+		return t.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// acquire enables the domains for the duration of an operation, the
+// returned function releases them.
+func (tab *Tab) acquire(ctx context.Context, names ...string) (release func(), err error) {
+	var enabled = make([]string, 0, len(names))
+	release = func() {
+		ctx, cancel := tab.context()
+		defer cancel()
+		for _, name := range enabled {
+			_ = tab.disable(ctx, name)
+		}
+	}
+	for _, name := range names {
+		if err := tab.enable(ctx, name); err != nil {
+			release()
+			return nil, fmt.Errorf("cuto: enable %s: %w", name, err)
+		}
+		enabled = append(enabled, name)
+	}
+	return release, nil
+}
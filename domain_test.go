@@ -0,0 +1,107 @@
+package cuto
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestDomains(t *testing.T) {
+	var mu sync.Mutex
+	var sent []string
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		sent = append(sent, method)
+		mu.Unlock()
+		return map[string]interface{}{}, nil
+	})
+	for i := 0; i < 2; i++ {
+		if err := tab.EnableDomain("Network"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tab.EnableDomain("Page"); err != nil {
+		t.Fatal(err)
+	}
+	if domains := tab.Domains(); !reflect.DeepEqual(domains, []string{"Network", "Page"}) {
+		t.Errorf("active domains %v", domains)
+	}
+	for i := 0; i < 2; i++ {
+		if err := tab.DisableDomain("Network"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tab.DisableDomain("Network"); err == nil {
+		t.Error("expected error disabling a released domain")
+	}
+	if domains := tab.Domains(); !reflect.DeepEqual(domains, []string{"Page"}) {
+		t.Errorf("active domains %v", domains)
+	}
+	if expected := []string{"Network.enable", "Page.enable", "Network.disable"}; !reflect.DeepEqual(sent, expected) {
+		t.Errorf("sent %v, expected %v", sent, expected)
+	}
+}
+
+// gate holds a command until opened.
+type gate struct {
+	method  string
+	entered chan struct{}
+	open    chan struct{}
+}
+
+func (g gate) Command(ctx context.Context, method string, params interface{}, invoke Invoker) (json.RawMessage, error) {
+	if method == g.method {
+		g.entered <- struct{}{}
+		<-g.open
+	}
+	return invoke(ctx, method, params)
+}
+
+func (g gate) Event(method string, params json.RawMessage, dispatch Dispatcher) {
+	dispatch(method, params)
+}
+
+func TestDomainsConcurrent(t *testing.T) {
+	var mu sync.Mutex
+	var sent []string
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		sent = append(sent, method)
+		mu.Unlock()
+		return map[string]interface{}{}, nil
+	})
+	g := gate{method: "Network.enable", entered: make(chan struct{}, 3), open: make(chan struct{})}
+	tab.interceptors = []Interceptor{g}
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := tab.EnableDomain("Network"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	<-g.entered
+	// 其他域不必等待进行中的命令
+	if err := tab.EnableDomain("Page"); err != nil {
+		t.Fatal(err)
+	}
+	if domains := tab.Domains(); !reflect.DeepEqual(domains, []string{"Page"}) {
+		t.Errorf("active domains %v", domains)
+	}
+	close(g.open)
+	wg.Wait()
+	for i := 0; i < 3; i++ {
+		if err := tab.DisableDomain("Network"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if expected := []string{"Page.enable", "Network.enable", "Network.disable"}; !reflect.DeepEqual(sent, expected) {
+		t.Errorf("sent %v, expected %v", sent, expected)
+	}
+}
@@ -0,0 +1,445 @@
+package cuto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+// Element is a handle to a DOM element of a frame. Unlike a dom.NodeId it
+// survives DOM.getDocument calls and can be used with Runtime, it stays
+// valid until the document of its frame goes away or it is released.
+type Element struct {
+	frame  *Frame
+	object runtime.RemoteObjectId
+
+	mu      sync.Mutex
+	backend dom.BackendNodeId
+}
+
+// 元素所在的对象组，见Runtime.releaseObjectGroup
+const elementGroup = "cuto"
+
+// 没有匹配的元素
+var ErrNoElement = errors.New("cuto: no element matches")
+
+// 元素被其他节点遮挡
+var ErrObscured = errors.New("cuto: element is obscured")
+
+// 查询第一个匹配的元素
+func (tab *Tab) Element(selector string) (*Element, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	return main.Element(selector)
+}
+
+// 查询所有匹配的元素
+func (tab *Tab) Elements(selector string) ([]*Element, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	return main.Elements(selector)
+}
+
+// 主框架节点对应的元素
+func (tab *Tab) ResolveNode(id dom.NodeId) (*Element, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	return main.ResolveNode(id)
+}
+
+// 主框架后端节点对应的元素
+func (tab *Tab) ResolveBackendNode(id dom.BackendNodeId) (*Element, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	return main.ResolveBackendNode(id)
+}
+
+// 查询框架中第一个匹配的元素
+func (f *Frame) Element(selector string) (*Element, error) {
+	ctx, cancel := f.tab.context()
+	defer cancel()
+	// 只取第一个，其余的不必释放
+	elements, err := f.queryElements(ctx, queryFirstFunction, selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("%w %s", ErrNoElement, selector)
+	}
+	return elements[0], nil
+}
+
+// 查询框架中所有匹配的元素
+func (f *Frame) Elements(selector string) ([]*Element, error) {
+	ctx, cancel := f.tab.context()
+	defer cancel()
+	return f.queryElements(ctx, queryFunction, selector)
+}
+
+// queryElements runs a function returning an array of nodes in the
+// isolated world of the frame.
+func (f *Frame) queryElements(ctx context.Context, function string, args ...interface{}) ([]*Element, error) {
+	id, err := f.executionContext(ctx, IsolatedWorld)
+	if err != nil {
+		return nil, err
+	}
+	result, err := Do(f.route(ctx), f.tab, runtime.CallFunctionOnCommand, runtime.CallFunctionOnParams{
+		FunctionDeclaration: function,
+		Arguments:           callArguments(args),
+		ExecutionContextId:  id,
+		ObjectGroup:         elementGroup,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := exceptionError(result.ExceptionDetails); err != nil {
+		return nil, err
+	}
+	return f.elements(ctx, result.Result.ObjectId)
+}
+
+// 框架节点对应的元素
+func (f *Frame) ResolveNode(id dom.NodeId) (*Element, error) {
+	return f.resolve(dom.ResolveNodeParams{NodeId: id})
+}
+
+// 框架后端节点对应的元素
+func (f *Frame) ResolveBackendNode(id dom.BackendNodeId) (*Element, error) {
+	e, err := f.resolve(dom.ResolveNodeParams{BackendNodeId: id})
+	if err != nil {
+		return nil, err
+	}
+	e.backend = id
+	return e, nil
+}
+
+// resolve creates the element of a node in the isolated world of the frame.
+func (f *Frame) resolve(params dom.ResolveNodeParams) (*Element, error) {
+	ctx, cancel := f.tab.context()
+	defer cancel()
+	id, err := f.executionContext(ctx, IsolatedWorld)
+	if err != nil {
+		return nil, err
+	}
+	params.ExecutionContextId = id
+	params.ObjectGroup = elementGroup
+	result, err := Do(f.route(ctx), f.tab, dom.ResolveNodeCommand, params)
+	if err != nil {
+		return nil, err
+	}
+	return &Element{frame: f, object: result.Object.ObjectId}, nil
+}
+
+// elements turns a remote array of nodes into elements and releases it.
+func (f *Frame) elements(ctx context.Context, array runtime.RemoteObjectId) ([]*Element, error) {
+	ctx = f.route(ctx)
+	defer Do(ctx, f.tab, runtime.ReleaseObjectCommand, runtime.ReleaseObjectParams{ObjectId: array})
+	properties, err := Do(ctx, f.tab, runtime.GetPropertiesCommand, runtime.GetPropertiesParams{
+		ObjectId:      array,
+		OwnProperties: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var indexes []int
+	var objects = make(map[int]runtime.RemoteObjectId)
+	for _, p := range properties.Result {
+		i, err := strconv.Atoi(p.Name)
+		if err != nil || p.Value.ObjectId == "" {
+			continue
+		}
+		indexes = append(indexes, i)
+		objects[i] = p.Value.ObjectId
+	}
+	sort.Ints(indexes)
+	var elements = make([]*Element, 0, len(indexes))
+	for _, i := range indexes {
+		elements = append(elements, &Element{frame: f, object: objects[i]})
+	}
+	return elements, nil
+}
+
+// 所在框架
+func (e *Element) Frame() *Frame {
+	return e.frame
+}
+
+// 远程对象ID，可用于Runtime
+func (e *Element) ObjectId() runtime.RemoteObjectId {
+	return e.object
+}
+
+// 后端节点ID，文档重新获取后仍然有效
+func (e *Element) BackendNodeId() (dom.BackendNodeId, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.backend != 0 {
+		return e.backend, nil
+	}
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	result, err := Do(e.frame.route(ctx), e.frame.tab, dom.DescribeNodeCommand, dom.DescribeNodeParams{ObjectId: e.object})
+	if err != nil {
+		return 0, err
+	}
+	e.backend = result.Node.BackendNodeId
+	return e.backend, nil
+}
+
+// 节点ID，文档更新前有效
+func (e *Element) NodeId() (dom.NodeId, error) {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	if err := e.frame.requestDocument(ctx); err != nil {
+		return 0, err
+	}
+	result, err := Do(e.frame.route(ctx), e.frame.tab, dom.RequestNodeCommand, dom.RequestNodeParams{ObjectId: e.object})
+	if err != nil {
+		return 0, err
+	}
+	return result.NodeId, nil
+}
+
+// call runs the function declaration with the element as this, the result
+// is awaited and decoded to out.
+func (e *Element) call(ctx context.Context, function string, out interface{}, args ...interface{}) error {
+	result, err := Do(e.frame.route(ctx), e.frame.tab, runtime.CallFunctionOnCommand, runtime.CallFunctionOnParams{
+		FunctionDeclaration: function,
+		ObjectId:            e.object,
+		Arguments:           callArguments(args),
+		ReturnByValue:       true,
+		AwaitPromise:        true,
+	})
+	if err != nil {
+		return err
+	}
+	if err := exceptionError(result.ExceptionDetails); err != nil {
+		return err
+	}
+	return decodeValue(result.Result, out)
+}
+
+// 用鼠标点击元素中心，元素被遮挡时失败
+func (e *Element) Click(options ...MouseOption) error {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	return e.click(ctx, newMouseOptions(options))
+}
+
+func (e *Element) click(ctx context.Context, o mouseOptions) error {
+	x, y, err := e.clickablePoint(ctx)
+	if err != nil {
+		return err
+	}
+	return e.frame.tab.mouse.click(ctx, x, y, o)
+}
+
+// 轻触元素中心
+func (e *Element) Tap() error {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	return e.tap(ctx)
+}
+
+func (e *Element) tap(ctx context.Context) error {
+	x, y, err := e.clickablePoint(ctx)
+	if err != nil {
+		return err
+	}
+	return e.frame.tab.touchscreen.tap(ctx, x, y)
+}
+
+// 鼠标移动到元素上
+func (e *Element) Hover() error {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	return e.hover(ctx)
+}
+
+func (e *Element) hover(ctx context.Context) error {
+	x, y, err := e.clickablePoint(ctx)
+	if err != nil {
+		return err
+	}
+	return e.frame.tab.mouse.move(ctx, x, y, newMouseOptions(nil))
+}
+
+// 聚焦元素后用键盘输入文本
+func (e *Element) Type(text string) error {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	return e.typeText(ctx, text)
+}
+
+func (e *Element) typeText(ctx context.Context, text string) error {
+	if err := e.call(ctx, "function(){this.focus()}", nil); err != nil {
+		return err
+	}
+	return e.frame.tab.keyboard.typeText(ctx, text, 0)
+}
+
+// selectScript focuses the element and selects its content, so typing
+// replaces it.
+const selectScript = `function(){
+	this.focus();
+	if (this.select) this.select();
+	else if (this.isContentEditable) {
+		const range = document.createRange();
+		range.selectNodeContents(this);
+		const selection = window.getSelection();
+		selection.removeAllRanges();
+		selection.addRange(range);
+	}
+}`
+
+// 清空元素后用键盘输入文本
+func (e *Element) Input(text string) error {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	if err := e.call(ctx, selectScript, nil); err != nil {
+		return err
+	}
+	if text == "" {
+		return e.frame.tab.keyboard.press(ctx, "Delete")
+	}
+	return e.frame.tab.keyboard.typeText(ctx, text, 0)
+}
+
+// 元素文本
+func (e *Element) Text() (string, error) {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	return e.text(ctx)
+}
+
+func (e *Element) text(ctx context.Context) (string, error) {
+	var text string
+	err := e.call(ctx, "function(){return this.textContent}", &text)
+	return text, err
+}
+
+// 元素的HTML
+func (e *Element) HTML() (string, error) {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	result, err := Do(e.frame.route(ctx), e.frame.tab, dom.GetOuterHTMLCommand, dom.GetOuterHTMLParams{ObjectId: e.object})
+	if err != nil {
+		return "", err
+	}
+	return result.OuterHTML, nil
+}
+
+// 属性值，属性不存在时为空
+func (e *Element) Attribute(name string) (string, error) {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	return e.attribute(ctx, name)
+}
+
+func (e *Element) attribute(ctx context.Context, name string) (string, error) {
+	var value string
+	err := e.call(ctx, "function(n){return this.getAttribute(n)}", &value, name)
+	return value, err
+}
+
+// 设置属性
+func (e *Element) SetAttribute(name, value string) error {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	return e.call(ctx, "function(n,v){this.setAttribute(n,v)}", nil, name, value)
+}
+
+// 元素边框在视口中的位置
+func (e *Element) BoundingBox() (dom.Rect, error) {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	result, err := Do(e.frame.route(ctx), e.frame.tab, dom.GetBoxModelCommand, dom.GetBoxModelParams{ObjectId: e.object})
+	if err != nil {
+		return dom.Rect{}, err
+	}
+	return quadRect(result.Model.Border), nil
+}
+
+// quadRect returns the rectangle containing the points of a quad.
+func quadRect(quad dom.Quad) dom.Rect {
+	if len(quad) < 2 {
+		return dom.Rect{}
+	}
+	minX, minY, maxX, maxY := quad[0], quad[1], quad[0], quad[1]
+	for i := 0; i+1 < len(quad); i += 2 {
+		minX, maxX = math.Min(minX, quad[i]), math.Max(maxX, quad[i])
+		minY, maxY = math.Min(minY, quad[i+1]), math.Max(maxY, quad[i+1])
+	}
+	return dom.Rect{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// 元素截图
+func (e *Element) Screenshot(filename string, quality int) error {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	if err := e.call(ctx, scrollScript, nil); err != nil {
+		return err
+	}
+	box, err := e.BoundingBox()
+	if err != nil {
+		return err
+	}
+	// 边框在框架的视口中，截图区域在页面中
+	offsetX, offsetY, err := e.frame.viewportOffset(ctx)
+	if err != nil {
+		return err
+	}
+	metrics, err := Do(ctx, e.frame.tab, page.GetLayoutMetricsCommand, page.GetLayoutMetricsParams{})
+	if err != nil {
+		return err
+	}
+	return e.frame.tab.Capture(filename, quality, page.Viewport{
+		X:      math.Round(box.X + offsetX + float64(metrics.LayoutViewport.PageX)),
+		Y:      math.Round(box.Y + offsetY + float64(metrics.LayoutViewport.PageY)),
+		Width:  math.Round(box.Width),
+		Height: math.Round(box.Height),
+		Scale:  1.0,
+	})
+}
+
+// 查询元素内所有匹配的元素
+func (e *Element) Query(selector string) ([]*Element, error) {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	result, err := Do(e.frame.route(ctx), e.frame.tab, runtime.CallFunctionOnCommand, runtime.CallFunctionOnParams{
+		FunctionDeclaration: queryElementFunction,
+		ObjectId:            e.object,
+		Arguments:           []*runtime.CallArgument{{Value: selector}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := exceptionError(result.ExceptionDetails); err != nil {
+		return nil, err
+	}
+	return e.frame.elements(ctx, result.Result.ObjectId)
+}
+
+// 释放元素，释放后不可再用
+func (e *Element) Release() error {
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	_, err := Do(e.frame.route(ctx), e.frame.tab, runtime.ReleaseObjectCommand, runtime.ReleaseObjectParams{ObjectId: e.object})
+	return err
+}
@@ -0,0 +1,102 @@
+package cuto
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestElement(t *testing.T) {
+	var mu sync.Mutex
+	var released []interface{}
+	var clip interface{}
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case runtime.CallFunctionOn:
+			args, _ := params["arguments"].([]interface{})
+			switch {
+			case params["executionContextId"] == float64(2) && len(args) == 1:
+				if args[0].(map[string]interface{})["value"] == "#none" {
+					return map[string]interface{}{"result": map[string]interface{}{"type": "object", "subtype": "array", "objectId": "empty"}}, nil
+				}
+				return map[string]interface{}{"result": map[string]interface{}{"type": "object", "subtype": "array", "objectId": "array"}}, nil
+			case params["objectId"] == "e1" && len(args) == 1:
+				return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": "https://www.baidu.com/more"}}, nil
+			case params["objectId"] == "e1":
+				return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": "more"}}, nil
+			}
+			return nil, &RemoteError{Code: -32000, Message: "Cannot find context with specified id"}
+		case runtime.GetProperties:
+			if params["objectId"] == "empty" {
+				return map[string]interface{}{"result": []interface{}{}}, nil
+			}
+			return map[string]interface{}{"result": []interface{}{
+				map[string]interface{}{"name": "1", "value": map[string]interface{}{"type": "object", "subtype": "node", "objectId": "e2"}},
+				map[string]interface{}{"name": "0", "value": map[string]interface{}{"type": "object", "subtype": "node", "objectId": "e1"}},
+				map[string]interface{}{"name": "length", "value": map[string]interface{}{"type": "number", "value": 2}},
+			}}, nil
+		case runtime.ReleaseObject:
+			mu.Lock()
+			released = append(released, params["objectId"])
+			mu.Unlock()
+		case dom.DescribeNode:
+			return map[string]interface{}{"node": map[string]interface{}{"nodeId": 0, "backendNodeId": 42}}, nil
+		case dom.GetBoxModel:
+			return map[string]interface{}{"model": map[string]interface{}{"border": []float64{10, 20, 110, 20, 110, 70, 10, 70}}}, nil
+		case page.GetLayoutMetrics:
+			// 页面已向下滚动
+			return map[string]interface{}{"layoutViewport": map[string]interface{}{"pageX": 0, "pageY": 500}}, nil
+		case page.CaptureScreenshot:
+			mu.Lock()
+			clip = params["clip"]
+			mu.Unlock()
+			return map[string]interface{}{"data": "aGk="}, nil
+		}
+		return map[string]interface{}{}, nil
+	}))
+	elements, err := tab.Elements("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(elements) != 2 || elements[0].ObjectId() != "e1" || elements[1].ObjectId() != "e2" {
+		t.Fatalf("unexpected elements %v", elements)
+	}
+	e := elements[0]
+	if text, err := e.Text(); err != nil || text != "more" {
+		t.Errorf("Text() = %q, %v", text, err)
+	}
+	if href, err := e.Attribute("href"); err != nil || href != "https://www.baidu.com/more" {
+		t.Errorf("Attribute() = %q, %v", href, err)
+	}
+	if id, err := e.BackendNodeId(); err != nil || id != 42 {
+		t.Errorf("BackendNodeId() = %d, %v", id, err)
+	}
+	if box, err := e.BoundingBox(); err != nil || box != (dom.Rect{X: 10, Y: 20, Width: 100, Height: 50}) {
+		t.Errorf("BoundingBox() = %v, %v", box, err)
+	}
+	if err := e.Screenshot(filepath.Join(t.TempDir(), "more.png"), 80); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	if expected := map[string]interface{}{"x": float64(10), "y": float64(520), "width": float64(100), "height": float64(50), "scale": float64(1)}; !reflect.DeepEqual(clip, expected) {
+		t.Errorf("captured %v, expected %v", clip, expected)
+	}
+	mu.Unlock()
+	if _, err := tab.Element("#none"); !errors.Is(err, ErrNoElement) {
+		t.Errorf("expected %v, got %v", ErrNoElement, err)
+	}
+	if err := e.Release(); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(released) != 3 || released[0] != "array" || released[2] != "e1" {
+		t.Errorf("released %v", released)
+	}
+}
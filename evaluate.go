@@ -0,0 +1,80 @@
+package cuto
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+// ScriptError is an exception thrown by JavaScript run by cuto.
+type ScriptError struct {
+	// 异常信息，如"TypeError: a is not a function"
+	Message string
+	// Javascript调用栈，每行一个调用
+	Stack string
+	// 脚本中的位置，从0开始
+	Line, Column int
+}
+
+func (e *ScriptError) Error() string {
+	if e.Stack == "" {
+		return "cuto: " + e.Message
+	}
+	return "cuto: " + e.Message + "\n" + e.Stack
+}
+
+// exceptionError converts an exception thrown by a script to an error.
+func exceptionError(details runtime.ExceptionDetails) error {
+	if details.Text == "" && details.Exception.Type == "" {
+		return nil
+	}
+	var e = &ScriptError{Line: details.LineNumber, Column: details.ColumnNumber}
+	// Error对象的描述包含调用栈
+	if description := details.Exception.Description; description != "" {
+		e.Message = description
+		if i := strings.Index(description, "\n"); i >= 0 {
+			e.Message, e.Stack = description[:i], description[i+1:]
+		}
+	} else {
+		e.Message = details.Text
+		if details.Exception.Value != nil {
+			value, _ := json.Marshal(details.Exception.Value)
+			e.Message += " " + string(value)
+		}
+	}
+	if e.Stack == "" {
+		var frames = make([]string, 0, len(details.StackTrace.CallFrames))
+		for _, f := range details.StackTrace.CallFrames {
+			name := f.FunctionName
+			if name == "" {
+				name = "<anonymous>"
+			}
+			frames = append(frames, fmt.Sprintf("    at %s (%s:%d:%d)", name, f.Url, f.LineNumber+1, f.ColumnNumber+1))
+		}
+		e.Stack = strings.Join(frames, "\n")
+	}
+	return e
+}
+
+// decodeValue decodes the value of an object returned by value to out.
+func decodeValue(object runtime.RemoteObject, out interface{}) error {
+	if out == nil {
+		return nil
+	}
+	data, err := json.Marshal(object.Value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// 在主框架中运行Javascript，等待Promise完成，结果解析到out
+func (tab *Tab) Evaluate(expression string, out interface{}, options ...EvalOption) error {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return err
+	}
+	return main.Evaluate(expression, out, options...)
+}
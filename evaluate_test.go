@@ -0,0 +1,78 @@
+package cuto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestEvaluate(t *testing.T) {
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case runtime.Evaluate:
+			if params["returnByValue"] != true || params["awaitPromise"] != true {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			if params["expression"] == "fail()" {
+				return map[string]interface{}{
+					"result": map[string]interface{}{"type": "object", "subtype": "error"},
+					"exceptionDetails": map[string]interface{}{
+						"exceptionId": 1, "text": "Uncaught", "lineNumber": 0, "columnNumber": 0,
+						"exception": map[string]interface{}{"type": "object", "subtype": "error",
+							"description": "ReferenceError: fail is not defined\n    at <anonymous>:1:1"},
+					},
+				}, nil
+			}
+			return map[string]interface{}{"result": map[string]interface{}{"type": "object", "value": map[string]interface{}{
+				"title": "百度一下", "links": 42, "context": params["contextId"],
+			}}}, nil
+		case runtime.CallFunctionOn:
+			return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": "百度一下"}}, nil
+		}
+		return map[string]interface{}{}, nil
+	}))
+	var out struct {
+		Title   string
+		Links   int
+		Context int
+	}
+	if err := tab.Evaluate("fetch('/').then(() => ({title: document.title}))", &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Title != "百度一下" || out.Links != 42 || out.Context != 1 {
+		t.Errorf("unexpected result %+v", out)
+	}
+	if err := tab.Evaluate("document.title", &out, InContext(5)); err != nil || out.Context != 5 {
+		t.Errorf("evaluated in context %d, %v", out.Context, err)
+	}
+	err := tab.Evaluate("fail()", nil)
+	var scriptErr *ScriptError
+	if !errors.As(err, &scriptErr) || scriptErr.Message != "ReferenceError: fail is not defined" || scriptErr.Stack != "    at <anonymous>:1:1" {
+		t.Errorf("unexpected error %#v", err)
+	}
+	if text := tab.Text("#su"); text != "百度一下" {
+		t.Errorf("Text() = %q", text)
+	}
+}
+
+func TestExceptionError(t *testing.T) {
+	if err := exceptionError(runtime.ExceptionDetails{}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	// throw 'boom'
+	err := exceptionError(runtime.ExceptionDetails{
+		Text:      "Uncaught",
+		Exception: runtime.RemoteObject{Type: "string", Value: "boom"},
+		StackTrace: runtime.StackTrace{CallFrames: []*runtime.CallFrame{
+			{FunctionName: "check", Url: "https://www.baidu.com/app.js", LineNumber: 9, ColumnNumber: 4},
+			{Url: "https://www.baidu.com/app.js", LineNumber: 20},
+		}},
+	})
+	expected := "cuto: Uncaught \"boom\"\n" +
+		"    at check (https://www.baidu.com/app.js:10:5)\n" +
+		"    at <anonymous> (https://www.baidu.com/app.js:21:1)"
+	if err == nil || err.Error() != expected {
+		t.Errorf("got %v, expected %s", err, expected)
+	}
+}
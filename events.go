@@ -0,0 +1,154 @@
+package cuto
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/diiyw/cuto/protocol/target"
+)
+
+// 监听者
+type listener struct {
+	session target.SessionID
+	method  string
+	fn      func(params json.RawMessage)
+}
+
+// events routes protocol events to the listeners registered on a tab.
+type events struct {
+	mu        sync.Mutex
+	id        int
+	listeners map[int]listener
+	polled    polled
+}
+
+// 每种事件为PollEvent保留的数量
+const pollBuffer = 128
+
+// polled keeps the latest events of the tab per method for PollEvent, so
+// events fired before it is called, e.g. while Send waits, are not lost.
+type polled struct {
+	queues map[string][]json.RawMessage
+	// 有新事件时关闭
+	signal chan struct{}
+}
+
+// push queues an event, dropping the oldest one of a full queue.
+func (p *polled) push(method string, params json.RawMessage) {
+	if p.queues == nil {
+		p.queues = make(map[string][]json.RawMessage)
+	}
+	queue := p.queues[method]
+	if len(queue) == pollBuffer {
+		queue = queue[1:]
+	}
+	p.queues[method] = append(queue, params)
+	if p.signal != nil {
+		close(p.signal)
+		p.signal = nil
+	}
+}
+
+// poll waits for the oldest queued event of method.
+func (tab *Tab) poll(ctx context.Context, method string) (json.RawMessage, error) {
+	for {
+		tab.events.mu.Lock()
+		p := &tab.events.polled
+		if queue := p.queues[method]; len(queue) > 0 {
+			p.queues[method] = queue[1:]
+			tab.events.mu.Unlock()
+			return queue[0], nil
+		}
+		if p.signal == nil {
+			p.signal = make(chan struct{})
+		}
+		signal := p.signal
+		tab.events.mu.Unlock()
+		select {
+		case <-signal:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// On calls fn for every event of method until the returned function is
+// called. fn runs on the goroutine reading the connection, so it must not
+// block or wait for commands of the tab; start a goroutine for that.
+func (tab *Tab) On(method string, fn func(params json.RawMessage)) (off func()) {
+	return tab.onSession("", method, fn)
+}
+
+// onSession listens to events of a child session attached to the tab.
+func (tab *Tab) onSession(session target.SessionID, method string, fn func(params json.RawMessage)) (off func()) {
+	tab.events.mu.Lock()
+	defer tab.events.mu.Unlock()
+	if tab.events.listeners == nil {
+		tab.events.listeners = make(map[int]listener)
+	}
+	tab.events.id++
+	id := tab.events.id
+	tab.events.listeners[id] = listener{session: session, method: method, fn: fn}
+	return func() {
+		tab.events.mu.Lock()
+		delete(tab.events.listeners, id)
+		tab.events.mu.Unlock()
+	}
+}
+
+// emit calls the listeners of an event, events of the tab are also kept for
+// PollEvent.
+func (tab *Tab) emit(session target.SessionID, method string, params json.RawMessage) {
+	tab.events.mu.Lock()
+	if session == "" {
+		tab.events.polled.push(method, params)
+	}
+	var fns = make([]func(json.RawMessage), 0, 2)
+	for _, l := range tab.events.listeners {
+		if l.session == session && l.method == method {
+			fns = append(fns, l.fn)
+		}
+	}
+	tab.events.mu.Unlock()
+	for _, fn := range fns {
+		fn(params)
+	}
+}
+
+// subscription buffers events of some methods until they are consumed.
+type subscription struct {
+	events chan *message
+	offs   []func()
+}
+
+// subscribe starts buffering events of methods, it must be closed once done.
+func (tab *Tab) subscribe(methods ...string) *subscription {
+	var s = &subscription{events: make(chan *message, 256)}
+	for _, method := range methods {
+		method := method
+		s.offs = append(s.offs, tab.On(method, func(params json.RawMessage) {
+			select {
+			case s.events <- &message{Method: method, Params: params}:
+			default:
+			}
+		}))
+	}
+	return s
+}
+
+// next waits for the next buffered event.
+func (s *subscription) next(ctx context.Context) (*message, error) {
+	select {
+	case msg := <-s.events:
+		return msg, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *subscription) close() {
+	for _, off := range s.offs {
+		off()
+	}
+}
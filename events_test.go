@@ -0,0 +1,40 @@
+package cuto
+
+import (
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/network"
+	"github.com/diiyw/cuto/protocol/page"
+)
+
+func TestPollEvent(t *testing.T) {
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		if method == page.Reload {
+			emit(page.FrameNavigatedEvent, map[string]interface{}{"frame": map[string]interface{}{"id": "main"}})
+			emit(page.LoadEventFiredEvent, map[string]interface{}{"timestamp": 1})
+			emit(page.LoadEventFiredEvent, map[string]interface{}{"timestamp": 2})
+		}
+		return map[string]interface{}{}, nil
+	})
+	// 事件在Send返回前已收到
+	if err := tab.Send(page.Reload, page.ReloadParams{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []network.MonotonicTime{1, 2} {
+		var loaded page.LoadEventFiredParams
+		if err := tab.PollEvent(page.LoadEventFiredEvent, &loaded); err != nil {
+			t.Fatal(err)
+		}
+		if loaded.Timestamp != expected {
+			t.Errorf("polled timestamp %v, expected %v", loaded.Timestamp, expected)
+		}
+	}
+	// 其他事件不会被丢弃
+	var navigated page.FrameNavigatedParams
+	if err := tab.PollEvent(page.FrameNavigatedEvent, &navigated); err != nil {
+		t.Fatal(err)
+	}
+	if navigated.Frame.Id != "main" {
+		t.Errorf("unexpected frame %+v", navigated.Frame)
+	}
+}
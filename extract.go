@@ -0,0 +1,313 @@
+package cuto
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldError is an error extracting one field, Field is its path in the
+// value, e.g. Items[2].Price.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return "cuto: extract " + e.Field + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ExtractError lists the fields Extract could not set, the other fields
+// are set anyway.
+type ExtractError struct {
+	Fields []*FieldError
+}
+
+func (e *ExtractError) Error() string {
+	var messages = make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		messages = append(messages, f.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e *ExtractError) Unwrap() []error {
+	var errs = make([]error, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		errs = append(errs, f)
+	}
+	return errs
+}
+
+// extractField describes how to read a struct field, it is sent to the
+// page along with the fields of nested structs.
+type extractField struct {
+	Selector string          `json:"selector,omitempty"`
+	Attr     string          `json:"attr,omitempty"`
+	Prop     string          `json:"prop,omitempty"`
+	HTML     bool            `json:"html,omitempty"`
+	Many     bool            `json:"many,omitempty"`
+	Fields   []*extractField `json:"fields"`
+
+	index    int
+	name     string
+	optional bool
+	layout   string
+}
+
+// extractScript reads the fields of the spec under the document in one
+// call. A field gives null when no element matches and {error} when the
+// element lacks the attribute to read.
+const extractScript = `function(spec){
+	const queryAll = ` + queryAllScript + `;
+	const read = (e, f) => {
+		if (f.attr) return e.hasAttribute(f.attr) ? e.getAttribute(f.attr) : {error: 'no attribute ' + f.attr};
+		if (f.prop) return e[f.prop] == null ? {error: 'no property ' + f.prop} : String(e[f.prop]);
+		if (f.html) return e.innerHTML;
+		return (e.textContent || '').replace(/\s+/g, ' ').trim();
+	};
+	const extract = (root, fields) => fields.map(f => {
+		const nodes = f.selector ? queryAll(f.selector, root) : [root];
+		const value = e => f.fields ? extract(e, f.fields) : read(e, f);
+		if (f.many) return nodes.map(value);
+		return nodes.length ? value(nodes[0]) : null;
+	});
+	return extract(document, spec);
+}`
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// parseExtractTag parses a tag like "css=.price,attr=data-value". Parts
+// which are no option belong to the selector, which may contain commas;
+// layout takes the rest of the tag as time layouts may contain commas too.
+func parseExtractTag(tag string) *extractField {
+	var f = new(extractField)
+	var selector []string
+	parts := strings.Split(tag, ",")
+	for i := 0; i < len(parts); i++ {
+		part := parts[i]
+		switch {
+		case strings.HasPrefix(part, "attr="):
+			f.Attr = strings.TrimPrefix(part, "attr=")
+		case strings.HasPrefix(part, "prop="):
+			f.Prop = strings.TrimPrefix(part, "prop=")
+		case strings.HasPrefix(part, "layout="):
+			f.layout = strings.TrimPrefix(strings.Join(parts[i:], ","), "layout=")
+			i = len(parts)
+		case part == "html":
+			f.HTML = true
+		case part == "optional":
+			f.optional = true
+		default:
+			selector = append(selector, part)
+		}
+	}
+	f.Selector = strings.TrimSpace(strings.Join(selector, ","))
+	return f
+}
+
+// extractSpec builds the spec of the tagged fields of a struct type,
+// types met again down the path are refused as they never end.
+func extractSpec(t reflect.Type, path map[reflect.Type]bool) ([]*extractField, error) {
+	if path[t] {
+		return nil, fmt.Errorf("cuto: extract: recursive type %s", t)
+	}
+	path[t] = true
+	defer delete(path, t)
+	var fields []*extractField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("cuto")
+		if !ok || tag == "-" || sf.PkgPath != "" {
+			continue
+		}
+		f := parseExtractTag(tag)
+		f.index, f.name = i, sf.Name
+		ft := sf.Type
+		if ft.Kind() == reflect.Slice && ft.Elem().Kind() != reflect.Uint8 {
+			f.Many = true
+			ft = ft.Elem()
+		}
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && ft != timeType && !reflect.PointerTo(ft).Implements(textUnmarshalerType) {
+			nested, err := extractSpec(ft, path)
+			if err != nil {
+				return nil, err
+			}
+			// 非nil表示结构体，没有字段时也是
+			f.Fields = append([]*extractField{}, nested...)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// 按结构体字段的cuto标签提取主框架的内容到v，v须为结构体指针，见Frame.Extract
+func (tab *Tab) Extract(v interface{}) error {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return err
+	}
+	return main.Extract(v)
+}
+
+// 按结构体字段的cuto标签提取框架的内容到v，v须为结构体指针，只需一次调用。
+// 标签为选择器加选项，如`cuto:"css=.price,attr=data-value"`：
+// attr=读取属性，prop=读取DOM属性，html读取innerHTML，默认读取文本；
+// optional表示元素可以不存在；layout=为时间的格式，须放在最后。
+// 结构体字段在匹配的第一个元素内提取，切片对应所有匹配的元素，
+// 无法提取的字段以*ExtractError返回，其他字段照常设置
+func (f *Frame) Extract(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cuto: extract into %T, need a pointer to a struct", v)
+	}
+	spec, err := extractSpec(rv.Elem().Type(), make(map[reflect.Type]bool))
+	if err != nil {
+		return err
+	}
+	var result json.RawMessage
+	if err := f.helper(&result, extractScript, spec); err != nil {
+		return err
+	}
+	var e ExtractError
+	setStruct(rv.Elem(), spec, result, "", &e)
+	if len(e.Fields) > 0 {
+		return &e
+	}
+	return nil
+}
+
+// setStruct sets the fields of a struct from the values read in the page.
+func setStruct(v reflect.Value, spec []*extractField, data json.RawMessage, path string, e *ExtractError) {
+	var values []json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil || len(values) != len(spec) {
+		e.Fields = append(e.Fields, &FieldError{Field: strings.TrimPrefix(path, "."), Err: errors.New("unexpected result")})
+		return
+	}
+	for i, f := range spec {
+		field := v.Field(f.index)
+		name := path + "." + f.name
+		if !f.Many {
+			setValue(field, f, values[i], name, e)
+			continue
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(values[i], &items); err != nil {
+			e.Fields = append(e.Fields, &FieldError{Field: strings.TrimPrefix(name, "."), Err: err})
+			continue
+		}
+		slice := reflect.MakeSlice(field.Type(), len(items), len(items))
+		for j, item := range items {
+			setValue(slice.Index(j), f, item, fmt.Sprintf("%s[%d]", name, j), e)
+		}
+		field.Set(slice)
+	}
+}
+
+// setValue sets one value, allocating pointers on the way.
+func setValue(v reflect.Value, f *extractField, data json.RawMessage, path string, e *ExtractError) {
+	fail := func(err error) {
+		e.Fields = append(e.Fields, &FieldError{Field: strings.TrimPrefix(path, "."), Err: err})
+	}
+	if string(data) == "null" {
+		if !f.optional {
+			fail(fmt.Errorf("%w %s", ErrNoElement, f.Selector))
+		}
+		return
+	}
+	var failed struct {
+		Error string `json:"error"`
+	}
+	if len(data) > 0 && data[0] == '{' && json.Unmarshal(data, &failed) == nil && failed.Error != "" {
+		fail(errors.New(failed.Error))
+		return
+	}
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if f.Fields != nil {
+		setStruct(v, f.Fields, data, path, e)
+		return
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		fail(err)
+		return
+	}
+	if err := convertText(v, s, f.layout); err != nil {
+		fail(err)
+	}
+}
+
+// convertText converts the text read to the type of v. Numbers may have
+// thousands separators, times are parsed with layout, RFC 3339 by default.
+func convertText(v reflect.Value, s, layout string) error {
+	if v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType) && v.Type() != timeType {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	number := strings.NewReplacer(",", "", " ", "").Replace(s)
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(number, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(number, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(number, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Struct:
+		if v.Type() != timeType {
+			return fmt.Errorf("cannot extract into %s", v.Type())
+		}
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		t, err := time.Parse(layout, strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+	case reflect.Slice:
+		// []byte
+		v.SetBytes([]byte(s))
+	default:
+		return fmt.Errorf("cannot extract into %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package cuto
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestParseExtractTag(t *testing.T) {
+	for tag, expected := range map[string]extractField{
+		"h1":                                    {Selector: "h1"},
+		"css=.price,attr=data-value":            {Selector: "css=.price", Attr: "data-value"},
+		"h1, h2,html,optional":                  {Selector: "h1, h2", HTML: true, optional: true},
+		"prop=value":                            {Prop: "value"},
+		"time,attr=datetime,layout=Jan 2, 2006": {Selector: "time", Attr: "datetime", layout: "Jan 2, 2006"},
+	} {
+		if f := parseExtractTag(tag); !reflect.DeepEqual(*f, expected) {
+			t.Errorf("parseExtractTag(%q) = %+v", tag, *f)
+		}
+	}
+}
+
+func TestExtract(t *testing.T) {
+	type review struct {
+		Stars  int     `cuto:".stars"`
+		Author *string `cuto:".author"`
+	}
+	type product struct {
+		Name  string `cuto:"h1"`
+		Price struct {
+			Amount   float64 `cuto:"css=.price,attr=data-value"`
+			Currency string  `cuto:".currency,optional"`
+		} `cuto:".buy"`
+		Stock    uint      `cuto:"#stock"`
+		Released time.Time `cuto:"time,attr=datetime,layout=Jan 2, 2006"`
+		Tags     []string  `cuto:"css=.tags a"`
+		Reviews  []review  `cuto:"xpath=//li[@class='review']"`
+		Rating   float64   `cuto:".rating"`
+		Skipped  string
+	}
+	var mu sync.Mutex
+	var calls int
+	var spec []interface{}
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case runtime.CallFunctionOn:
+			calls++
+			if params["functionDeclaration"] != extractScript {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			spec = params["arguments"].([]interface{})[0].(map[string]interface{})["value"].([]interface{})
+			return map[string]interface{}{"result": map[string]interface{}{"type": "object", "value": []interface{}{
+				"Cuto 手册",
+				[]interface{}{"1,299.50", nil},
+				"12",
+				"Oct 18, 2026",
+				[]interface{}{"go", "chrome"},
+				[]interface{}{
+					[]interface{}{"5", "张三"},
+					[]interface{}{"x", nil},
+				},
+				nil,
+			}}}, nil
+		}
+		return map[string]interface{}{}, nil
+	}))
+	var p product
+	err := tab.Extract(&p)
+	var extractErr *ExtractError
+	if !errors.As(err, &extractErr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var fields []string
+	for _, f := range extractErr.Fields {
+		fields = append(fields, f.Field)
+	}
+	if !reflect.DeepEqual(fields, []string{"Reviews[1].Stars", "Reviews[1].Author", "Rating"}) {
+		t.Errorf("failed fields %v", fields)
+	}
+	if !errors.Is(err, ErrNoElement) || !errors.Is(extractErr.Fields[0], strconv.ErrSyntax) {
+		t.Errorf("unexpected errors %v", err)
+	}
+	if p.Name != "Cuto 手册" || p.Price.Amount != 1299.5 || p.Price.Currency != "" || p.Stock != 12 {
+		t.Errorf("extracted %+v", p)
+	}
+	if !p.Released.Equal(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)) || !reflect.DeepEqual(p.Tags, []string{"go", "chrome"}) {
+		t.Errorf("extracted %+v", p)
+	}
+	if len(p.Reviews) != 2 || p.Reviews[0].Stars != 5 || p.Reviews[0].Author == nil || *p.Reviews[0].Author != "张三" {
+		t.Errorf("extracted reviews %+v", p.Reviews)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if calls != 1 || len(spec) != 7 {
+		t.Fatalf("called %d times with %v", calls, spec)
+	}
+	if reviews := spec[5].(map[string]interface{}); reviews["many"] != true || len(reviews["fields"].([]interface{})) != 2 {
+		t.Errorf("spec of reviews %v", reviews)
+	}
+	if released := spec[3].(map[string]interface{}); released["attr"] != "datetime" || released["fields"] != nil {
+		t.Errorf("spec of released %v", released)
+	}
+
+	type node struct {
+		Children []node `cuto:"li"`
+	}
+	if err := tab.Extract(&node{}); err == nil {
+		t.Error("recursive type accepted")
+	}
+	if err := tab.Extract(p); err == nil {
+		t.Error("struct value accepted")
+	}
+}
@@ -0,0 +1,140 @@
+package cuto
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/page"
+)
+
+// 文件选择框已应答
+var ErrFileChooserHandled = errors.New("cuto: file chooser already handled")
+
+// FileChooser is a native file chooser opened by the page and intercepted,
+// the page waits until it is answered with Accept or Cancel.
+type FileChooser struct {
+	tab     *Tab
+	mode    string
+	once    sync.Once
+	release func()
+}
+
+// absFiles returns the absolute paths of files, which must exist.
+func absFiles(paths []string) ([]string, error) {
+	var files = make([]string, 0, len(paths))
+	for _, p := range paths {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(abs); err != nil {
+			return nil, err
+		}
+		files = append(files, abs)
+	}
+	return files, nil
+}
+
+// 设置文件输入框的文件，元素须为input[type=file]
+func (e *Element) SetFiles(paths ...string) error {
+	files, err := absFiles(paths)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := e.frame.tab.context()
+	defer cancel()
+	_, err = Do(e.frame.route(ctx), e.frame.tab, dom.SetFileInputFilesCommand, dom.SetFileInputFilesParams{
+		Files:    files,
+		ObjectId: e.object,
+	})
+	return err
+}
+
+// 设置文件输入框的文件
+func (tab *Tab) SetFiles(selector string, paths ...string) error {
+	e, err := tab.Element(selector)
+	if err != nil {
+		return err
+	}
+	defer e.Release()
+	return e.SetFiles(paths...)
+}
+
+// 运行action并等待其打开的文件选择框，如点击上传按钮，
+// 选择框不会显示，需调用Accept或Cancel应答
+func (tab *Tab) WaitFileChooser(action func() error) (*FileChooser, error) {
+	ctx, cancel := tab.context()
+	defer cancel()
+	release, err := tab.acquire(ctx, "Page")
+	if err != nil {
+		return nil, err
+	}
+	chooser := &FileChooser{tab: tab, release: release}
+	events := tab.subscribe(page.FileChooserOpenedEvent)
+	defer events.close()
+	if _, err := Do(ctx, tab, page.SetInterceptFileChooserDialogCommand, page.SetInterceptFileChooserDialogParams{Enabled: true}); err != nil {
+		release()
+		return nil, err
+	}
+	if err := action(); err != nil {
+		chooser.finish()
+		return nil, err
+	}
+	msg, err := events.next(ctx)
+	if err != nil {
+		chooser.finish()
+		return nil, err
+	}
+	var opened page.FileChooserOpenedParams
+	if err := json.Unmarshal(msg.Params, &opened); err != nil {
+		chooser.finish()
+		return nil, err
+	}
+	chooser.mode = opened.Mode
+	return chooser, nil
+}
+
+// finish stops intercepting file choosers.
+func (c *FileChooser) finish() {
+	ctx, cancel := c.tab.context()
+	defer cancel()
+	_, _ = Do(ctx, c.tab, page.SetInterceptFileChooserDialogCommand, page.SetInterceptFileChooserDialogParams{Enabled: false})
+	c.release()
+}
+
+func (c *FileChooser) handle(action string, files []string) error {
+	var err = ErrFileChooserHandled
+	c.once.Do(func() {
+		defer c.finish()
+		ctx, cancel := c.tab.context()
+		defer cancel()
+		_, err = Do(ctx, c.tab, page.HandleFileChooserCommand, page.HandleFileChooserParams{Action: action, Files: files})
+	})
+	return err
+}
+
+// 是否可以选择多个文件
+func (c *FileChooser) Multiple() bool {
+	return c.mode == "selectMultiple"
+}
+
+// 选择文件
+func (c *FileChooser) Accept(paths ...string) error {
+	files, err := absFiles(paths)
+	if err != nil {
+		return err
+	}
+	if len(files) > 1 && !c.Multiple() {
+		return errors.New("cuto: file chooser accepts a single file")
+	}
+	return c.handle("accept", files)
+}
+
+// 取消选择
+func (c *FileChooser) Cancel() error {
+	return c.handle("cancel", nil)
+}
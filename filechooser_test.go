@@ -0,0 +1,83 @@
+package cuto
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/input"
+	"github.com/diiyw/cuto/protocol/page"
+)
+
+func TestFileChooser(t *testing.T) {
+	dir := t.TempDir()
+	report := filepath.Join(dir, "report.pdf")
+	if err := os.WriteFile(report, []byte("%PDF"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var mu sync.Mutex
+	var sent []interface{}
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case dom.SetFileInputFiles:
+			sent = append(sent, method, params["objectId"], params["files"])
+		case page.SetInterceptFileChooserDialog:
+			sent = append(sent, method, params["enabled"])
+		case input.DispatchMouseEvent:
+			// 点击上传按钮
+			emit(page.FileChooserOpenedEvent, map[string]interface{}{"mode": "selectSingle"})
+		case page.HandleFileChooser:
+			sent = append(sent, method, params["action"], params["files"])
+		}
+		return map[string]interface{}{}, nil
+	}))
+	main, err := tab.MainFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := &Element{frame: main, object: "input"}
+	if err := input.SetFiles(filepath.Join(dir, "missing.pdf")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := input.SetFiles(report); err != nil {
+		t.Fatal(err)
+	}
+	before := tab.Domains()
+	chooser, err := tab.WaitFileChooser(func() error { return tab.Mouse().Click(10, 10) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chooser.Multiple() {
+		t.Error("single file chooser accepts multiple files")
+	}
+	if err := chooser.Accept(report, report); err == nil {
+		t.Error("accepted two files")
+	}
+	if err := chooser.Accept(report); err != nil {
+		t.Fatal(err)
+	}
+	if err := chooser.Cancel(); !errors.Is(err, ErrFileChooserHandled) {
+		t.Errorf("unexpected error %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	files := []interface{}{report}
+	expected := []interface{}{
+		dom.SetFileInputFiles, "input", files,
+		page.SetInterceptFileChooserDialog, true,
+		page.HandleFileChooser, "accept", files,
+		page.SetInterceptFileChooserDialog, false,
+	}
+	if !reflect.DeepEqual(sent, expected) {
+		t.Errorf("sent %v", sent)
+	}
+	if domains := tab.Domains(); !reflect.DeepEqual(domains, before) {
+		t.Errorf("domains left enabled %v", domains)
+	}
+}
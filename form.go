@@ -0,0 +1,189 @@
+package cuto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type FormOption func(o *formOptions)
+
+type formOptions struct {
+	submit     bool
+	navigation []NavigateOption
+}
+
+// Submit submits the form once filled and waits for the navigation it
+// causes, options tell which lifecycle events to wait for.
+func Submit(options ...NavigateOption) FormOption {
+	return func(o *formOptions) {
+		o.submit = true
+		o.navigation = options
+	}
+}
+
+// setControlScript sets the value of a form control and dispatches input
+// and change events as if the user did. Options of a select, checkboxes and
+// radios match by value or by their visible label, an array selects
+// several options or checks several checkboxes of a group. A radio cannot
+// be unchecked with false, only by checking another one of its group.
+const setControlScript = `(controls, value) => {
+	const norm = s => (s || '').replace(/\s+/g, ' ').trim();
+	const label = e => norm(e.labels && e.labels.length ? e.labels[0].textContent : '');
+	const values = (Array.isArray(value) ? value : [value]).map(String);
+	const matches = (e, text) => values.includes(e.value) || values.includes(norm(text));
+	const fire = e => {
+		e.dispatchEvent(new Event('input', {bubbles: true, composed: true}));
+		e.dispatchEvent(new Event('change', {bubbles: true}));
+	};
+	const first = controls[0];
+	if (first.disabled) throw new Error('control ' + (first.name || first.id) + ' is disabled');
+	if (first.localName === 'select') {
+		let selected = Array.from(first.options).filter(o => matches(o, o.label || o.textContent));
+		if (selected.length < values.length) throw new Error('no option ' + values.join(', ') + ' in ' + (first.name || first.id));
+		if (!first.multiple) selected = selected.slice(0, 1);
+		for (const o of first.options) o.selected = selected.includes(o);
+		fire(first);
+		return;
+	}
+	const type = first.localName === 'input' ? first.type : '';
+	if (type === 'checkbox' || type === 'radio') {
+		if (type === 'radio' && value === false) throw new Error('cannot uncheck radio ' + (first.name || first.id));
+		let found = false;
+		for (const e of controls) {
+			const checked = typeof value === 'boolean' && controls.length === 1 ? value : matches(e, label(e));
+			if (type === 'radio' && !checked) continue;
+			found = found || checked;
+			if (e.checked === checked) continue;
+			e.checked = checked;
+			fire(e);
+		}
+		if (type === 'radio' && !found) throw new Error('no radio ' + values.join(', ') + ' in ' + first.name);
+		return;
+	}
+	if (type === 'file') throw new Error('use SetFiles for file input ' + (first.name || first.id));
+	if (first.readOnly) throw new Error('control ' + (first.name || first.id) + ' is read-only');
+	first.focus();
+	first.value = values.join(',');
+	fire(first);
+}`
+
+// fillFormScript fills the controls of the form found by name, id or the
+// text of their label, and submits the form if asked to.
+const fillFormScript = `function(selector, values, submit){
+	const form = (` + queryAllScript + `)(selector, document)[0];
+	if (!form) throw new Error('no element matches ' + selector);
+	const setControl = ` + setControlScript + `;
+	const norm = s => (s || '').replace(/\s+/g, ' ').trim().toLowerCase();
+	const elements = Array.from(form.elements || form.querySelectorAll('input, select, textarea, button'));
+	for (const [name, value] of Object.entries(values)) {
+		let controls = elements.filter(e => e.name === name);
+		if (!controls.length) controls = elements.filter(e => e.id === name);
+		if (!controls.length) {
+			for (const l of form.querySelectorAll('label')) {
+				if (norm(l.textContent) === norm(name) && l.control) controls = [l.control];
+			}
+		}
+		if (!controls.length) throw new Error('no control ' + name + ' in ' + selector);
+		setControl(controls, value);
+	}
+	if (!submit) return;
+	if (form.requestSubmit) form.requestSubmit();
+	else form.submit();
+}`
+
+// formValues converts the values to fill, a map with string keys or a
+// struct whose fields are named by their cuto tag. Booleans check boxes,
+// slices select several options and other values are used as text.
+func formValues(values interface{}) (map[string]interface{}, error) {
+	var m = make(map[string]interface{})
+	v := reflect.ValueOf(values)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return m, nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, errors.New("cuto: form values need string keys")
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			if value, ok := formValue(iter.Value()); ok {
+				m[iter.Key().String()] = value
+			}
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name, opts, _ := strings.Cut(field.Tag.Get("cuto"), ",")
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			if opts == "omitempty" && v.Field(i).IsZero() {
+				continue
+			}
+			if value, ok := formValue(v.Field(i)); ok {
+				m[name] = value
+			}
+		}
+	default:
+		return nil, fmt.Errorf("cuto: form values of type %s", v.Type())
+	}
+	return m, nil
+}
+
+// formValue converts one value, nil pointers are left out.
+func formValue(v reflect.Value) (interface{}, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool(), true
+	case reflect.Slice, reflect.Array:
+		var values = make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, fmt.Sprint(v.Index(i).Interface()))
+		}
+		return values, true
+	}
+	return fmt.Sprint(v.Interface()), true
+}
+
+// 按名称、id或标签文字填写表单，values为map或带cuto标签的结构体，
+// 使用Submit选项时提交表单并等待跳转
+func (tab *Tab) FillForm(formSelector string, values interface{}, options ...FormOption) error {
+	var o formOptions
+	for _, option := range options {
+		option(&o)
+	}
+	m, err := formValues(values)
+	if err != nil {
+		return err
+	}
+	fill := func() error {
+		_, err := tab.helper(fillFormScript, formSelector, m, o.submit)
+		return err
+	}
+	if !o.submit {
+		return fill()
+	}
+	return tab.awaitNavigation(newNavigateOptions(o.navigation), func(ctx context.Context) error {
+		return fill()
+	})
+}
@@ -0,0 +1,135 @@
+package cuto
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestFormValues(t *testing.T) {
+	type signup struct {
+		User     string   `cuto:"username"`
+		Age      int      `cuto:"age"`
+		Agree    bool     `cuto:"agree"`
+		Tags     []string `cuto:"tags"`
+		City     *string  `cuto:"城市"`
+		Nickname string   `cuto:"nickname,omitempty"`
+		Password string   `cuto:"-"`
+		Country  string
+		note     string
+	}
+	values, err := formValues(&signup{User: "cuto", Age: 18, Agree: true, Tags: []string{"go", "chrome"}, Password: "secret", Country: "中国", note: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"username": "cuto", "age": "18", "agree": true, "tags": []string{"go", "chrome"}, "Country": "中国",
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("formValues() = %#v", values)
+	}
+	values, err = formValues(map[string]interface{}{"wd": "百度", "page": 2, "nil": nil})
+	if err != nil || !reflect.DeepEqual(values, map[string]interface{}{"wd": "百度", "page": "2"}) {
+		t.Errorf("formValues() = %#v, %v", values, err)
+	}
+	if _, err := formValues(map[int]string{1: "a"}); err == nil {
+		t.Error("map with int keys accepted")
+	}
+	if _, err := formValues("wd=百度"); err == nil {
+		t.Error("string accepted")
+	}
+}
+
+func TestFillForm(t *testing.T) {
+	var mu sync.Mutex
+	var filled []interface{}
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case runtime.CallFunctionOn:
+			if params["functionDeclaration"] != fillFormScript || params["executionContextId"] != float64(2) {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			var args []interface{}
+			for _, arg := range params["arguments"].([]interface{}) {
+				args = append(args, arg.(map[string]interface{})["value"])
+			}
+			filled = append(filled, args)
+			values := args[1].(map[string]interface{})
+			if _, ok := values["missing"]; ok {
+				return map[string]interface{}{
+					"result": map[string]interface{}{"type": "object", "subtype": "error"},
+					"exceptionDetails": map[string]interface{}{"exceptionId": 1, "text": "Uncaught",
+						"exception": map[string]interface{}{"type": "object", "subtype": "error", "description": "Error: no control missing in #form"}},
+				}, nil
+			}
+			if args[2] == true {
+				emit(page.LifecycleEventEvent, map[string]interface{}{"frameId": "main", "loaderId": "result", "name": "DOMContentLoaded"})
+				emit(page.FrameNavigatedEvent, map[string]interface{}{"frame": map[string]interface{}{"id": "main", "loaderId": "result", "url": "https://www.baidu.com/s"}})
+			}
+			return map[string]interface{}{"result": map[string]interface{}{"type": "undefined"}}, nil
+		}
+		return map[string]interface{}{}, nil
+	}))
+	search := struct {
+		Keyword string `cuto:"wd"`
+	}{"百度一下"}
+	if err := tab.FillForm("#form", search); err != nil {
+		t.Fatal(err)
+	}
+	if err := tab.FillForm("#form", map[string]string{"wd": "cuto"}, Submit(WaitUntil(DOMContentLoaded))); err != nil {
+		t.Fatal(err)
+	}
+	var scriptErr *ScriptError
+	if err := tab.FillForm("#form", map[string]string{"missing": "x"}); !errors.As(err, &scriptErr) || scriptErr.Message != "Error: no control missing in #form" {
+		t.Errorf("unexpected error %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []interface{}{
+		[]interface{}{"#form", map[string]interface{}{"wd": "百度一下"}, false},
+		[]interface{}{"#form", map[string]interface{}{"wd": "cuto"}, true},
+	}
+	if len(filled) != 3 || !reflect.DeepEqual(filled[:2], expected) {
+		t.Errorf("filled %v", filled)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	var mu sync.Mutex
+	var checked []interface{}
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		if method != runtime.CallFunctionOn {
+			return map[string]interface{}{}, nil
+		}
+		// 与填写表单相同的方式设置，页面会收到事件
+		if params["functionDeclaration"] != checkFunction {
+			return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+		}
+		args := params["arguments"].([]interface{})
+		mu.Lock()
+		checked = append(checked, args[0].(map[string]interface{})["value"], args[1].(map[string]interface{})["value"])
+		mu.Unlock()
+		return map[string]interface{}{"result": map[string]interface{}{"type": "undefined"}}, nil
+	}))
+	if err := tab.Check("#agree", true); err != nil {
+		t.Fatal(err)
+	}
+	main, err := tab.MainFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := main.Check("#agree", false); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if expected := []interface{}{"#agree", true, "#agree", false}; !reflect.DeepEqual(checked, expected) {
+		t.Errorf("checked %v", checked)
+	}
+}
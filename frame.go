@@ -0,0 +1,564 @@
+package cuto
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+	"github.com/diiyw/cuto/protocol/target"
+)
+
+// Frame is the main frame of a tab or one of its iframes. Frames are kept
+// current through the Page events, so a Frame stays valid across
+// navigations until it is detached.
+type Frame struct {
+	tab *Tab
+
+	// 以下字段由frames.mu保护
+	id       page.FrameId
+	session  target.SessionID
+	parentId page.FrameId
+	name     string
+	url      string
+	context  runtime.ExecutionContextId
+	// cuto的隔离环境
+	isolated runtime.ExecutionContextId
+	detached bool
+}
+
+// 框架已被移除
+var ErrDetached = errors.New("cuto: frame detached")
+
+// 没有匹配的框架
+var ErrFrameNotFound = errors.New("cuto: frame not found")
+
+// frames tracks the frame tree and the execution contexts of every frame
+// of a tab.
+type frames struct {
+	start    sync.Mutex
+	tracking bool
+
+	mu       sync.Mutex
+	main     page.FrameId
+	all      map[page.FrameId]*Frame
+	sessions map[target.SessionID]*session
+	changed  chan struct{}
+	// 已获取文档的会话，其中的节点ID有效
+	documents map[target.SessionID]bool
+}
+
+// 主框架
+func (tab *Tab) MainFrame() (*Frame, error) {
+	if err := tab.trackFrames(); err != nil {
+		return nil, err
+	}
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	return tab.frames.all[tab.frames.main], nil
+}
+
+// 所有框架，父框架在子框架之前
+func (tab *Tab) Frames() ([]*Frame, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	var all = []*Frame{main}
+	for i := 0; i < len(all); i++ {
+		all = append(all, all[i].Children()...)
+	}
+	return all, nil
+}
+
+// 按名称、地址或ID查找框架
+func (tab *Tab) FindFrame(kw string) (*Frame, error) {
+	all, err := tab.Frames()
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range all {
+		if f.Name() == kw || string(f.Id()) == kw || strings.Contains(f.Url(), kw) {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrFrameNotFound, kw)
+}
+
+// trackFrames starts tracking frames on first use. Page and Runtime stay
+// enabled from then on, and out-of-process iframes are attached to the
+// tab as child sessions, see session.go.
+func (tab *Tab) trackFrames() error {
+	tab.frames.start.Lock()
+	defer tab.frames.start.Unlock()
+	if tab.frames.tracking {
+		return nil
+	}
+	tab.frames.mu.Lock()
+	tab.frames.all = make(map[page.FrameId]*Frame)
+	tab.frames.sessions = make(map[target.SessionID]*session)
+	tab.frames.documents = make(map[target.SessionID]bool)
+	tab.frames.changed = make(chan struct{})
+	tab.frames.mu.Unlock()
+	offs := tab.watchSession("")
+	ctx, cancel := tab.context()
+	defer cancel()
+	err := func() error {
+		if err := tab.enable(ctx, "Page"); err != nil {
+			return err
+		}
+		if err := tab.loadFrameTree(ctx, ""); err != nil {
+			return err
+		}
+		// 开启后会收到已有的执行上下文
+		if err := tab.enable(ctx, "Runtime"); err != nil {
+			return err
+		}
+		return tab.autoAttach(ctx, "")
+	}()
+	if err != nil {
+		for _, off := range offs {
+			off()
+		}
+		return err
+	}
+	tab.frames.tracking = true
+	return nil
+}
+
+// watchSession keeps the frames of a session current.
+func (tab *Tab) watchSession(sid target.SessionID) []func() {
+	return []func(){
+		tab.onSession(sid, page.FrameAttachedEvent, func(params json.RawMessage) { tab.frameAttached(sid, params) }),
+		tab.onSession(sid, page.FrameNavigatedEvent, func(params json.RawMessage) { tab.frameNavigated(sid, params) }),
+		tab.onSession(sid, page.FrameDetachedEvent, func(params json.RawMessage) { tab.frameDetached(sid, params) }),
+		tab.onSession(sid, runtime.ExecutionContextCreatedEvent, func(params json.RawMessage) { tab.contextCreated(sid, params) }),
+		tab.onSession(sid, runtime.ExecutionContextDestroyedEvent, func(params json.RawMessage) { tab.contextDestroyed(sid, params) }),
+		tab.onSession(sid, runtime.ExecutionContextsClearedEvent, func(json.RawMessage) { tab.contextsCleared(sid) }),
+		tab.onSession(sid, target.AttachedToTargetEvent, func(params json.RawMessage) { tab.attachedToTarget(sid, params) }),
+		tab.onSession(sid, target.DetachedFromTargetEvent, func(params json.RawMessage) { tab.detachedFromTarget(params) }),
+		tab.onSession(sid, dom.DocumentUpdatedEvent, func(json.RawMessage) { tab.documentUpdated(sid) }),
+	}
+}
+
+func (tab *Tab) loadFrameTree(ctx context.Context, sid target.SessionID) error {
+	tree, err := Do(withSession(ctx, sid), tab, page.GetFrameTreeCommand, page.GetFrameTreeParams{})
+	if err != nil {
+		return err
+	}
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	tab.addFrameTree(sid, &tree.FrameTree)
+	tab.notifyLocked()
+	return nil
+}
+
+func (tab *Tab) addFrameTree(sid target.SessionID, tree *page.FrameTree) {
+	tab.updateFrame(sid, tree.Frame)
+	for _, child := range tree.ChildFrames {
+		tab.addFrameTree(sid, child)
+	}
+}
+
+// updateFrame must be called with frames.mu held. The root frame of a
+// child session is an iframe of the parent session, it keeps its parent.
+func (tab *Tab) updateFrame(sid target.SessionID, frame page.Frame) {
+	f := tab.frameLocked(frame.Id)
+	f.session = sid
+	f.name = frame.Name
+	f.url = frame.Url
+	if frame.ParentId != "" {
+		f.parentId = page.FrameId(frame.ParentId)
+	} else if sid == "" {
+		f.parentId = ""
+		tab.frames.main = frame.Id
+	}
+}
+
+// frameLocked returns the frame of id, creating it when unknown.
+func (tab *Tab) frameLocked(id page.FrameId) *Frame {
+	f, ok := tab.frames.all[id]
+	if !ok {
+		f = &Frame{tab: tab, id: id}
+		tab.frames.all[id] = f
+	}
+	return f
+}
+
+// notifyLocked wakes up everybody waiting for the frames to change.
+func (tab *Tab) notifyLocked() {
+	close(tab.frames.changed)
+	tab.frames.changed = make(chan struct{})
+}
+
+// ownedLocked returns the frame of id when it belongs to the session, a
+// frame moved to a child session ignores the events of its parent session.
+func (tab *Tab) ownedLocked(sid target.SessionID, id page.FrameId) (*Frame, bool) {
+	f, ok := tab.frames.all[id]
+	if !ok || f.session != sid {
+		return nil, false
+	}
+	return f, true
+}
+
+func (tab *Tab) frameAttached(sid target.SessionID, params json.RawMessage) {
+	var attached page.FrameAttachedParams
+	if err := json.Unmarshal(params, &attached); err != nil {
+		return
+	}
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	if _, ok := tab.frames.all[attached.FrameId]; ok {
+		return
+	}
+	f := tab.frameLocked(attached.FrameId)
+	f.session = sid
+	f.parentId = attached.ParentFrameId
+	tab.notifyLocked()
+}
+
+func (tab *Tab) frameNavigated(sid target.SessionID, params json.RawMessage) {
+	var navigated page.FrameNavigatedParams
+	if err := json.Unmarshal(params, &navigated); err != nil {
+		return
+	}
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	if f, ok := tab.frames.all[navigated.Frame.Id]; ok && f.session != sid {
+		return
+	}
+	tab.updateFrame(sid, navigated.Frame)
+	tab.notifyLocked()
+}
+
+func (tab *Tab) frameDetached(sid target.SessionID, params json.RawMessage) {
+	var detached page.FrameDetachedParams
+	if err := json.Unmarshal(params, &detached); err != nil {
+		return
+	}
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	if _, ok := tab.ownedLocked(sid, detached.FrameId); !ok {
+		return
+	}
+	tab.removeFrameLocked(detached.FrameId)
+	tab.notifyLocked()
+}
+
+// removeFrameLocked removes a frame and all of its descendants.
+func (tab *Tab) removeFrameLocked(id page.FrameId) {
+	f, ok := tab.frames.all[id]
+	if !ok {
+		return
+	}
+	for _, child := range tab.frames.all {
+		if child.parentId == id {
+			tab.removeFrameLocked(child.id)
+		}
+	}
+	f.detached = true
+	f.resetContexts()
+	delete(tab.frames.all, id)
+}
+
+// 执行上下文的附加信息
+type contextAuxData struct {
+	FrameId   page.FrameId `json:"frameId"`
+	IsDefault bool         `json:"isDefault"`
+	Type      string       `json:"type"`
+}
+
+func (tab *Tab) contextCreated(sid target.SessionID, params json.RawMessage) {
+	var created struct {
+		Context struct {
+			Id      runtime.ExecutionContextId `json:"id"`
+			Name    string                     `json:"name"`
+			AuxData contextAuxData             `json:"auxData"`
+		} `json:"context"`
+	}
+	if err := json.Unmarshal(params, &created); err != nil {
+		return
+	}
+	aux := created.Context.AuxData
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	f, ok := tab.ownedLocked(sid, aux.FrameId)
+	if !ok {
+		return
+	}
+	switch {
+	case aux.IsDefault:
+		f.context = created.Context.Id
+	case aux.Type == "isolated" && created.Context.Name == utilityWorld:
+		f.isolated = created.Context.Id
+	default:
+		return
+	}
+	tab.notifyLocked()
+}
+
+func (tab *Tab) contextDestroyed(sid target.SessionID, params json.RawMessage) {
+	var destroyed runtime.ExecutionContextDestroyedParams
+	if err := json.Unmarshal(params, &destroyed); err != nil {
+		return
+	}
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	for _, f := range tab.frames.all {
+		if f.session != sid {
+			continue
+		}
+		switch destroyed.ExecutionContextId {
+		case f.context:
+			// 文档已销毁，隔离环境随之销毁
+			f.resetContexts()
+		case f.isolated:
+			f.isolated = 0
+		}
+	}
+}
+
+func (tab *Tab) contextsCleared(sid target.SessionID) {
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	for _, f := range tab.frames.all {
+		if f.session == sid {
+			f.resetContexts()
+		}
+	}
+}
+
+// documentUpdated forgets the document of a session, its node ids are
+// no longer valid.
+func (tab *Tab) documentUpdated(sid target.SessionID) {
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	delete(tab.frames.documents, sid)
+}
+
+// requestDocument requests the document of the frame's session once, node
+// ids are only sent after it. Requesting it again would invalidate the node
+// ids already sent.
+func (f *Frame) requestDocument(ctx context.Context) error {
+	f.tab.frames.mu.Lock()
+	sid := f.session
+	requested := f.tab.frames.documents[sid]
+	f.tab.frames.mu.Unlock()
+	if requested {
+		return nil
+	}
+	if _, err := Do(withSession(ctx, sid), f.tab, dom.GetDocumentCommand, dom.GetDocumentParams{}); err != nil {
+		return err
+	}
+	f.tab.frames.mu.Lock()
+	defer f.tab.frames.mu.Unlock()
+	f.tab.frames.documents[sid] = true
+	return nil
+}
+
+// 框架ID
+func (f *Frame) Id() page.FrameId {
+	return f.id
+}
+
+// 框架名称
+func (f *Frame) Name() string {
+	f.tab.frames.mu.Lock()
+	defer f.tab.frames.mu.Unlock()
+	return f.name
+}
+
+// 框架地址
+func (f *Frame) Url() string {
+	f.tab.frames.mu.Lock()
+	defer f.tab.frames.mu.Unlock()
+	return f.url
+}
+
+// 父框架，主框架返回nil
+func (f *Frame) Parent() *Frame {
+	f.tab.frames.mu.Lock()
+	defer f.tab.frames.mu.Unlock()
+	return f.tab.frames.all[f.parentId]
+}
+
+// 子框架
+func (f *Frame) Children() []*Frame {
+	f.tab.frames.mu.Lock()
+	defer f.tab.frames.mu.Unlock()
+	var children []*Frame
+	for _, child := range f.tab.frames.all {
+		if child.parentId == f.id {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// 是否已被移除
+func (f *Frame) Detached() bool {
+	f.tab.frames.mu.Lock()
+	defer f.tab.frames.mu.Unlock()
+	return f.detached
+}
+
+// resetContexts must be called with frames.mu held.
+func (f *Frame) resetContexts() {
+	f.context = 0
+	f.isolated = 0
+}
+
+// executionContext waits until the frame has an execution context in the
+// world, e.g. while it is navigating. The isolated world is created once
+// the document of the frame exists.
+func (f *Frame) executionContext(ctx context.Context, world World) (runtime.ExecutionContextId, error) {
+	id, err := f.defaultContext(ctx)
+	if err != nil || world == MainWorld {
+		return id, err
+	}
+	f.tab.frames.mu.Lock()
+	isolated := f.isolated
+	f.tab.frames.mu.Unlock()
+	if isolated != 0 {
+		return isolated, nil
+	}
+	result, err := Do(f.route(ctx), f.tab, page.CreateIsolatedWorldCommand, page.CreateIsolatedWorldParams{
+		FrameId:   f.id,
+		WorldName: utilityWorld,
+	})
+	if err != nil {
+		return 0, err
+	}
+	f.tab.frames.mu.Lock()
+	defer f.tab.frames.mu.Unlock()
+	// 创建期间文档已变化
+	if f.context != id {
+		return 0, errors.New("cuto: frame " + string(f.id) + " navigated")
+	}
+	f.isolated = result.ExecutionContextId
+	return f.isolated, nil
+}
+
+// defaultContext waits for the execution context of the main world.
+func (f *Frame) defaultContext(ctx context.Context) (runtime.ExecutionContextId, error) {
+	for {
+		f.tab.frames.mu.Lock()
+		id, detached, changed := f.context, f.detached, f.tab.frames.changed
+		f.tab.frames.mu.Unlock()
+		if detached {
+			return 0, ErrDetached
+		}
+		if id != 0 {
+			return id, nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// 在框架中运行Javascript，等待Promise完成，结果解析到out，默认在页面环境中运行
+func (f *Frame) Evaluate(expression string, out interface{}, options ...EvalOption) error {
+	var o = newEvalOptions(options)
+	ctx, cancel := f.tab.context()
+	defer cancel()
+	var id = o.context
+	if id == 0 {
+		var err error
+		if id, err = f.executionContext(ctx, o.world); err != nil {
+			return err
+		}
+	}
+	result, err := Do(f.route(ctx), f.tab, runtime.EvaluateCommand, runtime.EvaluateParams{
+		Expression:    expression,
+		ContextId:     id,
+		ReturnByValue: true,
+		AwaitPromise:  true,
+	})
+	if err != nil {
+		return err
+	}
+	if err := exceptionError(result.ExceptionDetails); err != nil {
+		return err
+	}
+	return decodeValue(result.Result, out)
+}
+
+// route sends the commands run with ctx to the session of the frame.
+func (f *Frame) route(ctx context.Context) context.Context {
+	f.tab.frames.mu.Lock()
+	defer f.tab.frames.mu.Unlock()
+	return withSession(ctx, f.session)
+}
+
+// elementFunction calls body with the first element matching the selector
+// as e and the other arguments as a, failing when there is none.
+func elementFunction(body string) string {
+	return "function(s,...a){const e=(" + queryAllScript + ")(s, document)[0];" +
+		"if(!e)throw new Error('no element matches '+s);" + body + "}"
+}
+
+// helper calls one of cuto's functions in the isolated world of the frame.
+func (f *Frame) helper(out interface{}, fn string, args ...interface{}) error {
+	ctx, cancel := f.tab.context()
+	defer cancel()
+	result, err := f.callFunction(ctx, IsolatedWorld, fn, args)
+	if err != nil {
+		return err
+	}
+	return decodeValue(result, out)
+}
+
+// 元素点击
+func (f *Frame) Click(selector string) error {
+	e, err := f.Element(selector)
+	if err != nil {
+		return err
+	}
+	defer e.Release()
+	return e.Click()
+}
+
+// 清空后用键盘输入值
+func (f *Frame) Input(selector, v string) error {
+	e, err := f.Element(selector)
+	if err != nil {
+		return err
+	}
+	defer e.Release()
+	return e.Input(v)
+}
+
+// checkFunction checks or unchecks the checkbox or radio matching a
+// selector like the user would, firing input and change events. A radio
+// is unchecked by clearing it, which the user cannot do.
+var checkFunction = elementFunction("if(e.type!=='checkbox'&&e.type!=='radio')throw new Error('not a checkbox or radio: '+s);" +
+	"if(e.type==='radio'&&!a[0]){if(e.checked){e.checked=false;" +
+	"e.dispatchEvent(new Event('input',{bubbles:true,composed:true}));e.dispatchEvent(new Event('change',{bubbles:true}))}return}" +
+	"(" + setControlScript + ")([e], a[0])")
+
+// 勾选或取消勾选复选框、单选框，触发input和change事件，取消勾选单选框时清除其选中状态
+func (f *Frame) Check(selector string, checked bool) error {
+	return f.helper(nil, checkFunction, selector, checked)
+}
+
+// 获取文本信息
+func (f *Frame) Text(selector string) (string, error) {
+	var text string
+	err := f.helper(&text, elementFunction("return e.textContent"), selector)
+	return text, err
+}
+
+// 元素值
+func (f *Frame) Value(selector string) (string, error) {
+	var value string
+	err := f.helper(&value, elementFunction("return e.value"), selector)
+	return value, err
+}
@@ -0,0 +1,241 @@
+package cuto
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/input"
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+	"github.com/diiyw/cuto/protocol/target"
+)
+
+func TestFrames(t *testing.T) {
+	var mu sync.Mutex
+	var contexts []interface{}
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case page.GetFrameTree:
+			return map[string]interface{}{"frameTree": map[string]interface{}{
+				"frame": map[string]interface{}{"id": "main", "url": "https://www.baidu.com/"},
+				"childFrames": []interface{}{map[string]interface{}{
+					"frame": map[string]interface{}{"id": "pay", "parentId": "main", "name": "payment", "url": "https://pay.baidu.com/"},
+				}},
+			}}, nil
+		case runtime.Enable:
+			emit(runtime.ExecutionContextCreatedEvent, map[string]interface{}{"context": map[string]interface{}{"id": 1, "auxData": map[string]interface{}{"frameId": "main", "isDefault": true}}})
+			emit(runtime.ExecutionContextCreatedEvent, map[string]interface{}{"context": map[string]interface{}{"id": 2, "auxData": map[string]interface{}{"frameId": "pay", "isDefault": true}}})
+		case page.CreateIsolatedWorld:
+			if params["frameId"] != "pay" || params["worldName"] != utilityWorld {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			return map[string]interface{}{"executionContextId": 7}, nil
+		case runtime.Evaluate:
+			mu.Lock()
+			contexts = append(contexts, params["contextId"])
+			mu.Unlock()
+			return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": "pay"}}, nil
+		case runtime.CallFunctionOn:
+			mu.Lock()
+			contexts = append(contexts, params["executionContextId"])
+			mu.Unlock()
+			// 选择器作为参数传递
+			args := params["arguments"].([]interface{})
+			if args[0].(map[string]interface{})["value"] != "#amount" {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": "pay"}}, nil
+		}
+		return map[string]interface{}{}, nil
+	})
+	frames, err := tab.Frames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 2 || frames[0].Id() != "main" || frames[1].Parent() != frames[0] {
+		t.Fatalf("unexpected frames %v", frames)
+	}
+	frame, err := tab.FindFrame("payment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tab.FindFrame("checkout"); !errors.Is(err, ErrFrameNotFound) {
+		t.Errorf("expected %v, got %v", ErrFrameNotFound, err)
+	}
+	text, err := frame.Text("#amount")
+	if err != nil || text != "pay" {
+		t.Errorf("Text() = %q, %v", text, err)
+	}
+	if err := frame.Evaluate("location.href", nil); err != nil {
+		t.Fatal(err)
+	}
+	// 辅助函数在隔离环境中运行
+	if len(contexts) != 2 || contexts[0] != float64(7) || contexts[1] != float64(2) {
+		t.Errorf("evaluated in contexts %v", contexts)
+	}
+	tab.frameDetached("", []byte(`{"frameId":"pay"}`))
+	if !frame.Detached() {
+		t.Error("frame not detached")
+	}
+	if err := frame.Click("#pay"); err != ErrDetached {
+		t.Errorf("expected %v, got %v", ErrDetached, err)
+	}
+}
+
+func TestOutOfProcessFrames(t *testing.T) {
+	var mu sync.Mutex
+	var commands = make(map[string]bool)
+	resumed := make(chan struct{})
+	tab := devtoolsSessions(t, &Browser{}, func(emit func(string, interface{}), session, method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		commands[method+"@"+session] = true
+		mu.Unlock()
+		switch method {
+		case page.GetFrameTree:
+			if session == "ads" {
+				return map[string]interface{}{"frameTree": map[string]interface{}{
+					"frame": map[string]interface{}{"id": "ad", "url": "https://ads.example.com/"},
+				}}, nil
+			}
+			return map[string]interface{}{"frameTree": map[string]interface{}{
+				"frame": map[string]interface{}{"id": "main", "url": "https://www.baidu.com/"},
+				"childFrames": []interface{}{map[string]interface{}{
+					"frame": map[string]interface{}{"id": "ad", "parentId": "main", "name": "ad", "url": "about:blank"},
+				}},
+			}}, nil
+		case runtime.Enable:
+			if session == "ads" {
+				emit(runtime.ExecutionContextCreatedEvent, map[string]interface{}{"context": map[string]interface{}{"id": 1, "auxData": map[string]interface{}{"frameId": "ad", "isDefault": true}}})
+			}
+		case target.SetAutoAttach:
+			if session == "" {
+				emit(target.AttachedToTargetEvent, map[string]interface{}{
+					"sessionId":          "ads",
+					"targetInfo":         map[string]interface{}{"targetId": "ad", "type": "iframe", "url": "https://ads.example.com/"},
+					"waitingForDebugger": true,
+				})
+			}
+		case runtime.RunIfWaitingForDebugger:
+			close(resumed)
+		case runtime.Evaluate:
+			return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": session}}, nil
+		}
+		return map[string]interface{}{}, nil
+	})
+	if _, err := tab.Frames(); err != nil {
+		t.Fatal(err)
+	}
+	<-resumed
+	frame, err := tab.FindFrame("ad")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var in string
+	if err := frame.Evaluate("location.href", &in); err != nil || in != "ads" {
+		t.Fatalf("Evaluate() ran in session %q, %v", in, err)
+	}
+	main, _ := tab.MainFrame()
+	if frame.Url() != "https://ads.example.com/" || frame.Parent() != main {
+		t.Errorf("unexpected frame %s with parent %v", frame.Url(), frame.Parent())
+	}
+	tab.detachedFromTarget([]byte(`{"sessionId":"ads"}`))
+	if frame.Detached() {
+		t.Error("iframe removed with its session")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !commands[page.Enable+"@ads"] {
+		t.Errorf("child session not set up: %v", commands)
+	}
+}
+
+func TestOutOfProcessClick(t *testing.T) {
+	var mu sync.Mutex
+	var clicks []string
+	resumed := make(chan struct{})
+	tab := devtoolsSessions(t, &Browser{}, func(emit func(string, interface{}), session, method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case page.GetFrameTree:
+			if session == "ads" {
+				return map[string]interface{}{"frameTree": map[string]interface{}{
+					"frame": map[string]interface{}{"id": "ad", "url": "https://ads.example.com/"},
+				}}, nil
+			}
+			return map[string]interface{}{"frameTree": map[string]interface{}{
+				"frame": map[string]interface{}{"id": "main", "url": "https://www.baidu.com/"},
+				"childFrames": []interface{}{map[string]interface{}{
+					"frame": map[string]interface{}{"id": "ad", "parentId": "main", "name": "ad", "url": "about:blank"},
+				}},
+			}}, nil
+		case target.SetAutoAttach:
+			if session == "" {
+				emit(target.AttachedToTargetEvent, map[string]interface{}{
+					"sessionId":          "ads",
+					"targetInfo":         map[string]interface{}{"targetId": "ad", "type": "iframe", "url": "https://ads.example.com/"},
+					"waitingForDebugger": true,
+				})
+			}
+		case runtime.Enable:
+			if session == "ads" {
+				emit(runtime.ExecutionContextCreatedEvent, map[string]interface{}{"context": map[string]interface{}{"id": 1, "auxData": map[string]interface{}{"frameId": "ad", "isDefault": true}}})
+			}
+		case runtime.RunIfWaitingForDebugger:
+			close(resumed)
+		case page.CreateIsolatedWorld:
+			return map[string]interface{}{"executionContextId": 9}, nil
+		case runtime.CallFunctionOn:
+			if params["executionContextId"] == float64(9) {
+				return map[string]interface{}{"result": map[string]interface{}{"type": "object", "subtype": "array", "objectId": "array"}}, nil
+			}
+		case runtime.GetProperties:
+			return map[string]interface{}{"result": []interface{}{
+				map[string]interface{}{"name": "0", "value": map[string]interface{}{"type": "object", "subtype": "node", "objectId": "button"}},
+			}}, nil
+		case dom.DescribeNode:
+			return map[string]interface{}{"node": map[string]interface{}{"backendNodeId": 42}}, nil
+		case dom.GetNodeForLocation:
+			return map[string]interface{}{"backendNodeId": 42}, nil
+		case dom.GetFrameOwner:
+			if session != "" || params["frameId"] != "ad" {
+				return nil, &RemoteError{Code: -32000, Message: "Frame with the given id was not found."}
+			}
+			return map[string]interface{}{"backendNodeId": 7}, nil
+		case dom.GetContentQuads:
+			// iframe在页面中的位置
+			if params["backendNodeId"] == float64(7) {
+				return map[string]interface{}{"quads": [][]float64{{100, 200, 400, 200, 400, 500, 100, 500}}}, nil
+			}
+			return map[string]interface{}{"quads": [][]float64{{10, 10, 30, 10, 30, 30, 10, 30}}}, nil
+		case input.DispatchMouseEvent:
+			mu.Lock()
+			clicks = append(clicks, fmt.Sprintf("%s %v@%s(%v,%v)", params["type"], params["button"], session, params["x"], params["y"]))
+			mu.Unlock()
+		}
+		return map[string]interface{}{}, nil
+	})
+	if _, err := tab.Frames(); err != nil {
+		t.Fatal(err)
+	}
+	<-resumed
+	frame, err := tab.FindFrame("ad")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := frame.Element("button")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Click(); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{"mouseMoved none@(120,220)", "mousePressed left@(120,220)", "mouseReleased left@(120,220)"}
+	if !reflect.DeepEqual(clicks, expected) {
+		t.Errorf("dispatched %q, expected %q", clicks, expected)
+	}
+}
@@ -0,0 +1,111 @@
+package cuto
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+// 在主框架中调用Javascript函数，参数以JSON传递，返回结果的JSON
+// 参数含*Element时在隔离环境中调用，否则在页面环境中调用
+func (tab *Tab) Call(fn string, args ...interface{}) (json.RawMessage, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	return main.Call(fn, args...)
+}
+
+// 在框架中调用Javascript函数，参数以JSON传递，返回结果的JSON
+// 参数含*Element时在隔离环境中调用，否则在页面环境中调用
+func (f *Frame) Call(fn string, args ...interface{}) (json.RawMessage, error) {
+	ctx, cancel := f.tab.context()
+	defer cancel()
+	var world = MainWorld
+	for _, arg := range args {
+		if _, ok := arg.(*Element); ok {
+			world = IsolatedWorld
+		}
+	}
+	result, err := f.callFunction(ctx, world, fn, args)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(result.Value)
+}
+
+// callFunction calls the function declaration fn with args in the world
+// of the frame and returns its result by value.
+func (f *Frame) callFunction(ctx context.Context, world World, fn string, args []interface{}) (runtime.RemoteObject, error) {
+	id, err := f.executionContext(ctx, world)
+	if err != nil {
+		return runtime.RemoteObject{}, err
+	}
+	result, err := Do(f.route(ctx), f.tab, runtime.CallFunctionOnCommand, runtime.CallFunctionOnParams{
+		FunctionDeclaration: fn,
+		Arguments:           callArguments(args),
+		ExecutionContextId:  id,
+		ReturnByValue:       true,
+		AwaitPromise:        true,
+	})
+	if err != nil {
+		return runtime.RemoteObject{}, err
+	}
+	if err := exceptionError(result.ExceptionDetails); err != nil {
+		return runtime.RemoteObject{}, err
+	}
+	return result.Result, nil
+}
+
+// helper calls one of cuto's functions in the isolated world of the main
+// frame.
+func (tab *Tab) helper(fn string, args ...interface{}) (runtime.RemoteObject, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return runtime.RemoteObject{}, err
+	}
+	ctx, cancel := tab.context()
+	defer cancel()
+	return main.callFunction(ctx, IsolatedWorld, fn, args)
+}
+
+// callArguments passes elements by reference and other values as JSON,
+// numbers JSON cannot represent are passed unserializable.
+func callArguments(args []interface{}) []*runtime.CallArgument {
+	var arguments = make([]*runtime.CallArgument, 0, len(args))
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case *Element:
+			arguments = append(arguments, &runtime.CallArgument{ObjectId: v.object})
+			continue
+		case float64:
+			if unserializable := unserializableNumber(v); unserializable != "" {
+				arguments = append(arguments, &runtime.CallArgument{UnserializableValue: unserializable})
+				continue
+			}
+		case float32:
+			if unserializable := unserializableNumber(float64(v)); unserializable != "" {
+				arguments = append(arguments, &runtime.CallArgument{UnserializableValue: unserializable})
+				continue
+			}
+		}
+		arguments = append(arguments, &runtime.CallArgument{Value: arg})
+	}
+	return arguments
+}
+
+func unserializableNumber(f float64) runtime.UnserializableValue {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case f == 0 && math.Signbit(f):
+		return "-0"
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+package cuto
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestTabCall(t *testing.T) {
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case runtime.CallFunctionOn:
+			if params["executionContextId"] != float64(1) {
+				return nil, &RemoteError{Code: -32000, Message: "Cannot find context with specified id"}
+			}
+			// 参数原样传给函数
+			var values []interface{}
+			for _, arg := range params["arguments"].([]interface{}) {
+				values = append(values, arg.(map[string]interface{})["value"])
+			}
+			return map[string]interface{}{"result": map[string]interface{}{"type": "object", "value": values}}, nil
+		}
+		return map[string]interface{}{}, nil
+	}))
+	selector := `input[name='q"]');alert(1)//`
+	result, err := tab.Call("function(s, n){return [s, n]}", selector, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(result) != `["input[name='q\"]');alert(1)//",3]` {
+		t.Errorf("Call() = %s", result)
+	}
+}
+
+func TestCallArguments(t *testing.T) {
+	args := callArguments([]interface{}{"a", false, math.Inf(-1), &Element{object: "e1"}})
+	expected := []*runtime.CallArgument{
+		{Value: "a"},
+		{Value: false},
+		{UnserializableValue: "-Infinity"},
+		{ObjectId: "e1"},
+	}
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("callArguments() = %+v", args)
+	}
+}
@@ -2,4 +2,4 @@ module github.com/diiyw/cuto
 
 require github.com/gorilla/websocket v1.4.0
 
-go 1.13
+go 1.21
@@ -0,0 +1,143 @@
+package cuto
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/diiyw/cuto/protocol/network"
+	"github.com/diiyw/cuto/protocol/page"
+)
+
+// 没有可以前进或后退的历史记录
+var ErrNoHistory = errors.New("cuto: no history entry")
+
+// IgnoreCache makes Reload bypass the cache, like shift-refresh.
+func IgnoreCache() NavigateOption {
+	return func(o *navigateOptions) {
+		o.ignoreCache = true
+	}
+}
+
+// 历史记录及当前位置
+func (tab *Tab) History() ([]*page.NavigationEntry, int, error) {
+	ctx, cancel := tab.context()
+	defer cancel()
+	history, err := Do(ctx, tab, page.GetNavigationHistoryCommand, page.GetNavigationHistoryParams{})
+	if err != nil {
+		return nil, 0, err
+	}
+	return history.Entries, history.CurrentIndex, nil
+}
+
+// 后退
+func (tab *Tab) Back(options ...NavigateOption) error {
+	return tab.traverse(-1, options)
+}
+
+// 前进
+func (tab *Tab) Forward(options ...NavigateOption) error {
+	return tab.traverse(1, options)
+}
+
+// 重新加载页面
+func (tab *Tab) Reload(options ...NavigateOption) error {
+	var o = newNavigateOptions(options)
+	return tab.awaitNavigation(o, func(ctx context.Context) error {
+		_, err := Do(ctx, tab, page.ReloadCommand, page.ReloadParams{IgnoreCache: o.ignoreCache})
+		return err
+	})
+}
+
+func (tab *Tab) traverse(delta int, options []NavigateOption) error {
+	entries, current, err := tab.History()
+	if err != nil {
+		return err
+	}
+	index := current + delta
+	if index < 0 || index >= len(entries) {
+		return ErrNoHistory
+	}
+	return tab.awaitNavigation(newNavigateOptions(options), func(ctx context.Context) error {
+		_, err := Do(ctx, tab, page.NavigateToHistoryEntryCommand, page.NavigateToHistoryEntryParams{
+			EntryId: entries[index].Id,
+		})
+		return err
+	})
+}
+
+// awaitNavigation runs an action navigating the main frame, and waits for
+// the lifecycle events of the new document. Same document navigations such
+// as hash changes or history.pushState fire no load event, they end the
+// wait as soon as the frame navigated within the document.
+func (tab *Tab) awaitNavigation(o navigateOptions, action func(ctx context.Context) error) error {
+	ctx, cancel := tab.context()
+	defer cancel()
+	release, err := tab.acquire(ctx, "Page")
+	if err != nil {
+		return err
+	}
+	defer release()
+	if _, err := Do(ctx, tab, page.SetLifecycleEventsEnabledCommand, page.SetLifecycleEventsEnabledParams{Enabled: true}); err != nil {
+		return err
+	}
+	tree, err := Do(ctx, tab, page.GetFrameTreeCommand, page.GetFrameTreeParams{})
+	if err != nil {
+		return err
+	}
+	main := tree.FrameTree.Frame.Id
+	events := tab.subscribe(page.FrameNavigatedEvent, page.NavigatedWithinDocumentEvent, page.LifecycleEventEvent)
+	defer events.close()
+	if err := action(ctx); err != nil {
+		return err
+	}
+	// 生命周期事件可能早于frameNavigated
+	var loaderId network.LoaderId
+	var fired = make(map[network.LoaderId]map[LifecycleEvent]bool)
+	for {
+		msg, err := events.next(ctx)
+		if err != nil {
+			return err
+		}
+		switch msg.Method {
+		case page.NavigatedWithinDocumentEvent:
+			var within page.NavigatedWithinDocumentParams
+			if err := json.Unmarshal(msg.Params, &within); err != nil {
+				return err
+			}
+			if within.FrameId == main {
+				return nil
+			}
+		case page.FrameNavigatedEvent:
+			var navigated page.FrameNavigatedParams
+			if err := json.Unmarshal(msg.Params, &navigated); err != nil {
+				return err
+			}
+			if navigated.Frame.Id == main {
+				loaderId = navigated.Frame.LoaderId
+			}
+		case page.LifecycleEventEvent:
+			var lifecycle page.LifecycleEventParams
+			if err := json.Unmarshal(msg.Params, &lifecycle); err != nil {
+				return err
+			}
+			if lifecycle.FrameId != main {
+				continue
+			}
+			if fired[lifecycle.LoaderId] == nil {
+				fired[lifecycle.LoaderId] = make(map[LifecycleEvent]bool)
+			}
+			fired[lifecycle.LoaderId][LifecycleEvent(lifecycle.Name)] = true
+		}
+		if loaderId == "" {
+			continue
+		}
+		var done = true
+		for _, e := range o.waitUntil {
+			done = done && fired[loaderId][e]
+		}
+		if done {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package cuto
+
+import (
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/page"
+)
+
+func TestHistory(t *testing.T) {
+	var reloaded bool
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case page.GetFrameTree:
+			return map[string]interface{}{"frameTree": map[string]interface{}{"frame": map[string]interface{}{"id": "main"}}}, nil
+		case page.GetNavigationHistory:
+			return map[string]interface{}{"currentIndex": 1, "entries": []interface{}{
+				map[string]interface{}{"id": 1, "url": "https://www.baidu.com/"},
+				map[string]interface{}{"id": 2, "url": "https://www.baidu.com/#s"},
+			}}, nil
+		case page.NavigateToHistoryEntry:
+			// hash跳转没有load事件
+			emit(page.NavigatedWithinDocumentEvent, map[string]interface{}{"frameId": "main", "url": "https://www.baidu.com/"})
+		case page.Reload:
+			reloaded = params["ignoreCache"] == true
+			emit(page.LifecycleEventEvent, map[string]interface{}{"frameId": "main", "loaderId": "next", "name": "load"})
+			emit(page.FrameNavigatedEvent, map[string]interface{}{"frame": map[string]interface{}{"id": "main", "loaderId": "next"}})
+		}
+		return map[string]interface{}{}, nil
+	})
+	if err := tab.Back(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tab.Forward(); err != ErrNoHistory {
+		t.Errorf("expected %v, got %v", ErrNoHistory, err)
+	}
+	if err := tab.Reload(IgnoreCache()); err != nil {
+		t.Fatal(err)
+	}
+	if !reloaded {
+		t.Error("reload did not ignore cache")
+	}
+}
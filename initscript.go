@@ -0,0 +1,225 @@
+package cuto
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+	"github.com/diiyw/cuto/protocol/target"
+)
+
+// 浏览器级的初始化脚本不存在
+var ErrNoInitScript = errors.New("cuto: no init script")
+
+// initScripts are the scripts added to every page of the browser. Pages
+// are attached through a connection to the browser target, new pages and
+// popups wait until the scripts are added.
+type initScripts struct {
+	mu      sync.Mutex
+	conn    *Tab
+	seq     int
+	sources map[int]string
+	// 每个页面会话中脚本的标识
+	pages map[target.SessionID]map[int]page.ScriptIdentifier
+}
+
+// tabScripts are the init scripts added to a tab. Each holds the Page
+// domain, disabling it would drop the scripts.
+type tabScripts struct {
+	mu       sync.Mutex
+	releases map[page.ScriptIdentifier]func()
+}
+
+// 添加初始化脚本，每次导航后在页面的脚本之前运行
+func (tab *Tab) AddInitScript(source string) (page.ScriptIdentifier, error) {
+	ctx, cancel := tab.context()
+	defer cancel()
+	release, err := tab.acquire(ctx, "Page")
+	if err != nil {
+		return "", err
+	}
+	result, err := Do(ctx, tab, page.AddScriptToEvaluateOnNewDocumentCommand, page.AddScriptToEvaluateOnNewDocumentParams{Source: source})
+	if err != nil {
+		release()
+		return "", err
+	}
+	tab.scripts.mu.Lock()
+	defer tab.scripts.mu.Unlock()
+	if tab.scripts.releases == nil {
+		tab.scripts.releases = make(map[page.ScriptIdentifier]func())
+	}
+	tab.scripts.releases[result.Identifier] = release
+	return result.Identifier, nil
+}
+
+// 移除初始化脚本
+func (tab *Tab) RemoveInitScript(id page.ScriptIdentifier) error {
+	ctx, cancel := tab.context()
+	defer cancel()
+	_, err := Do(ctx, tab, page.RemoveScriptToEvaluateOnNewDocumentCommand, page.RemoveScriptToEvaluateOnNewDocumentParams{Identifier: id})
+	if err != nil {
+		return err
+	}
+	tab.scripts.mu.Lock()
+	release := tab.scripts.releases[id]
+	delete(tab.scripts.releases, id)
+	tab.scripts.mu.Unlock()
+	if release != nil {
+		release()
+	}
+	return nil
+}
+
+// AddInitScript adds a script to every page of the browser, including tabs
+// and popups opened later. It runs before the scripts of the page on every
+// navigation.
+func (b *Browser) AddInitScript(source string) (int, error) {
+	b.scripts.mu.Lock()
+	defer b.scripts.mu.Unlock()
+	if b.scripts.conn == nil {
+		if err := b.connectBrowser(); err != nil {
+			return 0, err
+		}
+	}
+	b.scripts.seq++
+	id := b.scripts.seq
+	b.scripts.sources[id] = source
+	for sid, ids := range b.scripts.pages {
+		if err := b.addPageScript(sid, ids, id); err != nil {
+			// 页面已关闭
+			delete(b.scripts.pages, sid)
+		}
+	}
+	return id, nil
+}
+
+// RemoveInitScript removes a script added by AddInitScript from every page.
+func (b *Browser) RemoveInitScript(id int) error {
+	b.scripts.mu.Lock()
+	defer b.scripts.mu.Unlock()
+	if _, ok := b.scripts.sources[id]; !ok {
+		return fmt.Errorf("%w %d", ErrNoInitScript, id)
+	}
+	delete(b.scripts.sources, id)
+	for sid, ids := range b.scripts.pages {
+		identifier, ok := ids[id]
+		if !ok {
+			continue
+		}
+		delete(ids, id)
+		ctx, cancel := b.scripts.conn.context()
+		_, err := Do(withSession(ctx, sid), b.scripts.conn, page.RemoveScriptToEvaluateOnNewDocumentCommand, page.RemoveScriptToEvaluateOnNewDocumentParams{Identifier: identifier})
+		cancel()
+		if err != nil {
+			delete(b.scripts.pages, sid)
+		}
+	}
+	return nil
+}
+
+// connectBrowser connects to the browser target.
+func (b *Browser) connectBrowser() error {
+	r, err := http.Get("http://" + b.remoteAddr + "/json/version")
+	if err != nil {
+		return errors.New("Http request error:" + err.Error())
+	}
+	defer r.Body.Close()
+	var version struct {
+		WebSocketDebuggerUrl string `json:"webSocketDebuggerUrl"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&version); err != nil {
+		return err
+	}
+	conn := &Tab{WebSocketDebuggerUrl: version.WebSocketDebuggerUrl}
+	if err := conn.connect(b); err != nil {
+		return err
+	}
+	return b.watchPages(conn)
+}
+
+// watchPages attaches the pages of the browser to conn, existing ones and
+// the ones created later.
+func (b *Browser) watchPages(conn *Tab) error {
+	b.scripts.conn = conn
+	if b.scripts.sources == nil {
+		b.scripts.sources = make(map[int]string)
+		b.scripts.pages = make(map[target.SessionID]map[int]page.ScriptIdentifier)
+	}
+	conn.On(target.AttachedToTargetEvent, func(params json.RawMessage) {
+		var attached target.AttachedToTargetParams
+		if err := json.Unmarshal(params, &attached); err != nil {
+			return
+		}
+		// 不能在读取连接的协程中等待响应
+		go b.pageAttached(attached)
+	})
+	conn.On(target.DetachedFromTargetEvent, func(params json.RawMessage) {
+		var detached target.DetachedFromTargetParams
+		if err := json.Unmarshal(params, &detached); err != nil {
+			return
+		}
+		go func() {
+			b.scripts.mu.Lock()
+			delete(b.scripts.pages, detached.SessionId)
+			b.scripts.mu.Unlock()
+		}()
+	})
+	ctx, cancel := conn.context()
+	defer cancel()
+	_, err := Do(ctx, conn, target.SetAutoAttachCommand, target.SetAutoAttachParams{
+		AutoAttach:             true,
+		WaitForDebuggerOnStart: true,
+		Flatten:                true,
+	})
+	return err
+}
+
+// pageAttached adds the scripts to a page before resuming it, other
+// targets are resumed and detached.
+func (b *Browser) pageAttached(attached target.AttachedToTargetParams) {
+	conn, sid := b.scripts.conn, attached.SessionId
+	ctx, cancel := conn.context()
+	defer cancel()
+	if attached.TargetInfo.Type != "page" {
+		_, _ = Do(withSession(ctx, sid), conn, runtime.RunIfWaitingForDebuggerCommand, runtime.RunIfWaitingForDebuggerParams{})
+		_, _ = Do(ctx, conn, target.DetachFromTargetCommand, target.DetachFromTargetParams{SessionId: sid})
+		return
+	}
+	b.scripts.mu.Lock()
+	if _, err := Do(withSession(ctx, sid), conn, page.EnableCommand, page.EnableParams{}); err == nil {
+		var ids = make(map[int]page.ScriptIdentifier)
+		var order = make([]int, 0, len(b.scripts.sources))
+		for id := range b.scripts.sources {
+			order = append(order, id)
+		}
+		// 按添加的顺序运行
+		sort.Ints(order)
+		var err error
+		for _, id := range order {
+			if err = b.addPageScript(sid, ids, id); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			b.scripts.pages[sid] = ids
+		}
+	}
+	b.scripts.mu.Unlock()
+	_, _ = Do(withSession(ctx, sid), conn, runtime.RunIfWaitingForDebuggerCommand, runtime.RunIfWaitingForDebuggerParams{})
+}
+
+func (b *Browser) addPageScript(sid target.SessionID, ids map[int]page.ScriptIdentifier, id int) error {
+	ctx, cancel := b.scripts.conn.context()
+	defer cancel()
+	result, err := Do(withSession(ctx, sid), b.scripts.conn, page.AddScriptToEvaluateOnNewDocumentCommand, page.AddScriptToEvaluateOnNewDocumentParams{Source: b.scripts.sources[id]})
+	if err != nil {
+		return err
+	}
+	ids[id] = result.Identifier
+	return nil
+}
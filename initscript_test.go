@@ -0,0 +1,164 @@
+package cuto
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+	"github.com/diiyw/cuto/protocol/target"
+)
+
+func TestInitScripts(t *testing.T) {
+	var mu sync.Mutex
+	var sent []string
+	var seq int
+	var resumed = make(chan string, 4)
+	var detached = make(chan string, 4)
+	handler := func(emit func(string, interface{}), session, method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case page.AddScriptToEvaluateOnNewDocument:
+			seq++
+			sent = append(sent, session+" add "+params["source"].(string))
+			return map[string]interface{}{"identifier": strconv.Itoa(seq)}, nil
+		case page.RemoveScriptToEvaluateOnNewDocument:
+			sent = append(sent, session+" remove "+params["identifier"].(string))
+		case target.SetAutoAttach:
+			if params["waitForDebuggerOnStart"] != true || params["flatten"] != true {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			sent = append(sent, session+" "+method)
+		case target.DetachFromTarget:
+			detached <- params["sessionId"].(string)
+		case runtime.RunIfWaitingForDebugger:
+			resumed <- session
+		}
+		return map[string]interface{}{}, nil
+	}
+
+	tab := devtoolsSessions(t, &Browser{}, handler)
+	id, err := tab.AddInitScript("Math.random = () => 0.5")
+	if err != nil || id != "1" {
+		t.Fatalf("AddInitScript() = %q, %v", id, err)
+	}
+	if err := tab.RemoveInitScript(id); err != nil {
+		t.Fatal(err)
+	}
+
+	b := &Browser{}
+	conn := devtoolsSessions(t, b, handler)
+	if err := b.watchPages(conn); err != nil {
+		t.Fatal(err)
+	}
+	first, err := b.AddInitScript("window.first = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	attach := func(sid, kind string) {
+		params, _ := json.Marshal(map[string]interface{}{
+			"sessionId":          sid,
+			"targetInfo":         map[string]interface{}{"targetId": sid, "type": kind},
+			"waitingForDebugger": true,
+		})
+		conn.emit("", target.AttachedToTargetEvent, params)
+		select {
+		case session := <-resumed:
+			if session != sid {
+				t.Errorf("resumed %s, expected %s", session, sid)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%s not resumed", sid)
+		}
+	}
+	attach("popup", "page")
+	attach("worker", "service_worker")
+	select {
+	case sid := <-detached:
+		if sid != "worker" {
+			t.Errorf("detached %s", sid)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("worker not detached")
+	}
+	second, err := b.AddInitScript("window.second = 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.RemoveInitScript(first); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.RemoveInitScript(first); !errors.Is(err, ErrNoInitScript) {
+		t.Errorf("unexpected error %v", err)
+	}
+	params, _ := json.Marshal(map[string]interface{}{"sessionId": "popup"})
+	conn.emit("", target.DetachedFromTargetEvent, params)
+	time.Sleep(50 * time.Millisecond)
+	if err := b.RemoveInitScript(second); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{
+		" add Math.random = () => 0.5",
+		" remove 1",
+		" " + target.SetAutoAttach,
+		"popup add window.first = 1",
+		"popup add window.second = 2",
+		"popup remove 2",
+	}
+	if !reflect.DeepEqual(sent, expected) {
+		t.Errorf("sent %q", sent)
+	}
+}
+
+func TestInitScriptKeepsPage(t *testing.T) {
+	var mu sync.Mutex
+	var disabled int
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case page.AddScriptToEvaluateOnNewDocument:
+			return map[string]interface{}{"identifier": "1"}, nil
+		case page.Navigate:
+			// 同文档跳转，不等待生命周期事件
+			return map[string]interface{}{"frameId": "main"}, nil
+		case page.Disable:
+			mu.Lock()
+			disabled++
+			mu.Unlock()
+		}
+		return map[string]interface{}{}, nil
+	})
+	id, err := tab.AddInitScript("window.cuto = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, url := range []string{"https://www.baidu.com/#1", "https://www.baidu.com/#2"} {
+		if _, err := tab.Navigate(url); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mu.Lock()
+	if disabled != 0 {
+		t.Errorf("Page disabled %d times while an init script is registered", disabled)
+	}
+	mu.Unlock()
+	if err := tab.RemoveInitScript(id); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if disabled != 1 {
+		t.Errorf("Page disabled %d times after removing the init script", disabled)
+	}
+	if domains := tab.Domains(); len(domains) != 0 {
+		t.Errorf("domains %v still enabled", domains)
+	}
+}
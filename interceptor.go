@@ -0,0 +1,158 @@
+package cuto
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/diiyw/cuto/protocol/network"
+)
+
+// Invoker executes a command and returns its raw result.
+type Invoker func(ctx context.Context, method string, params interface{}) (json.RawMessage, error)
+
+// Dispatcher delivers an event to the tab.
+type Dispatcher func(method string, params json.RawMessage)
+
+// Interceptor wraps command execution and event dispatch of a tab.
+// Implementations call invoke/dispatch to continue the chain, and may
+// inspect or replace the values passed along.
+type Interceptor interface {
+	Command(ctx context.Context, method string, params interface{}, invoke Invoker) (json.RawMessage, error)
+	Event(method string, params json.RawMessage, dispatch Dispatcher)
+}
+
+// invoke runs a command through the interceptors of the tab, the first
+// interceptor being the outermost one.
+func (tab *Tab) invoke(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	var next Invoker = tab.transport
+	for i := len(tab.interceptors) - 1; i >= 0; i-- {
+		interceptor, invoke := tab.interceptors[i], next
+		next = func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+			return interceptor.Command(ctx, method, params, invoke)
+		}
+	}
+	return next(ctx, method, params)
+}
+
+// transport writes a command to the connection and waits for its response.
+func (tab *Tab) transport(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	if tab.validate {
+		if err := validate(method, params); err != nil {
+			return nil, err
+		}
+	}
+	id, p, err := tab.Channel.send(sessionOf(ctx), method, params)
+	if err != nil {
+		return nil, err
+	}
+	defer tab.Channel.forget(id)
+	msg, err := tab.Channel.wait(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Error != nil {
+		return nil, msg.Error
+	}
+	return msg.Result, nil
+}
+
+// dispatch passes an event through the interceptors of the tab.
+func (tab *Tab) dispatch(msg *message) {
+	var next Dispatcher = func(method string, params json.RawMessage) {
+		if tab.debug {
+			log.Println("Event:", method, string(params))
+		}
+		tab.emit(msg.SessionId, method, params)
+	}
+	for i := len(tab.interceptors) - 1; i >= 0; i-- {
+		interceptor, dispatch := tab.interceptors[i], next
+		next = func(method string, params json.RawMessage) {
+			interceptor.Event(method, params, dispatch)
+		}
+	}
+	next(msg.Method, msg.Params)
+}
+
+// 默认脱敏的参数名
+var DefaultRedacted = []string{"cookie", "cookies", "set-cookie", "authorization", "proxy-authorization", "password"}
+
+// cookieMethods carry cookies in plain fields such as name and value, their
+// params and results are masked as a whole. The Storage ones come with newer
+// browsers and are only reachable through Send.
+var cookieMethods = map[string]bool{
+	network.SetCookie:     true,
+	network.SetCookies:    true,
+	network.GetCookies:    true,
+	network.GetAllCookies: true,
+	"Storage.getCookies":  true,
+	"Storage.setCookies":  true,
+}
+
+// redact returns a copy of v, as decoded JSON, with the values of the given
+// keys masked at any depth. Keys are matched case-insensitively. The values
+// of cookie methods are masked whole.
+func redact(method string, v interface{}, keys []string) interface{} {
+	if len(keys) == 0 {
+		keys = DefaultRedacted
+	}
+	if v == nil {
+		return nil
+	}
+	if cookieMethods[method] {
+		return "[REDACTED]"
+	}
+	var value interface{}
+	switch data := v.(type) {
+	case json.RawMessage:
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil
+		}
+	default:
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil
+		}
+		if err := json.Unmarshal(b, &value); err != nil {
+			return nil
+		}
+	}
+	return redactValue(value, keys)
+}
+
+// redactValue masks the values of the keys in decoded JSON. Entries like
+// {"name": "Authorization", "value": "…"}, e.g. headers of the Fetch domain,
+// have their value masked when the name is one of the keys.
+func redactValue(value interface{}, keys []string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if name, ok := v["name"].(string); ok && redacted(name, keys) {
+			if _, ok := v["value"]; ok {
+				v["value"] = "[REDACTED]"
+			}
+		}
+		for k, item := range v {
+			if redacted(k, keys) {
+				v[k] = "[REDACTED]"
+				continue
+			}
+			v[k] = redactValue(item, keys)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, keys)
+		}
+	}
+	return value
+}
+
+// redacted reports whether a name is one of the keys, ignoring case.
+func redacted(name string, keys []string) bool {
+	for _, key := range keys {
+		if strings.EqualFold(name, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package cuto
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/network"
+)
+
+type recorder struct {
+	name  string
+	calls *[]string
+}
+
+func (r recorder) Command(ctx context.Context, method string, params interface{}, invoke Invoker) (json.RawMessage, error) {
+	*r.calls = append(*r.calls, r.name+">"+method)
+	result, err := invoke(ctx, method, params)
+	*r.calls = append(*r.calls, r.name+"<"+method)
+	return result, err
+}
+
+func (r recorder) Event(method string, params json.RawMessage, dispatch Dispatcher) {
+	dispatch(method, params)
+}
+
+func TestIntercept(t *testing.T) {
+	var calls []string
+	var trace, logs bytes.Buffer
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		if method == network.GetCookies {
+			return map[string]interface{}{"cookies": []interface{}{map[string]interface{}{"name": "sid", "value": "secret"}}}, nil
+		}
+		return map[string]interface{}{}, nil
+	})
+	logger := slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	tab.interceptors = []Interceptor{recorder{"a", &calls}, recorder{"b", &calls}, Tracer(&trace), Logger(logger)}
+	_, err := Do(context.Background(), tab, network.SetCookieCommand, network.SetCookieParams{Name: "sid", Value: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected = []string{"a>" + network.SetCookie, "b>" + network.SetCookie, "b<" + network.SetCookie, "a<" + network.SetCookie}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("interceptors called as %v, expected %v", calls, expected)
+	}
+	if !strings.HasPrefix(trace.String(), "[\n") || !strings.Contains(trace.String(), `"name":"`+network.SetCookie+`"`) {
+		t.Errorf("unexpected trace %s", trace.String())
+	}
+	if _, err := Do(context.Background(), tab, network.GetCookiesCommand, network.GetCookiesParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(trace.String(), "secret") || strings.Contains(logs.String(), "secret") {
+		t.Errorf("cookie value leaked:\n%s\n%s", trace.String(), logs.String())
+	}
+}
+
+func TestRedact(t *testing.T) {
+	var params = map[string]interface{}{
+		"url":     "https://www.baidu.com",
+		"headers": map[string]interface{}{"Cookie": "sid=1", "Accept": "*/*"},
+		"cookies": []interface{}{map[string]interface{}{"name": "sid"}},
+	}
+	var expected = map[string]interface{}{
+		"url":     "https://www.baidu.com",
+		"headers": map[string]interface{}{"Cookie": "[REDACTED]", "Accept": "*/*"},
+		"cookies": "[REDACTED]",
+	}
+	if v := redact("Network.setExtraHTTPHeaders", params, nil); !reflect.DeepEqual(v, expected) {
+		t.Errorf("redact(%v) = %v", params, v)
+	}
+	if v := redact("Page.navigate", json.RawMessage(`{"password":"1","user":"cuto"}`), nil); !reflect.DeepEqual(v, map[string]interface{}{"password": "[REDACTED]", "user": "cuto"}) {
+		t.Errorf("unexpected redacted raw message %v", v)
+	}
+	// Fetch域的请求头为名称和值的列表
+	paused := json.RawMessage(`{"requestId":"1","responseHeaders":[{"name":"Authorization","value":"Bearer secret"},{"name":"Accept","value":"*/*"}]}`)
+	expected = map[string]interface{}{
+		"requestId": "1",
+		"responseHeaders": []interface{}{
+			map[string]interface{}{"name": "Authorization", "value": "[REDACTED]"},
+			map[string]interface{}{"name": "Accept", "value": "*/*"},
+		},
+	}
+	if v := redact("Fetch.requestPaused", paused, nil); !reflect.DeepEqual(v, expected) {
+		t.Errorf("unexpected redacted header entries %v", v)
+	}
+}
@@ -0,0 +1,204 @@
+package cuto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/diiyw/cuto/protocol/input"
+)
+
+// 未知的按键
+var ErrUnknownKey = errors.New("cuto: unknown key")
+
+// 修饰键对应的位
+var modifierKeys = map[string]Modifier{
+	"Alt":     Alt,
+	"Control": Control,
+	"Meta":    Meta,
+	"Shift":   Shift,
+}
+
+// Keyboard dispatches key events to the focused element of the tab. Keys
+// are named as KeyboardEvent.key of the US layout, e.g. "a", "Enter",
+// "ArrowLeft" or "Shift"; modifiers held down also apply to the Mouse.
+type Keyboard struct {
+	tab *Tab
+
+	mu        sync.Mutex
+	modifiers Modifier
+	pressed   map[string]bool
+}
+
+// 标签的键盘
+func (tab *Tab) Keyboard() *Keyboard {
+	return &tab.keyboard
+}
+
+// current returns the modifiers held down.
+func (k *Keyboard) current() Modifier {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.modifiers
+}
+
+// describe returns the event of a key with the modifiers held down.
+func (k *Keyboard) describe(key string) (input.DispatchKeyEventParams, error) {
+	def, ok := usKeyboard[key]
+	if !ok {
+		return input.DispatchKeyEventParams{}, fmt.Errorf("%w %s", ErrUnknownKey, key)
+	}
+	var params = input.DispatchKeyEventParams{
+		Key:                   def.key,
+		Code:                  def.code,
+		WindowsVirtualKeyCode: def.keyCode,
+		Text:                  def.text,
+		Location:              def.location,
+	}
+	if k.modifiers&Shift != 0 && def.shiftKey != "" {
+		params.Key = def.shiftKey
+		params.Text = def.shiftText
+	}
+	params.UnmodifiedText = params.Text
+	// 快捷键不输入字符
+	if k.modifiers&^Shift != 0 {
+		params.Text = ""
+	}
+	return params, nil
+}
+
+func (k *Keyboard) down(ctx context.Context, key string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	params, err := k.describe(key)
+	if err != nil {
+		return err
+	}
+	if k.pressed == nil {
+		k.pressed = make(map[string]bool)
+	}
+	params.AutoRepeat = k.pressed[params.Code]
+	k.pressed[params.Code] = true
+	k.modifiers |= modifierKeys[params.Key]
+	params.Modifiers = int(k.modifiers)
+	params.Type = "rawKeyDown"
+	if params.Text != "" {
+		params.Type = "keyDown"
+	}
+	_, err = Do(ctx, k.tab, input.DispatchKeyEventCommand, params)
+	return err
+}
+
+func (k *Keyboard) up(ctx context.Context, key string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	params, err := k.describe(key)
+	if err != nil {
+		return err
+	}
+	delete(k.pressed, params.Code)
+	k.modifiers &^= modifierKeys[params.Key]
+	_, err = Do(ctx, k.tab, input.DispatchKeyEventCommand, input.DispatchKeyEventParams{
+		Type:                  "keyUp",
+		Modifiers:             int(k.modifiers),
+		Key:                   params.Key,
+		Code:                  params.Code,
+		WindowsVirtualKeyCode: params.WindowsVirtualKeyCode,
+		Location:              params.Location,
+	})
+	return err
+}
+
+// press presses the keys of a chord in order and releases them in
+// reverse order.
+func (k *Keyboard) press(ctx context.Context, chord string) error {
+	keys := splitChord(chord)
+	for i, key := range keys {
+		if err := k.down(ctx, key); err != nil {
+			// 松开已按下的键
+			for j := i - 1; j >= 0; j-- {
+				_ = k.up(ctx, keys[j])
+			}
+			return err
+		}
+	}
+	var err error
+	for i := len(keys) - 1; i >= 0; i-- {
+		if e := k.up(ctx, keys[i]); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// splitChord splits a chord like "Control+Shift+A", a trailing "+" is
+// the plus key itself.
+func splitChord(chord string) []string {
+	keys := strings.Split(chord, "+")
+	if len(keys) > 1 && keys[len(keys)-1] == "" {
+		keys = append(keys[:len(keys)-2], "+")
+	}
+	return keys
+}
+
+func (k *Keyboard) typeText(ctx context.Context, text string, delay time.Duration) error {
+	for i, r := range text {
+		if i > 0 && delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		var err error
+		if _, ok := usKeyboard[string(r)]; ok {
+			err = k.press(ctx, string(r))
+		} else {
+			// 布局外的字符，如中文
+			_, err = Do(ctx, k.tab, input.InsertTextCommand, input.InsertTextParams{Text: string(r)})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 按下按键
+func (k *Keyboard) Down(key string) error {
+	ctx, cancel := k.tab.context()
+	defer cancel()
+	return k.down(ctx, key)
+}
+
+// 松开按键
+func (k *Keyboard) Up(key string) error {
+	ctx, cancel := k.tab.context()
+	defer cancel()
+	return k.up(ctx, key)
+}
+
+// 按下并松开按键或组合键，如"Enter"、"Control+A"
+func (k *Keyboard) Press(chord string) error {
+	ctx, cancel := k.tab.context()
+	defer cancel()
+	return k.press(ctx, chord)
+}
+
+// 逐个字符输入文本，每个字符间隔delay
+func (k *Keyboard) Type(text string, delay time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout+time.Duration(len(text))*delay)
+	defer cancel()
+	return k.typeText(ctx, text, delay)
+}
+
+// 直接插入文本，不产生按键事件，如输入法
+func (k *Keyboard) InsertText(text string) error {
+	ctx, cancel := k.tab.context()
+	defer cancel()
+	_, err := Do(ctx, k.tab, input.InsertTextCommand, input.InsertTextParams{Text: text})
+	return err
+}
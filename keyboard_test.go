@@ -0,0 +1,78 @@
+package cuto
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/input"
+)
+
+func TestKeyboard(t *testing.T) {
+	var mu sync.Mutex
+	var events []string
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case input.DispatchKeyEvent:
+			text, _ := params["text"].(string)
+			modifiers, _ := params["modifiers"].(float64)
+			events = append(events, fmt.Sprintf("%s %s %s %s %g", params["type"], params["key"], params["code"], text, modifiers))
+		case input.InsertText:
+			events = append(events, "insertText "+params["text"].(string))
+		}
+		return map[string]interface{}{}, nil
+	})
+	keyboard := tab.Keyboard()
+	if err := keyboard.Press("Control+a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := keyboard.Down("Shift"); err != nil {
+		t.Fatal(err)
+	}
+	if err := keyboard.Type("1", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := keyboard.Up("Shift"); err != nil {
+		t.Fatal(err)
+	}
+	if err := keyboard.Type("b中", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := keyboard.Press("Hyper"); err == nil {
+		t.Error("pressed an unknown key")
+	}
+	var expected = []string{
+		"rawKeyDown Control ControlLeft  2",
+		"rawKeyDown a KeyA  2",
+		"keyUp a KeyA  2",
+		"keyUp Control ControlLeft  0",
+		"rawKeyDown Shift ShiftLeft  8",
+		"keyDown ! Digit1 ! 8",
+		"keyUp ! Digit1  8",
+		"keyUp Shift ShiftLeft  0",
+		"keyDown b KeyB b 0",
+		"keyUp b KeyB  0",
+		"insertText 中",
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("dispatched\n%q\nexpected\n%q", events, expected)
+	}
+}
+
+func TestSplitChord(t *testing.T) {
+	for chord, keys := range map[string][]string{
+		"Enter":           {"Enter"},
+		"Control+Shift+A": {"Control", "Shift", "A"},
+		"Control++":       {"Control", "+"},
+		"+":               {"+"},
+	} {
+		if got := splitChord(chord); !reflect.DeepEqual(got, keys) {
+			t.Errorf("splitChord(%q) = %q, expected %q", chord, got, keys)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package cuto
+
+import "strconv"
+
+// keyDefinition describes a key of the US keyboard layout as seen by the
+// DOM, the shift variants are used while Shift is down.
+type keyDefinition struct {
+	key       string
+	shiftKey  string
+	code      string
+	keyCode   int
+	text      string
+	shiftText string
+	location  int
+}
+
+// 美式键盘布局，按键名称或字符查找
+var usKeyboard = usKeyboardLayout()
+
+func usKeyboardLayout() map[string]keyDefinition {
+	var keys = map[string]keyDefinition{
+		"Backspace":    {key: "Backspace", code: "Backspace", keyCode: 8},
+		"Tab":          {key: "Tab", code: "Tab", keyCode: 9},
+		"Enter":        {key: "Enter", code: "Enter", keyCode: 13, text: "\r"},
+		"Escape":       {key: "Escape", code: "Escape", keyCode: 27},
+		"CapsLock":     {key: "CapsLock", code: "CapsLock", keyCode: 20},
+		"Pause":        {key: "Pause", code: "Pause", keyCode: 19},
+		"PageUp":       {key: "PageUp", code: "PageUp", keyCode: 33},
+		"PageDown":     {key: "PageDown", code: "PageDown", keyCode: 34},
+		"End":          {key: "End", code: "End", keyCode: 35},
+		"Home":         {key: "Home", code: "Home", keyCode: 36},
+		"ArrowLeft":    {key: "ArrowLeft", code: "ArrowLeft", keyCode: 37},
+		"ArrowUp":      {key: "ArrowUp", code: "ArrowUp", keyCode: 38},
+		"ArrowRight":   {key: "ArrowRight", code: "ArrowRight", keyCode: 39},
+		"ArrowDown":    {key: "ArrowDown", code: "ArrowDown", keyCode: 40},
+		"Insert":       {key: "Insert", code: "Insert", keyCode: 45},
+		"Delete":       {key: "Delete", code: "Delete", keyCode: 46},
+		"Shift":        {key: "Shift", code: "ShiftLeft", keyCode: 16, location: 1},
+		"Control":      {key: "Control", code: "ControlLeft", keyCode: 17, location: 1},
+		"Alt":          {key: "Alt", code: "AltLeft", keyCode: 18, location: 1},
+		"Meta":         {key: "Meta", code: "MetaLeft", keyCode: 91, location: 1},
+		"ShiftRight":   {key: "Shift", code: "ShiftRight", keyCode: 16, location: 2},
+		"ControlRight": {key: "Control", code: "ControlRight", keyCode: 17, location: 2},
+		"AltRight":     {key: "Alt", code: "AltRight", keyCode: 18, location: 2},
+		"MetaRight":    {key: "Meta", code: "MetaRight", keyCode: 92, location: 2},
+		"ContextMenu":  {key: "ContextMenu", code: "ContextMenu", keyCode: 93},
+		" ":            {key: " ", code: "Space", keyCode: 32, text: " "},
+		"\r":           {key: "Enter", code: "Enter", keyCode: 13, text: "\r"},
+		"\n":           {key: "Enter", code: "Enter", keyCode: 13, text: "\r"},
+		"\t":           {key: "Tab", code: "Tab", keyCode: 9},
+	}
+	for i := 1; i <= 12; i++ {
+		name := "F" + strconv.Itoa(i)
+		keys[name] = keyDefinition{key: name, code: name, keyCode: 111 + i}
+	}
+	// 字母
+	for c := 'a'; c <= 'z'; c++ {
+		lower, upper := string(c), string(c-'a'+'A')
+		def := keyDefinition{
+			key: lower, shiftKey: upper, code: "Key" + upper,
+			keyCode: int(c - 'a' + 'A'), text: lower, shiftText: upper,
+		}
+		keys[lower] = def
+		keys[upper] = keyDefinition{key: upper, code: def.code, keyCode: def.keyCode, text: upper}
+	}
+	// 数字和标点，按下Shift时的字符
+	var symbols = []struct {
+		key, shiftKey, code string
+		keyCode             int
+	}{
+		{"0", ")", "Digit0", 48}, {"1", "!", "Digit1", 49}, {"2", "@", "Digit2", 50},
+		{"3", "#", "Digit3", 51}, {"4", "$", "Digit4", 52}, {"5", "%", "Digit5", 53},
+		{"6", "^", "Digit6", 54}, {"7", "&", "Digit7", 55}, {"8", "*", "Digit8", 56},
+		{"9", "(", "Digit9", 57}, {";", ":", "Semicolon", 186}, {"=", "+", "Equal", 187},
+		{",", "<", "Comma", 188}, {"-", "_", "Minus", 189}, {".", ">", "Period", 190},
+		{"/", "?", "Slash", 191}, {"`", "~", "Backquote", 192}, {"[", "{", "BracketLeft", 219},
+		{"\\", "|", "Backslash", 220}, {"]", "}", "BracketRight", 221}, {"'", "\"", "Quote", 222},
+	}
+	for _, s := range symbols {
+		keys[s.key] = keyDefinition{
+			key: s.key, shiftKey: s.shiftKey, code: s.code,
+			keyCode: s.keyCode, text: s.key, shiftText: s.shiftKey,
+		}
+		keys[s.shiftKey] = keyDefinition{key: s.shiftKey, code: s.code, keyCode: s.keyCode, text: s.shiftKey}
+	}
+	return keys
+}
@@ -0,0 +1,327 @@
+package cuto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Locator finds elements lazily: it only stores a chain of selectors and
+// resolves it again on every action, so it never goes stale. Actions wait
+// for the element to become actionable, see Click.
+type Locator struct {
+	tab *Tab
+	// 为空时使用当前的主框架
+	frame   *Frame
+	steps   []locatorStep
+	timeout time.Duration
+}
+
+// locatorStep is one selector of the chain, applied to the elements
+// matched by the previous ones.
+type locatorStep struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value,omitempty"`
+	Index int    `json:"index"`
+}
+
+// 重试的间隔
+const locatorInterval = 100 * time.Millisecond
+
+// 按选择器定位主框架的元素，选择器见Frame.Query
+func (tab *Tab) Locator(selector string) *Locator {
+	return (&Locator{tab: tab, timeout: defaultTimeout}).Locator(selector)
+}
+
+// 按选择器定位框架的元素，选择器见Frame.Query
+func (f *Frame) Locator(selector string) *Locator {
+	return (&Locator{tab: f.tab, frame: f, timeout: defaultTimeout}).Locator(selector)
+}
+
+func (l *Locator) with(step locatorStep) *Locator {
+	var next = *l
+	next.steps = append(append([]locatorStep(nil), l.steps...), step)
+	return &next
+}
+
+// 匹配元素内的选择器，选择器见Frame.Query
+func (l *Locator) Locator(selector string) *Locator {
+	return l.with(locatorStep{Kind: "css", Value: selector})
+}
+
+// 匹配元素内的XPath
+func (l *Locator) XPath(expression string) *Locator {
+	return l.with(locatorStep{Kind: "xpath", Value: expression})
+}
+
+// 匹配元素内包含文本的最内层元素，忽略大小写和多余空白
+func (l *Locator) ByText(text string) *Locator {
+	return l.with(locatorStep{Kind: "text", Value: text})
+}
+
+// 匹配元素内的ARIA角色，包括标签的隐含角色，如button、link、textbox
+func (l *Locator) ByRole(role string) *Locator {
+	return l.with(locatorStep{Kind: "role", Value: role})
+}
+
+// 第n个匹配的元素，从0开始，负数从末尾开始
+func (l *Locator) Nth(n int) *Locator {
+	return l.with(locatorStep{Kind: "nth", Index: n})
+}
+
+// 第一个匹配的元素
+func (l *Locator) First() *Locator {
+	return l.Nth(0)
+}
+
+// 最后一个匹配的元素
+func (l *Locator) Last() *Locator {
+	return l.Nth(-1)
+}
+
+// 只保留包含文本的元素
+func (l *Locator) HasText(text string) *Locator {
+	return l.with(locatorStep{Kind: "has-text", Value: text})
+}
+
+// 等待的超时时间
+func (l *Locator) Timeout(timeout time.Duration) *Locator {
+	var next = *l
+	next.timeout = timeout
+	return &next
+}
+
+func (l *Locator) String() string {
+	var parts = make([]string, 0, len(l.steps))
+	for _, s := range l.steps {
+		if s.Kind == "nth" {
+			parts = append(parts, fmt.Sprintf("nth=%d", s.Index))
+			continue
+		}
+		if s.Kind == "css" && hasEngine(s.Value) {
+			parts = append(parts, s.Value)
+			continue
+		}
+		parts = append(parts, s.Kind+"="+s.Value)
+	}
+	return strings.Join(parts, " >> ")
+}
+
+// locateScript resolves the chain of selectors in the page.
+const locateScript = `function(steps, first){
+	const queryAll = ` + queryAllScript + `;
+	const norm = s => (s || '').replace(/\s+/g, ' ').trim().toLowerCase();
+	const implicit = {
+		a: e => e.hasAttribute('href') ? 'link' : '',
+		button: () => 'button',
+		h1: () => 'heading', h2: () => 'heading', h3: () => 'heading',
+		h4: () => 'heading', h5: () => 'heading', h6: () => 'heading',
+		img: () => 'img', ul: () => 'list', ol: () => 'list', li: () => 'listitem',
+		select: () => 'combobox', textarea: () => 'textbox', table: () => 'table',
+		form: () => 'form', nav: () => 'navigation', dialog: () => 'dialog',
+		input: e => {
+			const r = {button: 'button', submit: 'button', reset: 'button', image: 'button',
+				checkbox: 'checkbox', radio: 'radio', range: 'slider', number: 'spinbutton',
+				search: 'searchbox', hidden: ''}[e.type];
+			return r === undefined ? 'textbox' : r;
+		},
+	};
+	const role = e => e.getAttribute('role') || (implicit[e.localName] || (() => ''))(e);
+	let nodes = [document];
+	for (const step of steps) {
+		let next = [];
+		for (const node of nodes) {
+			switch (step.kind) {
+			case 'css':
+				next.push(...queryAll(step.value, node));
+				break;
+			case 'xpath':
+			case 'text':
+				next.push(...queryAll(step.kind + '=' + step.value, node));
+				break;
+			case 'role':
+				for (const e of queryAll('*', node)) if (role(e) === step.value) next.push(e);
+				break;
+			case 'has-text':
+				if (norm(node.textContent).includes(norm(step.value))) next.push(node);
+				break;
+			}
+		}
+		if (step.kind === 'nth') {
+			const i = step.index < 0 ? nodes.length + step.index : step.index;
+			next = i >= 0 && i < nodes.length ? [nodes[i]] : [];
+		}
+		nodes = Array.from(new Set(next));
+	}
+	nodes = nodes.filter(n => n.nodeType === Node.ELEMENT_NODE);
+	return first ? nodes.slice(0, 1) : nodes;
+}`
+
+// actionableScript returns why the element cannot be acted on yet, the
+// element is stable when its box does not move between two frames.
+const actionableScript = `async function(){
+	if (!this.isConnected) return 'not attached';
+	const box = () => { const r = this.getBoundingClientRect(); return [r.x, r.y, r.width, r.height].join(); };
+	const style = getComputedStyle(this);
+	const r = this.getBoundingClientRect();
+	if (style.visibility !== 'visible' || r.width === 0 || r.height === 0) return 'not visible';
+	if (this.disabled || this.closest('fieldset:disabled') || this.getAttribute('aria-disabled') === 'true') return 'not enabled';
+	const before = box();
+	await new Promise(resolve => requestAnimationFrame(() => requestAnimationFrame(resolve)));
+	if (box() !== before) return 'not stable';
+	return '';
+}`
+
+func (l *Locator) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), l.timeout)
+}
+
+// resolve returns the elements currently matched by the chain, only the
+// first one when first is set.
+func (l *Locator) resolve(ctx context.Context, first bool) ([]*Element, error) {
+	var frame = l.frame
+	if frame == nil {
+		main, err := l.tab.MainFrame()
+		if err != nil {
+			return nil, err
+		}
+		frame = main
+	}
+	return frame.queryElements(ctx, locateScript, l.steps, first)
+}
+
+// wait retries until the first matched element passes the checks and
+// returns it, the caller releases it.
+func (l *Locator) wait(actionable bool) (*Element, error) {
+	var found *Element
+	err := l.retry(actionable, func(ctx context.Context, e *Element) error {
+		found = e
+		return nil
+	})
+	return found, err
+}
+
+// act waits until the first matched element passes the checks and runs
+// action on it within the timeout of the locator, retrying while another
+// node covers the element, e.g. an overlay fading out.
+func (l *Locator) act(actionable bool, action func(ctx context.Context, e *Element) error) error {
+	return l.retry(actionable, func(ctx context.Context, e *Element) error {
+		defer e.Release()
+		return action(ctx, e)
+	})
+}
+
+// retry passes the first matched element to fn once it passes the checks,
+// fn takes over the element. Errors of fn end the retries, except
+// ErrObscured.
+func (l *Locator) retry(actionable bool, fn func(ctx context.Context, e *Element) error) error {
+	ctx, cancel := l.context()
+	defer cancel()
+	var reason string
+	for {
+		elements, err := l.resolve(ctx, true)
+		if errors.Is(err, ErrDetached) {
+			return err
+		}
+		reason = "not attached"
+		if err == nil && len(elements) > 0 {
+			e := elements[0]
+			reason = ""
+			if actionable {
+				err = e.call(ctx, actionableScript, &reason)
+			}
+			if err == nil && reason == "" {
+				err = fn(ctx, e)
+				if !errors.Is(err, ErrObscured) {
+					return err
+				}
+			} else {
+				_ = e.Release()
+			}
+		}
+		if err != nil {
+			// 导航期间执行上下文会被销毁，重试即可
+			reason = err.Error()
+		}
+		select {
+		case <-time.After(locatorInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("cuto: locator %s: %s: %w", l, reason, ctx.Err())
+		}
+	}
+}
+
+// 等待元素出现并返回，元素用完后需释放
+func (l *Locator) Element() (*Element, error) {
+	return l.wait(false)
+}
+
+// 等待元素可见、稳定且可用
+func (l *Locator) Wait() error {
+	e, err := l.wait(true)
+	if err != nil {
+		return err
+	}
+	return e.Release()
+}
+
+// 当前匹配的元素数量，不等待
+func (l *Locator) Count() (int, error) {
+	ctx, cancel := l.context()
+	defer cancel()
+	elements, err := l.resolve(ctx, false)
+	for _, e := range elements {
+		_ = e.Release()
+	}
+	return len(elements), err
+}
+
+// 等待元素可操作后点击，元素被遮挡时重试
+func (l *Locator) Click(options ...MouseOption) error {
+	return l.act(true, func(ctx context.Context, e *Element) error {
+		return e.click(ctx, newMouseOptions(options))
+	})
+}
+
+// 等待元素可操作后轻触，元素被遮挡时重试
+func (l *Locator) Tap() error {
+	return l.act(true, func(ctx context.Context, e *Element) error {
+		return e.tap(ctx)
+	})
+}
+
+// 等待元素可见后鼠标移动到元素上，元素被遮挡时重试
+func (l *Locator) Hover() error {
+	return l.act(true, func(ctx context.Context, e *Element) error {
+		return e.hover(ctx)
+	})
+}
+
+// 等待元素可操作后输入文本
+func (l *Locator) Type(text string) error {
+	return l.act(true, func(ctx context.Context, e *Element) error {
+		return e.typeText(ctx, text)
+	})
+}
+
+// 等待元素出现后获取文本
+func (l *Locator) Text() (string, error) {
+	var text string
+	err := l.act(false, func(ctx context.Context, e *Element) (err error) {
+		text, err = e.text(ctx)
+		return err
+	})
+	return text, err
+}
+
+// 等待元素出现后获取属性
+func (l *Locator) Attribute(name string) (string, error) {
+	var value string
+	err := l.act(false, func(ctx context.Context, e *Element) (err error) {
+		value, err = e.attribute(ctx, name)
+		return err
+	})
+	return value, err
+}
@@ -0,0 +1,93 @@
+package cuto
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/input"
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestLocator(t *testing.T) {
+	var mu sync.Mutex
+	var resolved, checked, hits int
+	var clicked bool
+	var steps interface{}
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case runtime.CallFunctionOn:
+			function := params["functionDeclaration"].(string)
+			switch {
+			case function == locateScript:
+				resolved++
+				steps = params["arguments"].([]interface{})[0].(map[string]interface{})["value"]
+				return map[string]interface{}{"result": map[string]interface{}{"type": "object", "subtype": "array", "objectId": "array"}}, nil
+			case function == actionableScript:
+				checked++
+				if checked == 1 {
+					return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": "not visible"}}, nil
+				}
+				return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": ""}}, nil
+			case function == containsScript:
+				return map[string]interface{}{"result": map[string]interface{}{"type": "boolean", "value": false}}, nil
+			}
+			return map[string]interface{}{"result": map[string]interface{}{"type": "undefined"}}, nil
+		case dom.GetContentQuads:
+			return map[string]interface{}{"quads": []interface{}{[]float64{10, 20, 110, 20, 110, 70, 10, 70}}}, nil
+		case dom.GetNodeForLocation:
+			if params["x"] != float64(60) || params["y"] != float64(45) {
+				return nil, &RemoteError{Code: -32000, Message: "No node found at given location"}
+			}
+			// 第一次点击时被正在淡出的遮罩挡住
+			hits++
+			if hits == 1 {
+				return map[string]interface{}{"backendNodeId": 6}, nil
+			}
+			return map[string]interface{}{"backendNodeId": 5}, nil
+		case dom.ResolveNode:
+			return map[string]interface{}{"object": map[string]interface{}{"type": "object", "subtype": "node", "objectId": "overlay"}}, nil
+		case dom.DescribeNode:
+			return map[string]interface{}{"node": map[string]interface{}{"backendNodeId": 5}}, nil
+		case input.DispatchMouseEvent:
+			if params["type"] == "mouseReleased" && params["x"] == float64(60) && params["y"] == float64(45) {
+				clicked = true
+			}
+		case runtime.GetProperties:
+			// 第一次解析时元素还不存在
+			if resolved == 1 {
+				return map[string]interface{}{"result": []interface{}{}}, nil
+			}
+			return map[string]interface{}{"result": []interface{}{
+				map[string]interface{}{"name": "0", "value": map[string]interface{}{"type": "object", "subtype": "node", "objectId": "e1"}},
+			}}, nil
+		}
+		return map[string]interface{}{}, nil
+	}))
+	submit := tab.Locator("form").ByRole("button").HasText("百度一下").First()
+	if s := submit.String(); s != "css=form >> role=button >> has-text=百度一下 >> nth=0" {
+		t.Errorf("String() = %q", s)
+	}
+	if err := submit.Click(); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	if !clicked || resolved != 4 || checked != 3 || hits != 2 {
+		t.Errorf("clicked %v after %d resolves, %d checks and %d hit tests", clicked, resolved, checked, hits)
+	}
+	if last := steps.([]interface{})[3].(map[string]interface{}); last["kind"] != "nth" || last["index"] != float64(0) {
+		t.Errorf("unexpected step %v", last)
+	}
+	checked = 0
+	mu.Unlock()
+	err := submit.Timeout(50 * time.Millisecond).Wait()
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "not visible") {
+		t.Errorf("expected a timeout while not visible, got %v", err)
+	}
+}
@@ -0,0 +1,389 @@
+package cuto
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/diiyw/cuto/protocol/cdp"
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/input"
+)
+
+// 鼠标按键
+type MouseButton string
+
+const (
+	LeftButton    MouseButton = "left"
+	RightButton   MouseButton = "right"
+	MiddleButton  MouseButton = "middle"
+	BackButton    MouseButton = "back"
+	ForwardButton MouseButton = "forward"
+)
+
+// 按键对应的buttons位
+var buttonBits = map[MouseButton]int{
+	LeftButton:    1,
+	RightButton:   2,
+	MiddleButton:  4,
+	BackButton:    8,
+	ForwardButton: 16,
+}
+
+// 修饰键
+type Modifier int
+
+const (
+	Alt     Modifier = 1
+	Control Modifier = 2
+	Meta    Modifier = 4
+	Shift   Modifier = 8
+)
+
+type MouseOption func(o *mouseOptions)
+
+type mouseOptions struct {
+	button     MouseButton
+	clickCount int
+	modifiers  Modifier
+	steps      int
+	delay      time.Duration
+}
+
+func newMouseOptions(options []MouseOption) mouseOptions {
+	var o = mouseOptions{button: LeftButton, clickCount: 1, steps: 1}
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
+
+// Button sets the button to press, LeftButton by default.
+func Button(button MouseButton) MouseOption {
+	return func(o *mouseOptions) {
+		o.button = button
+	}
+}
+
+// ClickCount clicks n times, e.g. 2 for a double click.
+func ClickCount(n int) MouseOption {
+	return func(o *mouseOptions) {
+		o.clickCount = n
+	}
+}
+
+// Modifiers holds the modifier keys down during the events.
+func Modifiers(modifiers ...Modifier) MouseOption {
+	return func(o *mouseOptions) {
+		for _, m := range modifiers {
+			o.modifiers |= m
+		}
+	}
+}
+
+// Steps splits a move into n intermediate mousemove events.
+func Steps(n int) MouseOption {
+	return func(o *mouseOptions) {
+		o.steps = n
+	}
+}
+
+// Delay waits between pressing and releasing a button.
+func Delay(d time.Duration) MouseOption {
+	return func(o *mouseOptions) {
+		o.delay = d
+	}
+}
+
+// Mouse dispatches mouse events to the tab, coordinates are CSS pixels
+// relative to the viewport of the main frame.
+type Mouse struct {
+	tab *Tab
+
+	mu      sync.Mutex
+	x, y    float64
+	buttons int
+}
+
+// 标签的鼠标
+func (tab *Tab) Mouse() *Mouse {
+	return &tab.mouse
+}
+
+func (m *Mouse) dispatch(ctx context.Context, params input.DispatchMouseEventParams) error {
+	_, err := Do(ctx, m.tab, input.DispatchMouseEventCommand, params)
+	return err
+}
+
+func (m *Mouse) move(ctx context.Context, x, y float64, o mouseOptions) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fromX, fromY := m.x, m.y
+	var steps = o.steps
+	if steps < 1 {
+		steps = 1
+	}
+	for i := 1; i <= steps; i++ {
+		m.x = fromX + (x-fromX)*float64(i)/float64(steps)
+		m.y = fromY + (y-fromY)*float64(i)/float64(steps)
+		err := m.dispatch(ctx, input.DispatchMouseEventParams{
+			Type:      "mouseMoved",
+			X:         m.x,
+			Y:         m.y,
+			Modifiers: int(o.modifiers | m.tab.keyboard.current()),
+			Button:    string(m.pressed()),
+			Buttons:   m.buttons,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pressed returns a button being held, mousemove events report it.
+func (m *Mouse) pressed() MouseButton {
+	for _, b := range []MouseButton{LeftButton, RightButton, MiddleButton, BackButton, ForwardButton} {
+		if m.buttons&buttonBits[b] != 0 {
+			return b
+		}
+	}
+	return "none"
+}
+
+func (m *Mouse) press(ctx context.Context, down bool, clickCount int, o mouseOptions) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var event = "mouseReleased"
+	if down {
+		event = "mousePressed"
+		m.buttons |= buttonBits[o.button]
+	} else {
+		m.buttons &^= buttonBits[o.button]
+	}
+	return m.dispatch(ctx, input.DispatchMouseEventParams{
+		Type:       event,
+		X:          m.x,
+		Y:          m.y,
+		Modifiers:  int(o.modifiers | m.tab.keyboard.current()),
+		Button:     string(o.button),
+		Buttons:    m.buttons,
+		ClickCount: clickCount,
+	})
+}
+
+func (m *Mouse) click(ctx context.Context, x, y float64, o mouseOptions) error {
+	if err := m.move(ctx, x, y, o); err != nil {
+		return err
+	}
+	// 多击时每次的clickCount递增
+	for i := 1; i <= o.clickCount; i++ {
+		if err := m.press(ctx, true, i, o); err != nil {
+			return err
+		}
+		if o.delay > 0 {
+			time.Sleep(o.delay)
+		}
+		if err := m.press(ctx, false, i, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 移动到指定位置
+func (m *Mouse) Move(x, y float64, options ...MouseOption) error {
+	ctx, cancel := m.tab.context()
+	defer cancel()
+	return m.move(ctx, x, y, newMouseOptions(options))
+}
+
+// 按下按键
+func (m *Mouse) Down(options ...MouseOption) error {
+	ctx, cancel := m.tab.context()
+	defer cancel()
+	o := newMouseOptions(options)
+	return m.press(ctx, true, o.clickCount, o)
+}
+
+// 松开按键
+func (m *Mouse) Up(options ...MouseOption) error {
+	ctx, cancel := m.tab.context()
+	defer cancel()
+	o := newMouseOptions(options)
+	return m.press(ctx, false, o.clickCount, o)
+}
+
+// 移动到指定位置后点击
+func (m *Mouse) Click(x, y float64, options ...MouseOption) error {
+	ctx, cancel := m.tab.context()
+	defer cancel()
+	return m.click(ctx, x, y, newMouseOptions(options))
+}
+
+// 在当前位置滚动滚轮
+func (m *Mouse) Wheel(deltaX, deltaY float64, options ...MouseOption) error {
+	ctx, cancel := m.tab.context()
+	defer cancel()
+	o := newMouseOptions(options)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.dispatch(ctx, input.DispatchMouseEventParams{
+		Type:      "mouseWheel",
+		X:         m.x,
+		Y:         m.y,
+		Modifiers: int(o.modifiers | m.tab.keyboard.current()),
+		DeltaX:    deltaX,
+		DeltaY:    deltaY,
+	})
+}
+
+// 按住按键从一处拖动到另一处，只产生鼠标事件，适用于滑块、画布等；
+// 不会触发HTML5拖放(dragstart、drop等事件)，此协议版本不支持拦截拖放
+func (m *Mouse) Drag(fromX, fromY, toX, toY float64, options ...MouseOption) error {
+	ctx, cancel := m.tab.context()
+	defer cancel()
+	o := newMouseOptions(options)
+	if o.steps < 2 {
+		// 拖动需要中间事件才能被页面识别
+		o.steps = 10
+	}
+	if err := m.move(ctx, fromX, fromY, mouseOptions{steps: 1, modifiers: o.modifiers}); err != nil {
+		return err
+	}
+	if err := m.press(ctx, true, 1, o); err != nil {
+		return err
+	}
+	if err := m.move(ctx, toX, toY, o); err != nil {
+		return err
+	}
+	return m.press(ctx, false, 1, o)
+}
+
+// scrollScript scrolls the element into view when it is not visible.
+const scrollScript = `function(){
+	if (this.scrollIntoViewIfNeeded) this.scrollIntoViewIfNeeded(true);
+	else this.scrollIntoView({block: 'center', inline: 'center'});
+}`
+
+// containsScript reports whether a node is the element or inside of it,
+// crossing shadow roots.
+const containsScript = `function(node){
+	for (let n = node; n; n = n.parentNode || n.host) if (n === this) return true;
+	return false;
+}`
+
+// clickablePoint scrolls the element into view and returns the center of
+// its first visible content quad in the coordinates of the main frame,
+// failing when another node covers it.
+func (e *Element) clickablePoint(ctx context.Context) (float64, float64, error) {
+	if err := e.call(ctx, scrollScript, nil); err != nil {
+		return 0, 0, err
+	}
+	routed := e.frame.route(ctx)
+	result, err := Do(routed, e.frame.tab, dom.GetContentQuadsCommand, dom.GetContentQuadsParams{ObjectId: e.object})
+	if err != nil {
+		return 0, 0, err
+	}
+	x, y, ok := quadsCenter(result.Quads)
+	if !ok {
+		return 0, 0, fmt.Errorf("cuto: element is not visible")
+	}
+	hit, err := Do(routed, e.frame.tab, dom.GetNodeForLocationCommand, dom.GetNodeForLocationParams{
+		X:                         int(math.Round(x)),
+		Y:                         int(math.Round(y)),
+		IncludeUserAgentShadowDOM: true,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	backend, err := e.BackendNodeId()
+	if err != nil {
+		return 0, 0, err
+	}
+	if hit.BackendNodeId != backend {
+		inside, err := e.contains(ctx, hit.BackendNodeId)
+		if err != nil {
+			return 0, 0, err
+		}
+		if !inside {
+			return 0, 0, fmt.Errorf("%w by another node at (%g, %g)", ErrObscured, x, y)
+		}
+	}
+	offsetX, offsetY, err := e.frame.viewportOffset(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return x + offsetX, y + offsetY, nil
+}
+
+// viewportOffset returns where the viewport of the frame's session starts
+// in the main frame. Quads of an out-of-process iframe are relative to the
+// iframe, so the content box of every owner iframe up to the page is added.
+func (f *Frame) viewportOffset(ctx context.Context) (float64, float64, error) {
+	var x, y float64
+	f.tab.frames.mu.Lock()
+	sid := f.session
+	f.tab.frames.mu.Unlock()
+	for sid != "" {
+		f.tab.frames.mu.Lock()
+		s, ok := f.tab.frames.sessions[sid]
+		f.tab.frames.mu.Unlock()
+		if !ok {
+			return 0, 0, ErrDetached
+		}
+		parent := withSession(ctx, s.parent)
+		owner, err := Do(parent, f.tab, dom.GetFrameOwnerCommand, dom.GetFrameOwnerParams{FrameId: cdp.FrameId(s.frame)})
+		if err != nil {
+			return 0, 0, err
+		}
+		result, err := Do(parent, f.tab, dom.GetContentQuadsCommand, dom.GetContentQuadsParams{BackendNodeId: owner.BackendNodeId})
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(result.Quads) == 0 || result.Quads[0] == nil || len(*result.Quads[0]) != 8 {
+			return 0, 0, fmt.Errorf("cuto: iframe is not visible")
+		}
+		// 内容框的左上角
+		quad := *result.Quads[0]
+		x += math.Min(math.Min(quad[0], quad[2]), math.Min(quad[4], quad[6]))
+		y += math.Min(math.Min(quad[1], quad[3]), math.Min(quad[5], quad[7]))
+		sid = s.parent
+	}
+	return x, y, nil
+}
+
+// contains reports whether the node is the element or one of its descendants.
+func (e *Element) contains(ctx context.Context, backend dom.BackendNodeId) (bool, error) {
+	node, err := e.frame.resolve(dom.ResolveNodeParams{BackendNodeId: backend})
+	if err != nil {
+		return false, err
+	}
+	defer node.Release()
+	var inside bool
+	err = e.call(ctx, containsScript, &inside, node)
+	return inside, err
+}
+
+// quadsCenter returns the center of the first quad with an area.
+func quadsCenter(quads []*dom.Quad) (float64, float64, bool) {
+	for _, q := range quads {
+		if q == nil || len(*q) != 8 {
+			continue
+		}
+		quad := *q
+		// 鞋带公式求面积
+		var area float64
+		for i := 0; i < 4; i++ {
+			j := (i + 1) % 4
+			area += quad[2*i]*quad[2*j+1] - quad[2*j]*quad[2*i+1]
+		}
+		if math.Abs(area)/2 < 1 {
+			continue
+		}
+		return (quad[0] + quad[2] + quad[4] + quad[6]) / 4, (quad[1] + quad[3] + quad[5] + quad[7]) / 4, true
+	}
+	return 0, 0, false
+}
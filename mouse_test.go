@@ -0,0 +1,77 @@
+package cuto
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/input"
+)
+
+func TestMouse(t *testing.T) {
+	var mu sync.Mutex
+	var events []map[string]interface{}
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		if method == input.DispatchMouseEvent {
+			mu.Lock()
+			events = append(events, params)
+			mu.Unlock()
+		}
+		return map[string]interface{}{}, nil
+	})
+	mouse := tab.Mouse()
+	if err := mouse.Click(100, 50, ClickCount(2), Modifiers(Shift)); err != nil {
+		t.Fatal(err)
+	}
+	if err := mouse.Drag(0, 0, 30, 60, Steps(3), Button(RightButton)); err != nil {
+		t.Fatal(err)
+	}
+	var expected = []struct {
+		typ        string
+		x, y       float64
+		button     string
+		buttons    float64
+		clickCount float64
+	}{
+		{"mouseMoved", 100, 50, "none", 0, 0},
+		{"mousePressed", 100, 50, "left", 1, 1},
+		{"mouseReleased", 100, 50, "left", 0, 1},
+		{"mousePressed", 100, 50, "left", 1, 2},
+		{"mouseReleased", 100, 50, "left", 0, 2},
+		{"mouseMoved", 0, 0, "none", 0, 0},
+		{"mousePressed", 0, 0, "right", 2, 1},
+		{"mouseMoved", 10, 20, "right", 2, 0},
+		{"mouseMoved", 20, 40, "right", 2, 0},
+		{"mouseMoved", 30, 60, "right", 2, 0},
+		{"mouseReleased", 30, 60, "right", 0, 1},
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != len(expected) {
+		t.Fatalf("dispatched %d events, expected %d: %v", len(events), len(expected), events)
+	}
+	for i, e := range expected {
+		got := events[i]
+		// 缺省的零值字段不会发送
+		buttons, _ := got["buttons"].(float64)
+		clickCount, _ := got["clickCount"].(float64)
+		if got["type"] != e.typ || got["x"] != e.x || got["y"] != e.y || got["button"] != e.button || buttons != e.buttons || clickCount != e.clickCount {
+			t.Errorf("event %d = %v, expected %+v", i, got, e)
+		}
+	}
+	if events[1]["modifiers"] != float64(Shift) {
+		t.Errorf("modifiers not sent: %v", events[1])
+	}
+}
+
+func TestQuadsCenter(t *testing.T) {
+	empty := dom.Quad{0, 0, 0, 0, 0, 0, 0, 0}
+	quad := dom.Quad{10, 20, 110, 20, 110, 70, 10, 70}
+	x, y, ok := quadsCenter([]*dom.Quad{&empty, &quad})
+	if !ok || x != 60 || y != 45 {
+		t.Errorf("quadsCenter() = %g, %g, %v", x, y, ok)
+	}
+	if _, _, ok := quadsCenter([]*dom.Quad{&empty}); ok {
+		t.Error("empty quad is clickable")
+	}
+}
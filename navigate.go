@@ -0,0 +1,206 @@
+package cuto
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/diiyw/cuto/protocol/network"
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/security"
+)
+
+// 页面生命周期事件，见Page.lifecycleEvent
+type LifecycleEvent string
+
+const (
+	DOMContentLoaded     LifecycleEvent = "DOMContentLoaded"
+	Load                 LifecycleEvent = "load"
+	FirstMeaningfulPaint LifecycleEvent = "firstMeaningfulPaint"
+	// 500ms内没有网络连接
+	NetworkIdle LifecycleEvent = "networkIdle"
+	// 500ms内不超过2个网络连接
+	NetworkAlmostIdle LifecycleEvent = "networkAlmostIdle"
+)
+
+type NavigateOption func(o *navigateOptions)
+
+type navigateOptions struct {
+	waitUntil   []LifecycleEvent
+	referrer    string
+	ignoreCache bool
+}
+
+func newNavigateOptions(options []NavigateOption) navigateOptions {
+	var o = navigateOptions{waitUntil: []LifecycleEvent{Load}}
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
+
+// WaitUntil makes Navigate wait for all of the given lifecycle events of
+// the new document, Load by default.
+func WaitUntil(events ...LifecycleEvent) NavigateOption {
+	return func(o *navigateOptions) {
+		o.waitUntil = events
+	}
+}
+
+// Referrer sets the referrer of the navigation.
+func Referrer(url string) NavigateOption {
+	return func(o *navigateOptions) {
+		o.referrer = url
+	}
+}
+
+// Response of the document loaded by a navigation.
+type Response struct {
+	Url        string
+	Status     int
+	StatusText string
+	Headers    map[string]string
+	MimeType   string
+	// 服务器地址
+	RemoteAddress string
+	// 重定向链，按发生顺序
+	Redirects       []network.Response
+	SecurityState   security.SecurityState
+	SecurityDetails network.SecurityDetails
+}
+
+// NavigationError is returned when the browser fails to navigate, e.g. on
+// DNS failures or any net::ERR_* error.
+type NavigationError struct {
+	Url  string
+	Text string
+}
+
+func (e *NavigationError) Error() string {
+	return "cuto: navigate " + e.Url + ": " + e.Text
+}
+
+// 跳转地址并等待生命周期事件，同文档跳转时没有响应
+func (tab *Tab) Navigate(url string, options ...NavigateOption) (*Response, error) {
+	var o = newNavigateOptions(options)
+	ctx, cancel := tab.context()
+	defer cancel()
+	release, err := tab.acquire(ctx, "Page", "Network")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if _, err := Do(ctx, tab, page.SetLifecycleEventsEnabledCommand, page.SetLifecycleEventsEnabledParams{Enabled: true}); err != nil {
+		return nil, err
+	}
+	events := tab.subscribe(page.LifecycleEventEvent, network.RequestWillBeSentEvent, network.ResponseReceivedEvent)
+	defer events.close()
+	result, err := Do(ctx, tab, page.NavigateCommand, page.NavigateParams{Url: url, Referrer: o.referrer})
+	if err != nil {
+		return nil, err
+	}
+	if result.ErrorText != "" {
+		return nil, &NavigationError{Url: url, Text: result.ErrorText}
+	}
+	// 同文档跳转没有新的文档加载
+	if result.LoaderId == "" {
+		return nil, nil
+	}
+	var nav = navigation{frameId: result.FrameId, loaderId: result.LoaderId, fired: make(map[LifecycleEvent]bool)}
+	for !nav.done(o.waitUntil) {
+		msg, err := events.next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := nav.handle(msg); err != nil {
+			return nil, err
+		}
+	}
+	// 已收到但未处理的事件
+	for {
+		select {
+		case msg := <-events.events:
+			if err := nav.handle(msg); err != nil {
+				return nil, err
+			}
+		default:
+			return nav.response, nil
+		}
+	}
+}
+
+// navigation tracks the events of a document load. The request of a
+// document uses the loader id as request id.
+type navigation struct {
+	frameId   page.FrameId
+	loaderId  network.LoaderId
+	fired     map[LifecycleEvent]bool
+	redirects []network.Response
+	response  *Response
+}
+
+func (nav *navigation) done(waitUntil []LifecycleEvent) bool {
+	for _, e := range waitUntil {
+		if !nav.fired[e] {
+			return false
+		}
+	}
+	return true
+}
+
+func (nav *navigation) handle(msg *message) error {
+	switch msg.Method {
+	case page.LifecycleEventEvent:
+		var lifecycle page.LifecycleEventParams
+		if err := json.Unmarshal(msg.Params, &lifecycle); err != nil {
+			return err
+		}
+		// 只接受本次跳转的事件
+		if lifecycle.FrameId == nav.frameId && lifecycle.LoaderId == nav.loaderId {
+			nav.fired[LifecycleEvent(lifecycle.Name)] = true
+		}
+	case network.RequestWillBeSentEvent:
+		var request network.RequestWillBeSentParams
+		if err := json.Unmarshal(msg.Params, &request); err != nil {
+			return err
+		}
+		if string(request.RequestId) == string(nav.loaderId) && request.RedirectResponse.Url != "" {
+			nav.redirects = append(nav.redirects, request.RedirectResponse)
+		}
+	case network.ResponseReceivedEvent:
+		var received network.ResponseReceivedParams
+		if err := json.Unmarshal(msg.Params, &received); err != nil {
+			return err
+		}
+		if string(received.RequestId) != string(nav.loaderId) {
+			return nil
+		}
+		r := received.Response
+		nav.response = &Response{
+			Url:             r.Url,
+			Status:          r.Status,
+			StatusText:      r.StatusText,
+			Headers:         headers(r.Headers),
+			MimeType:        r.MimeType,
+			Redirects:       nav.redirects,
+			SecurityState:   r.SecurityState,
+			SecurityDetails: r.SecurityDetails,
+		}
+		if r.RemoteIPAddress != "" {
+			nav.response.RemoteAddress = net.JoinHostPort(r.RemoteIPAddress, strconv.Itoa(r.RemotePort))
+		}
+	}
+	return nil
+}
+
+// headers converts protocol headers to a map of strings.
+func headers(h network.Headers) map[string]string {
+	var m = make(map[string]string)
+	if values, ok := h.(map[string]interface{}); ok {
+		for k, v := range values {
+			m[k] = fmt.Sprint(v)
+		}
+	}
+	return m
+}
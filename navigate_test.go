@@ -0,0 +1,53 @@
+package cuto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/network"
+	"github.com/diiyw/cuto/protocol/page"
+)
+
+func TestNavigate(t *testing.T) {
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		if method != page.Navigate {
+			return map[string]interface{}{}, nil
+		}
+		emit(network.RequestWillBeSentEvent, map[string]interface{}{"requestId": "next", "redirectResponse": map[string]interface{}{"url": "http://www.baidu.com/", "status": 307}})
+		emit(network.ResponseReceivedEvent, map[string]interface{}{"requestId": "next", "response": map[string]interface{}{
+			"url": "https://www.baidu.com/", "status": 200, "headers": map[string]interface{}{"Content-Type": "text/html"}, "securityState": "secure",
+		}})
+		// 上一个页面的事件不应结束等待
+		emit(page.LifecycleEventEvent, map[string]interface{}{"frameId": "main", "loaderId": "previous", "name": "networkIdle"})
+		emit(page.LifecycleEventEvent, map[string]interface{}{"frameId": "main", "loaderId": "next", "name": "load"})
+		go emit(page.LifecycleEventEvent, map[string]interface{}{"frameId": "main", "loaderId": "next", "name": "networkIdle"})
+		return map[string]interface{}{"frameId": "main", "loaderId": "next"}, nil
+	})
+	response, err := tab.Navigate("http://www.baidu.com", WaitUntil(Load, NetworkIdle))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Status != 200 || response.Url != "https://www.baidu.com/" || response.Headers["Content-Type"] != "text/html" || response.SecurityState != "secure" {
+		t.Errorf("unexpected response %+v", response)
+	}
+	if len(response.Redirects) != 1 || response.Redirects[0].Status != 307 {
+		t.Errorf("unexpected redirects %+v", response.Redirects)
+	}
+	if domains := tab.Domains(); len(domains) != 0 {
+		t.Errorf("domains %v still enabled", domains)
+	}
+}
+
+func TestNavigateError(t *testing.T) {
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		if method != page.Navigate {
+			return map[string]interface{}{}, nil
+		}
+		return map[string]interface{}{"frameId": "main", "loaderId": "next", "errorText": "net::ERR_NAME_NOT_RESOLVED"}, nil
+	})
+	_, err := tab.Navigate("https://cuto.invalid")
+	var navigationErr *NavigationError
+	if !errors.As(err, &navigationErr) || navigationErr.Text != "net::ERR_NAME_NOT_RESOLVED" {
+		t.Errorf("expected navigation error, got %v", err)
+	}
+}
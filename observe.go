@@ -0,0 +1,214 @@
+package cuto
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Logger logs every command and event of a tab with logger. Values of the
+// redacted keys are masked, DefaultRedacted is used when none are given.
+func Logger(logger *slog.Logger, redacted ...string) Interceptor {
+	return &slogInterceptor{logger: logger, redacted: redacted}
+}
+
+type slogInterceptor struct {
+	logger   *slog.Logger
+	redacted []string
+}
+
+func (l *slogInterceptor) Command(ctx context.Context, method string, params interface{}, invoke Invoker) (json.RawMessage, error) {
+	start := time.Now()
+	result, err := invoke(ctx, method, params)
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.Duration("duration", time.Since(start)),
+		slog.Any("params", redact(method, params, l.redacted)),
+	}
+	if err != nil {
+		l.logger.LogAttrs(ctx, slog.LevelError, "command", append(attrs, slog.String("error", err.Error()))...)
+		return result, err
+	}
+	l.logger.LogAttrs(ctx, slog.LevelDebug, "command", append(attrs, slog.Any("result", redact(method, result, l.redacted)))...)
+	return result, err
+}
+
+func (l *slogInterceptor) Event(method string, params json.RawMessage, dispatch Dispatcher) {
+	l.logger.LogAttrs(context.Background(), slog.LevelDebug, "event",
+		slog.String("method", method),
+		slog.Any("params", redact(method, params, l.redacted)),
+	)
+	dispatch(method, params)
+}
+
+// 延迟直方图的桶上限(毫秒)
+var latencyBuckets = []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// Metrics publishes per method latency histograms of commands under the
+// expvar map of the given name, e.g. /debug/vars when net/http/pprof or
+// expvar's handler is served.
+func Metrics(name string) Interceptor {
+	m, ok := expvar.Get(name).(*expvar.Map)
+	if !ok {
+		m = expvar.NewMap(name)
+	}
+	return &metrics{vars: m}
+}
+
+type metrics struct {
+	vars *expvar.Map
+	mu   sync.Mutex
+}
+
+func (m *metrics) Command(ctx context.Context, method string, params interface{}, invoke Invoker) (json.RawMessage, error) {
+	start := time.Now()
+	result, err := invoke(ctx, method, params)
+	m.histogram(method).observe(time.Since(start), err)
+	return result, err
+}
+
+func (m *metrics) Event(method string, params json.RawMessage, dispatch Dispatcher) {
+	dispatch(method, params)
+}
+
+func (m *metrics) histogram(method string) *histogram {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if h, ok := m.vars.Get(method).(*histogram); ok {
+		return h
+	}
+	h := &histogram{counts: make([]int64, len(latencyBuckets)+1)}
+	m.vars.Set(method, h)
+	return h
+}
+
+// histogram of command latencies, exported as an expvar.Var
+type histogram struct {
+	mu     sync.Mutex
+	count  int64
+	errors int64
+	sum    float64
+	counts []int64
+}
+
+func (h *histogram) observe(d time.Duration, err error) {
+	ms := float64(d) / float64(time.Millisecond)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.count++
+	h.sum += ms
+	if err != nil {
+		h.errors++
+	}
+	for i, le := range latencyBuckets {
+		if ms <= le {
+			h.counts[i]++
+			return
+		}
+	}
+	h.counts[len(latencyBuckets)]++
+}
+
+func (h *histogram) String() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var buckets = make([]string, 0, len(h.counts))
+	var cumulative int64
+	for i, n := range h.counts {
+		cumulative += n
+		le := "+Inf"
+		if i < len(latencyBuckets) {
+			le = fmt.Sprint(latencyBuckets[i])
+		}
+		buckets = append(buckets, fmt.Sprintf("%q: %d", le, cumulative))
+	}
+	return fmt.Sprintf(`{"count": %d, "errors": %d, "sum_ms": %g, "buckets_ms": {%s}}`,
+		h.count, h.errors, h.sum, strings.Join(buckets, ", "))
+}
+
+// Tracer writes a span per command to w in the Trace Event Format, so a
+// trace file can be opened in chrome://tracing or Perfetto. Values of the
+// redacted keys are masked, DefaultRedacted is used when none are given.
+func Tracer(w io.Writer, redacted ...string) Interceptor {
+	return &tracer{w: w, redacted: redacted, pid: os.Getpid()}
+}
+
+type tracer struct {
+	mu       sync.Mutex
+	w        io.Writer
+	started  bool
+	redacted []string
+	pid      int
+	tid      int
+}
+
+// span of the Trace Event Format
+type span struct {
+	Name     string                 `json:"name"`
+	Category string                 `json:"cat"`
+	Phase    string                 `json:"ph"`
+	Start    int64                  `json:"ts"`
+	Duration int64                  `json:"dur,omitempty"`
+	Pid      int                    `json:"pid"`
+	Tid      int                    `json:"tid"`
+	Args     map[string]interface{} `json:"args,omitempty"`
+}
+
+func (t *tracer) Command(ctx context.Context, method string, params interface{}, invoke Invoker) (json.RawMessage, error) {
+	t.mu.Lock()
+	t.tid++
+	tid := t.tid
+	t.mu.Unlock()
+	start := time.Now()
+	result, err := invoke(ctx, method, params)
+	var args = map[string]interface{}{"params": redact(method, params, t.redacted)}
+	if err != nil {
+		args["error"] = err.Error()
+	}
+	t.write(span{
+		Name:     method,
+		Category: "command",
+		Phase:    "X",
+		Start:    start.UnixMicro(),
+		Duration: time.Since(start).Microseconds(),
+		Pid:      t.pid,
+		Tid:      tid,
+		Args:     args,
+	})
+	return result, err
+}
+
+func (t *tracer) Event(method string, params json.RawMessage, dispatch Dispatcher) {
+	t.write(span{
+		Name:     method,
+		Category: "event",
+		Phase:    "i",
+		Start:    time.Now().UnixMicro(),
+		Pid:      t.pid,
+		Args:     map[string]interface{}{"params": redact(method, params, t.redacted)},
+	})
+	dispatch(method, params)
+}
+
+// write appends a span, the trailing "]" of the array is optional in the
+// Trace Event Format, so the file stays valid when the process dies.
+func (t *tracer) write(s span) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.started {
+		t.started = true
+		_, _ = io.WriteString(t.w, "[\n")
+	}
+	_, _ = t.w.Write(append(b, ",\n"...))
+}
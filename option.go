@@ -39,3 +39,19 @@ func Debug() Option {
 		b.debug = true
 	}
 }
+
+// Intercept wraps command execution and event dispatch of every tab with
+// the given interceptors, the first one being the outermost.
+func Intercept(interceptors ...Interceptor) Option {
+	return func(b *Browser) {
+		b.interceptors = append(b.interceptors, interceptors...)
+	}
+}
+
+// Validate checks command params against the protocol schema before they are
+// sent, so mistakes surface as a *ValidationError instead of a remote error.
+func Validate() Option {
+	return func(b *Browser) {
+		b.validate = true
+	}
+}
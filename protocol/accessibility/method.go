@@ -1,8 +1,9 @@
 package accessibility
 
 import (
-	"github.com/diiyw/cuto/protocol/runtime"
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/runtime"
 )
 
 
@@ -16,6 +17,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables the accessibility domain which causes `AXNodeId`s to remain consistent between method calls.
 // This turns on accessibility for the page, which can impact performance until accessibility is disabled.
 const Enable = "Accessibility.enable"
@@ -27,7 +30,11 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Fetches the accessibility node and partial accessibility tree for this DOM node, if it exists.
+//
+// Experimental: may be changed or removed without notice.
 const GetPartialAXTree = "Accessibility.getPartialAXTree"
 
 type GetPartialAXTreeParams struct {
@@ -52,7 +59,11 @@ type GetPartialAXTreeResult struct {
 	Nodes 	[]*AXNode	`json:"nodes"`
 }
 
+var GetPartialAXTreeCommand = cdp.Command[GetPartialAXTreeParams, GetPartialAXTreeResult]{Method: GetPartialAXTree}
+
 // Fetches the entire accessibility tree
+//
+// Experimental: may be changed or removed without notice.
 const GetFullAXTree = "Accessibility.getFullAXTree"
 
 type GetFullAXTreeParams struct {
@@ -62,4 +73,6 @@ type GetFullAXTreeResult struct {
 
 	// 
 	Nodes 	[]*AXNode	`json:"nodes"`
-}
\ No newline at end of file
+}
+
+var GetFullAXTreeCommand = cdp.Command[GetFullAXTreeParams, GetFullAXTreeResult]{Method: GetFullAXTree}
\ No newline at end of file
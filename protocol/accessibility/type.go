@@ -1,3 +1,6 @@
+// Package accessibility implements the Accessibility domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package accessibility
 
 import (
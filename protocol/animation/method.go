@@ -1,6 +1,7 @@
 package animation
 
 import (
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/runtime"
 )
 
@@ -15,6 +16,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables animation domain notifications.
 const Enable = "Animation.enable"
 
@@ -25,6 +28,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Returns the current time of the an animation.
 const GetCurrentTime = "Animation.getCurrentTime"
 
@@ -40,6 +45,8 @@ type GetCurrentTimeResult struct {
 	CurrentTime 	float64	`json:"currentTime"`
 }
 
+var GetCurrentTimeCommand = cdp.Command[GetCurrentTimeParams, GetCurrentTimeResult]{Method: GetCurrentTime}
+
 // Gets the playback rate of the document timeline.
 const GetPlaybackRate = "Animation.getPlaybackRate"
 
@@ -52,6 +59,8 @@ type GetPlaybackRateResult struct {
 	PlaybackRate 	float64	`json:"playbackRate"`
 }
 
+var GetPlaybackRateCommand = cdp.Command[GetPlaybackRateParams, GetPlaybackRateResult]{Method: GetPlaybackRate}
+
 // Releases a set of animations to no longer be manipulated.
 const ReleaseAnimations = "Animation.releaseAnimations"
 
@@ -65,6 +74,8 @@ type ReleaseAnimationsResult struct {
 
 }
 
+var ReleaseAnimationsCommand = cdp.Command[ReleaseAnimationsParams, ReleaseAnimationsResult]{Method: ReleaseAnimations}
+
 // Gets the remote object of the Animation.
 const ResolveAnimation = "Animation.resolveAnimation"
 
@@ -80,6 +91,8 @@ type ResolveAnimationResult struct {
 	RemoteObject 	runtime.RemoteObject	`json:"remoteObject"`
 }
 
+var ResolveAnimationCommand = cdp.Command[ResolveAnimationParams, ResolveAnimationResult]{Method: ResolveAnimation}
+
 // Seek a set of animations to a particular time within each animation.
 const SeekAnimations = "Animation.seekAnimations"
 
@@ -96,6 +109,8 @@ type SeekAnimationsResult struct {
 
 }
 
+var SeekAnimationsCommand = cdp.Command[SeekAnimationsParams, SeekAnimationsResult]{Method: SeekAnimations}
+
 // Sets the paused state of a set of animations.
 const SetPaused = "Animation.setPaused"
 
@@ -112,6 +127,8 @@ type SetPausedResult struct {
 
 }
 
+var SetPausedCommand = cdp.Command[SetPausedParams, SetPausedResult]{Method: SetPaused}
+
 // Sets the playback rate of the document timeline.
 const SetPlaybackRate = "Animation.setPlaybackRate"
 
@@ -125,6 +142,8 @@ type SetPlaybackRateResult struct {
 
 }
 
+var SetPlaybackRateCommand = cdp.Command[SetPlaybackRateParams, SetPlaybackRateResult]{Method: SetPlaybackRate}
+
 // Sets the timing of an animation node.
 const SetTiming = "Animation.setTiming"
 
@@ -142,4 +161,6 @@ type SetTimingParams struct {
 
 type SetTimingResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetTimingCommand = cdp.Command[SetTimingParams, SetTimingResult]{Method: SetTiming}
\ No newline at end of file
@@ -1,3 +1,6 @@
+// Package animation implements the Animation domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package animation
 
 import (
@@ -15,6 +15,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Returns relevant application cache data for the document in given frame.
 const GetApplicationCacheForFrame = "ApplicationCache.getApplicationCacheForFrame"
 
@@ -30,6 +32,8 @@ type GetApplicationCacheForFrameResult struct {
 	ApplicationCache 	ApplicationCache	`json:"applicationCache"`
 }
 
+var GetApplicationCacheForFrameCommand = cdp.Command[GetApplicationCacheForFrameParams, GetApplicationCacheForFrameResult]{Method: GetApplicationCacheForFrame}
+
 // Returns array of frame identifiers with manifest urls for each frame containing a document
 // associated with some application cache.
 const GetFramesWithManifests = "ApplicationCache.getFramesWithManifests"
@@ -44,6 +48,8 @@ type GetFramesWithManifestsResult struct {
 	FrameIds 	[]*FrameWithManifest	`json:"frameIds"`
 }
 
+var GetFramesWithManifestsCommand = cdp.Command[GetFramesWithManifestsParams, GetFramesWithManifestsResult]{Method: GetFramesWithManifests}
+
 // Returns manifest URL for document in the given frame.
 const GetManifestForFrame = "ApplicationCache.getManifestForFrame"
 
@@ -57,4 +63,6 @@ type GetManifestForFrameResult struct {
 
 	// Manifest URL for document in the given frame.
 	ManifestURL 	string	`json:"manifestURL"`
-}
\ No newline at end of file
+}
+
+var GetManifestForFrameCommand = cdp.Command[GetManifestForFrameParams, GetManifestForFrameResult]{Method: GetManifestForFrame}
\ No newline at end of file
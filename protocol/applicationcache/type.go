@@ -1,3 +1,6 @@
+// Package applicationcache implements the ApplicationCache domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package applicationcache
 
 import (
@@ -1,6 +1,7 @@
 package audits
 
 import (
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/network"
 )
 
@@ -32,4 +33,6 @@ type GetEncodedResponseResult struct {
 	OriginalSize 	int	`json:"originalSize"`
 	// Size after re-encoding.
 	EncodedSize 	int	`json:"encodedSize"`
-}
\ No newline at end of file
+}
+
+var GetEncodedResponseCommand = cdp.Command[GetEncodedResponseParams, GetEncodedResponseResult]{Method: GetEncodedResponse}
\ No newline at end of file
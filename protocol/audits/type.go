@@ -1 +1,6 @@
+// Package audits implements the Audits domain of the DevTools protocol.
+//
+// Audits domain allows investigation of page violations and possible improvements.
+//
+// Experimental: may be changed or removed without notice.
 package audits
\ No newline at end of file
@@ -1,5 +1,10 @@
 package backgroundservice
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Enables event updates for the service.
 const StartObserving = "BackgroundService.startObserving"
 
@@ -13,6 +18,8 @@ type StartObservingResult struct {
 
 }
 
+var StartObservingCommand = cdp.Command[StartObservingParams, StartObservingResult]{Method: StartObserving}
+
 // Disables event updates for the service.
 const StopObserving = "BackgroundService.stopObserving"
 
@@ -26,6 +33,8 @@ type StopObservingResult struct {
 
 }
 
+var StopObservingCommand = cdp.Command[StopObservingParams, StopObservingResult]{Method: StopObserving}
+
 // Set the recording state for the service.
 const SetRecording = "BackgroundService.setRecording"
 
@@ -42,6 +51,8 @@ type SetRecordingResult struct {
 
 }
 
+var SetRecordingCommand = cdp.Command[SetRecordingParams, SetRecordingResult]{Method: SetRecording}
+
 // Clears all stored data for the service.
 const ClearEvents = "BackgroundService.clearEvents"
 
@@ -53,4 +64,6 @@ type ClearEventsParams struct {
 
 type ClearEventsResult struct {
 
-}
\ No newline at end of file
+}
+
+var ClearEventsCommand = cdp.Command[ClearEventsParams, ClearEventsResult]{Method: ClearEvents}
\ No newline at end of file
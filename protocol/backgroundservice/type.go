@@ -1,3 +1,8 @@
+// Package backgroundservice implements the BackgroundService domain of the DevTools protocol.
+//
+// Defines events for background web platform features.
+//
+// Experimental: may be changed or removed without notice.
 package backgroundservice
 
 import (
@@ -1,11 +1,14 @@
 package browser
 
 import (
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/target"
 )
 
 
 // Set permission settings for given origin.
+//
+// Experimental: may be changed or removed without notice.
 const SetPermission = "Browser.setPermission"
 
 type SetPermissionParams struct {
@@ -27,7 +30,11 @@ type SetPermissionResult struct {
 
 }
 
+var SetPermissionCommand = cdp.Command[SetPermissionParams, SetPermissionResult]{Method: SetPermission}
+
 // Grant specific permissions to the given origin and reject all others.
+//
+// Experimental: may be changed or removed without notice.
 const GrantPermissions = "Browser.grantPermissions"
 
 type GrantPermissionsParams struct {
@@ -46,7 +53,11 @@ type GrantPermissionsResult struct {
 
 }
 
+var GrantPermissionsCommand = cdp.Command[GrantPermissionsParams, GrantPermissionsResult]{Method: GrantPermissions}
+
 // Reset all permission management for all origins.
+//
+// Experimental: may be changed or removed without notice.
 const ResetPermissions = "Browser.resetPermissions"
 
 type ResetPermissionsParams struct {
@@ -59,6 +70,8 @@ type ResetPermissionsResult struct {
 
 }
 
+var ResetPermissionsCommand = cdp.Command[ResetPermissionsParams, ResetPermissionsResult]{Method: ResetPermissions}
+
 // Close browser gracefully.
 const Close = "Browser.close"
 
@@ -69,7 +82,11 @@ type CloseResult struct {
 
 }
 
+var CloseCommand = cdp.Command[CloseParams, CloseResult]{Method: Close}
+
 // Crashes browser on the main thread.
+//
+// Experimental: may be changed or removed without notice.
 const Crash = "Browser.crash"
 
 type CrashParams struct {
@@ -79,7 +96,11 @@ type CrashResult struct {
 
 }
 
+var CrashCommand = cdp.Command[CrashParams, CrashResult]{Method: Crash}
+
 // Crashes GPU process.
+//
+// Experimental: may be changed or removed without notice.
 const CrashGpuProcess = "Browser.crashGpuProcess"
 
 type CrashGpuProcessParams struct {
@@ -89,6 +110,8 @@ type CrashGpuProcessResult struct {
 
 }
 
+var CrashGpuProcessCommand = cdp.Command[CrashGpuProcessParams, CrashGpuProcessResult]{Method: CrashGpuProcess}
+
 // Returns version information.
 const GetVersion = "Browser.getVersion"
 
@@ -109,8 +132,12 @@ type GetVersionResult struct {
 	JsVersion 	string	`json:"jsVersion"`
 }
 
+var GetVersionCommand = cdp.Command[GetVersionParams, GetVersionResult]{Method: GetVersion}
+
 // Returns the command line switches for the browser process if, and only if
 // --enable-automation is on the commandline.
+//
+// Experimental: may be changed or removed without notice.
 const GetBrowserCommandLine = "Browser.getBrowserCommandLine"
 
 type GetBrowserCommandLineParams struct {
@@ -122,7 +149,11 @@ type GetBrowserCommandLineResult struct {
 	Arguments 	[]string	`json:"arguments"`
 }
 
+var GetBrowserCommandLineCommand = cdp.Command[GetBrowserCommandLineParams, GetBrowserCommandLineResult]{Method: GetBrowserCommandLine}
+
 // Get Chrome histograms.
+//
+// Experimental: may be changed or removed without notice.
 const GetHistograms = "Browser.getHistograms"
 
 type GetHistogramsParams struct {
@@ -142,7 +173,11 @@ type GetHistogramsResult struct {
 	Histograms 	[]*Histogram	`json:"histograms"`
 }
 
+var GetHistogramsCommand = cdp.Command[GetHistogramsParams, GetHistogramsResult]{Method: GetHistograms}
+
 // Get a Chrome histogram by name.
+//
+// Experimental: may be changed or removed without notice.
 const GetHistogram = "Browser.getHistogram"
 
 type GetHistogramParams struct {
@@ -160,7 +195,11 @@ type GetHistogramResult struct {
 	Histogram 	Histogram	`json:"histogram"`
 }
 
+var GetHistogramCommand = cdp.Command[GetHistogramParams, GetHistogramResult]{Method: GetHistogram}
+
 // Get position and size of the browser window.
+//
+// Experimental: may be changed or removed without notice.
 const GetWindowBounds = "Browser.getWindowBounds"
 
 type GetWindowBoundsParams struct {
@@ -176,7 +215,11 @@ type GetWindowBoundsResult struct {
 	Bounds 	Bounds	`json:"bounds"`
 }
 
+var GetWindowBoundsCommand = cdp.Command[GetWindowBoundsParams, GetWindowBoundsResult]{Method: GetWindowBounds}
+
 // Get the browser window that contains the devtools target.
+//
+// Experimental: may be changed or removed without notice.
 const GetWindowForTarget = "Browser.getWindowForTarget"
 
 type GetWindowForTargetParams struct {
@@ -194,7 +237,11 @@ type GetWindowForTargetResult struct {
 	Bounds 	Bounds	`json:"bounds"`
 }
 
+var GetWindowForTargetCommand = cdp.Command[GetWindowForTargetParams, GetWindowForTargetResult]{Method: GetWindowForTarget}
+
 // Set position and/or size of the browser window.
+//
+// Experimental: may be changed or removed without notice.
 const SetWindowBounds = "Browser.setWindowBounds"
 
 type SetWindowBoundsParams struct {
@@ -211,7 +258,11 @@ type SetWindowBoundsResult struct {
 
 }
 
+var SetWindowBoundsCommand = cdp.Command[SetWindowBoundsParams, SetWindowBoundsResult]{Method: SetWindowBounds}
+
 // Set dock tile details, platform-specific.
+//
+// Experimental: may be changed or removed without notice.
 const SetDockTile = "Browser.setDockTile"
 
 type SetDockTileParams struct {
@@ -225,4 +276,6 @@ type SetDockTileParams struct {
 
 type SetDockTileResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetDockTileCommand = cdp.Command[SetDockTileParams, SetDockTileResult]{Method: SetDockTile}
\ No newline at end of file
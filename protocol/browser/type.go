@@ -1,11 +1,20 @@
+// Package browser implements the Browser domain of the DevTools protocol.
+//
+// The Browser domain defines methods and events for browser managing.
 package browser
 // 
+//
+// Experimental: may be changed or removed without notice.
 type WindowID int
 
 // The state of the browser window.
+//
+// Experimental: may be changed or removed without notice.
 type WindowState string
 
 // Browser window bounds information
+//
+// Experimental: may be changed or removed without notice.
 type Bounds  struct {
 
 	// The offset from the left edge of the screen to the window in pixels.
@@ -25,13 +34,19 @@ type Bounds  struct {
 }
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type PermissionType string
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type PermissionSetting string
 
 // Definition of PermissionDescriptor defined in the Permissions API:
 	// https://w3c.github.io/permissions/#dictdef-permissiondescriptor.
+//
+// Experimental: may be changed or removed without notice.
 type PermissionDescriptor  struct {
 
 	// Name of permission.
@@ -50,6 +65,8 @@ type PermissionDescriptor  struct {
 }
 
 // Chrome histogram bucket.
+//
+// Experimental: may be changed or removed without notice.
 type Bucket  struct {
 
 	// Minimum value (inclusive).
@@ -63,6 +80,8 @@ type Bucket  struct {
 }
 
 // Chrome histogram.
+//
+// Experimental: may be changed or removed without notice.
 type Histogram  struct {
 
 	// Name.
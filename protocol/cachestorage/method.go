@@ -1,5 +1,10 @@
 package cachestorage
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Deletes a cache.
 const DeleteCache = "CacheStorage.deleteCache"
 
@@ -13,6 +18,8 @@ type DeleteCacheResult struct {
 
 }
 
+var DeleteCacheCommand = cdp.Command[DeleteCacheParams, DeleteCacheResult]{Method: DeleteCache}
+
 // Deletes a cache entry.
 const DeleteEntry = "CacheStorage.deleteEntry"
 
@@ -29,6 +36,8 @@ type DeleteEntryResult struct {
 
 }
 
+var DeleteEntryCommand = cdp.Command[DeleteEntryParams, DeleteEntryResult]{Method: DeleteEntry}
+
 // Requests cache names.
 const RequestCacheNames = "CacheStorage.requestCacheNames"
 
@@ -44,6 +53,8 @@ type RequestCacheNamesResult struct {
 	Caches 	[]*Cache	`json:"caches"`
 }
 
+var RequestCacheNamesCommand = cdp.Command[RequestCacheNamesParams, RequestCacheNamesResult]{Method: RequestCacheNames}
+
 // Fetches cache entry.
 const RequestCachedResponse = "CacheStorage.requestCachedResponse"
 
@@ -65,6 +76,8 @@ type RequestCachedResponseResult struct {
 	Response 	CachedResponse	`json:"response"`
 }
 
+var RequestCachedResponseCommand = cdp.Command[RequestCachedResponseParams, RequestCachedResponseResult]{Method: RequestCachedResponse}
+
 // Requests data from cache.
 const RequestEntries = "CacheStorage.requestEntries"
 
@@ -90,4 +103,6 @@ type RequestEntriesResult struct {
 	// Count of returned entries from this storage. If pathFilter is empty, it
 	// is the count of all entries from this storage.
 	ReturnCount 	float64	`json:"returnCount"`
-}
\ No newline at end of file
+}
+
+var RequestEntriesCommand = cdp.Command[RequestEntriesParams, RequestEntriesResult]{Method: RequestEntries}
\ No newline at end of file
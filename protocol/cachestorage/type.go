@@ -1,3 +1,6 @@
+// Package cachestorage implements the CacheStorage domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package cachestorage
 // Unique identifier of the Cache object.
 type CacheId string
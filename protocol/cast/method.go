@@ -1,5 +1,10 @@
 package cast
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Starts observing for sinks that can be used for tab mirroring, and if set,
 // sinks compatible with |presentationUrl| as well. When sinks are found, a
 // |sinksUpdated| event is fired.
@@ -17,6 +22,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Stops observing for sinks and issues.
 const Disable = "Cast.disable"
 
@@ -27,6 +34,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Sets a sink to be used when the web page requests the browser to choose a
 // sink via Presentation API, Remote Playback API, or Cast SDK.
 const SetSinkToUse = "Cast.setSinkToUse"
@@ -41,6 +50,8 @@ type SetSinkToUseResult struct {
 
 }
 
+var SetSinkToUseCommand = cdp.Command[SetSinkToUseParams, SetSinkToUseResult]{Method: SetSinkToUse}
+
 // Starts mirroring the tab to the sink.
 const StartTabMirroring = "Cast.startTabMirroring"
 
@@ -54,6 +65,8 @@ type StartTabMirroringResult struct {
 
 }
 
+var StartTabMirroringCommand = cdp.Command[StartTabMirroringParams, StartTabMirroringResult]{Method: StartTabMirroring}
+
 // Stops the active Cast session on the sink.
 const StopCasting = "Cast.stopCasting"
 
@@ -65,4 +78,6 @@ type StopCastingParams struct {
 
 type StopCastingResult struct {
 
-}
\ No newline at end of file
+}
+
+var StopCastingCommand = cdp.Command[StopCastingParams, StopCastingResult]{Method: StopCasting}
\ No newline at end of file
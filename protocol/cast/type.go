@@ -1,3 +1,9 @@
+// Package cast implements the Cast domain of the DevTools protocol.
+//
+// A domain for interacting with Cast, Presentation API, and Remote Playback API
+// functionalities.
+//
+// Experimental: may be changed or removed without notice.
 package cast
 // 
 type Sink  struct {
@@ -0,0 +1,1986 @@
+package cdp
+
+// Schema of a command parameter, an object property or a named type.
+type Param struct {
+
+	// Parameter or property name, empty for named types and array items
+	Name	string
+
+	// One of string, integer, number, boolean, object, array, any or binary.
+	// Empty when Ref is set.
+	Type	string
+
+	// Qualified name of the referenced type, e.g. "Page.TransitionType"
+	Ref	string
+
+	// Allowed values of a string
+	Enum	[]string
+
+	// Whether the parameter may be omitted
+	Optional	bool
+
+	// Schema of array items
+	Items	*Param
+
+	// Properties of an object
+	Properties	[]Param
+}
+
+// Parameters of every command, keyed by method name.
+var Commands = map[string][]Param{
+	"Accessibility.disable": {
+	},
+	"Accessibility.enable": {
+	},
+	"Accessibility.getPartialAXTree": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId", Optional: true},
+		Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true},
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true},
+		Param{Name: "fetchRelatives", Type: "boolean", Optional: true},
+	},
+	"Accessibility.getFullAXTree": {
+	},
+	"Animation.disable": {
+	},
+	"Animation.enable": {
+	},
+	"Animation.getCurrentTime": {
+		Param{Name: "id", Type: "string"},
+	},
+	"Animation.getPlaybackRate": {
+	},
+	"Animation.releaseAnimations": {
+		Param{Name: "animations", Type: "array", Items: &Param{Type: "string"}},
+	},
+	"Animation.resolveAnimation": {
+		Param{Name: "animationId", Type: "string"},
+	},
+	"Animation.seekAnimations": {
+		Param{Name: "animations", Type: "array", Items: &Param{Type: "string"}},
+		Param{Name: "currentTime", Type: "number"},
+	},
+	"Animation.setPaused": {
+		Param{Name: "animations", Type: "array", Items: &Param{Type: "string"}},
+		Param{Name: "paused", Type: "boolean"},
+	},
+	"Animation.setPlaybackRate": {
+		Param{Name: "playbackRate", Type: "number"},
+	},
+	"Animation.setTiming": {
+		Param{Name: "animationId", Type: "string"},
+		Param{Name: "duration", Type: "number"},
+		Param{Name: "delay", Type: "number"},
+	},
+	"ApplicationCache.enable": {
+	},
+	"ApplicationCache.getApplicationCacheForFrame": {
+		Param{Name: "frameId", Ref: "Page.FrameId"},
+	},
+	"ApplicationCache.getFramesWithManifests": {
+	},
+	"ApplicationCache.getManifestForFrame": {
+		Param{Name: "frameId", Ref: "Page.FrameId"},
+	},
+	"Audits.getEncodedResponse": {
+		Param{Name: "requestId", Ref: "Network.RequestId"},
+		Param{Name: "encoding", Type: "string", Enum: []string{"webp", "jpeg", "png"}},
+		Param{Name: "quality", Type: "number", Optional: true},
+		Param{Name: "sizeOnly", Type: "boolean", Optional: true},
+	},
+	"BackgroundService.startObserving": {
+		Param{Name: "service", Ref: "BackgroundService.ServiceName"},
+	},
+	"BackgroundService.stopObserving": {
+		Param{Name: "service", Ref: "BackgroundService.ServiceName"},
+	},
+	"BackgroundService.setRecording": {
+		Param{Name: "shouldRecord", Type: "boolean"},
+		Param{Name: "service", Ref: "BackgroundService.ServiceName"},
+	},
+	"BackgroundService.clearEvents": {
+		Param{Name: "service", Ref: "BackgroundService.ServiceName"},
+	},
+	"Browser.setPermission": {
+		Param{Name: "origin", Type: "string"},
+		Param{Name: "permission", Ref: "Browser.PermissionDescriptor"},
+		Param{Name: "setting", Ref: "Browser.PermissionSetting"},
+		Param{Name: "browserContextId", Ref: "Target.TargetID", Optional: true},
+	},
+	"Browser.grantPermissions": {
+		Param{Name: "origin", Type: "string"},
+		Param{Name: "permissions", Type: "array", Items: &Param{Ref: "Browser.PermissionType"}},
+		Param{Name: "browserContextId", Ref: "Target.BrowserContextID", Optional: true},
+	},
+	"Browser.resetPermissions": {
+		Param{Name: "browserContextId", Ref: "Target.BrowserContextID", Optional: true},
+	},
+	"Browser.close": {
+	},
+	"Browser.crash": {
+	},
+	"Browser.crashGpuProcess": {
+	},
+	"Browser.getVersion": {
+	},
+	"Browser.getBrowserCommandLine": {
+	},
+	"Browser.getHistograms": {
+		Param{Name: "query", Type: "string", Optional: true},
+		Param{Name: "delta", Type: "boolean", Optional: true},
+	},
+	"Browser.getHistogram": {
+		Param{Name: "name", Type: "string"},
+		Param{Name: "delta", Type: "boolean", Optional: true},
+	},
+	"Browser.getWindowBounds": {
+		Param{Name: "windowId", Ref: "Browser.WindowID"},
+	},
+	"Browser.getWindowForTarget": {
+		Param{Name: "targetId", Ref: "Target.TargetID", Optional: true},
+	},
+	"Browser.setWindowBounds": {
+		Param{Name: "windowId", Ref: "Browser.WindowID"},
+		Param{Name: "bounds", Ref: "Browser.Bounds"},
+	},
+	"Browser.setDockTile": {
+		Param{Name: "badgeLabel", Type: "string", Optional: true},
+		Param{Name: "image", Type: "binary", Optional: true},
+	},
+	"CSS.addRule": {
+		Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"},
+		Param{Name: "ruleText", Type: "string"},
+		Param{Name: "location", Ref: "CSS.SourceRange"},
+	},
+	"CSS.collectClassNames": {
+		Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"},
+	},
+	"CSS.createStyleSheet": {
+		Param{Name: "frameId", Ref: "Page.FrameId"},
+	},
+	"CSS.disable": {
+	},
+	"CSS.enable": {
+	},
+	"CSS.forcePseudoState": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "forcedPseudoClasses", Type: "array", Items: &Param{Type: "string"}},
+	},
+	"CSS.getBackgroundColors": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"CSS.getComputedStyleForNode": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"CSS.getInlineStylesForNode": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"CSS.getMatchedStylesForNode": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"CSS.getMediaQueries": {
+	},
+	"CSS.getPlatformFontsForNode": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"CSS.getStyleSheetText": {
+		Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"},
+	},
+	"CSS.setEffectivePropertyValueForNode": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "propertyName", Type: "string"},
+		Param{Name: "value", Type: "string"},
+	},
+	"CSS.setKeyframeKey": {
+		Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"},
+		Param{Name: "range", Ref: "CSS.SourceRange"},
+		Param{Name: "keyText", Type: "string"},
+	},
+	"CSS.setMediaText": {
+		Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"},
+		Param{Name: "range", Ref: "CSS.SourceRange"},
+		Param{Name: "text", Type: "string"},
+	},
+	"CSS.setRuleSelector": {
+		Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"},
+		Param{Name: "range", Ref: "CSS.SourceRange"},
+		Param{Name: "selector", Type: "string"},
+	},
+	"CSS.setStyleSheetText": {
+		Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"},
+		Param{Name: "text", Type: "string"},
+	},
+	"CSS.setStyleTexts": {
+		Param{Name: "edits", Type: "array", Items: &Param{Ref: "CSS.StyleDeclarationEdit"}},
+	},
+	"CSS.startRuleUsageTracking": {
+	},
+	"CSS.stopRuleUsageTracking": {
+	},
+	"CSS.takeCoverageDelta": {
+	},
+	"CacheStorage.deleteCache": {
+		Param{Name: "cacheId", Ref: "CacheStorage.CacheId"},
+	},
+	"CacheStorage.deleteEntry": {
+		Param{Name: "cacheId", Ref: "CacheStorage.CacheId"},
+		Param{Name: "request", Type: "string"},
+	},
+	"CacheStorage.requestCacheNames": {
+		Param{Name: "securityOrigin", Type: "string"},
+	},
+	"CacheStorage.requestCachedResponse": {
+		Param{Name: "cacheId", Ref: "CacheStorage.CacheId"},
+		Param{Name: "requestURL", Type: "string"},
+		Param{Name: "requestHeaders", Type: "array", Items: &Param{Ref: "CacheStorage.Header"}},
+	},
+	"CacheStorage.requestEntries": {
+		Param{Name: "cacheId", Ref: "CacheStorage.CacheId"},
+		Param{Name: "skipCount", Type: "integer"},
+		Param{Name: "pageSize", Type: "integer"},
+		Param{Name: "pathFilter", Type: "string", Optional: true},
+	},
+	"Cast.enable": {
+		Param{Name: "presentationUrl", Type: "string", Optional: true},
+	},
+	"Cast.disable": {
+	},
+	"Cast.setSinkToUse": {
+		Param{Name: "sinkName", Type: "string"},
+	},
+	"Cast.startTabMirroring": {
+		Param{Name: "sinkName", Type: "string"},
+	},
+	"Cast.stopCasting": {
+		Param{Name: "sinkName", Type: "string"},
+	},
+	"DOM.collectClassNamesFromSubtree": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"DOM.copyTo": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "targetNodeId", Ref: "DOM.NodeId"},
+		Param{Name: "insertBeforeNodeId", Ref: "DOM.NodeId", Optional: true},
+	},
+	"DOM.describeNode": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId", Optional: true},
+		Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true},
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true},
+		Param{Name: "depth", Type: "integer", Optional: true},
+		Param{Name: "pierce", Type: "boolean", Optional: true},
+	},
+	"DOM.disable": {
+	},
+	"DOM.discardSearchResults": {
+		Param{Name: "searchId", Type: "string"},
+	},
+	"DOM.enable": {
+	},
+	"DOM.focus": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId", Optional: true},
+		Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true},
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true},
+	},
+	"DOM.getAttributes": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"DOM.getBoxModel": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId", Optional: true},
+		Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true},
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true},
+	},
+	"DOM.getContentQuads": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId", Optional: true},
+		Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true},
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true},
+	},
+	"DOM.getDocument": {
+		Param{Name: "depth", Type: "integer", Optional: true},
+		Param{Name: "pierce", Type: "boolean", Optional: true},
+	},
+	"DOM.getFlattenedDocument": {
+		Param{Name: "depth", Type: "integer", Optional: true},
+		Param{Name: "pierce", Type: "boolean", Optional: true},
+	},
+	"DOM.getNodeForLocation": {
+		Param{Name: "x", Type: "integer"},
+		Param{Name: "y", Type: "integer"},
+		Param{Name: "includeUserAgentShadowDOM", Type: "boolean", Optional: true},
+		Param{Name: "ignorePointerEventsNone", Type: "boolean", Optional: true},
+	},
+	"DOM.getOuterHTML": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId", Optional: true},
+		Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true},
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true},
+	},
+	"DOM.getRelayoutBoundary": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"DOM.getSearchResults": {
+		Param{Name: "searchId", Type: "string"},
+		Param{Name: "fromIndex", Type: "integer"},
+		Param{Name: "toIndex", Type: "integer"},
+	},
+	"DOM.hideHighlight": {
+	},
+	"DOM.highlightNode": {
+	},
+	"DOM.highlightRect": {
+	},
+	"DOM.markUndoableState": {
+	},
+	"DOM.moveTo": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "targetNodeId", Ref: "DOM.NodeId"},
+		Param{Name: "insertBeforeNodeId", Ref: "DOM.NodeId", Optional: true},
+	},
+	"DOM.performSearch": {
+		Param{Name: "query", Type: "string"},
+		Param{Name: "includeUserAgentShadowDOM", Type: "boolean", Optional: true},
+	},
+	"DOM.pushNodeByPathToFrontend": {
+		Param{Name: "path", Type: "string"},
+	},
+	"DOM.pushNodesByBackendIdsToFrontend": {
+		Param{Name: "backendNodeIds", Type: "array", Items: &Param{Ref: "DOM.BackendNodeId"}},
+	},
+	"DOM.querySelector": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "selector", Type: "string"},
+	},
+	"DOM.querySelectorAll": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "selector", Type: "string"},
+	},
+	"DOM.redo": {
+	},
+	"DOM.removeAttribute": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "name", Type: "string"},
+	},
+	"DOM.removeNode": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"DOM.requestChildNodes": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "depth", Type: "integer", Optional: true},
+		Param{Name: "pierce", Type: "boolean", Optional: true},
+	},
+	"DOM.requestNode": {
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId"},
+	},
+	"DOM.resolveNode": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId", Optional: true},
+		Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true},
+		Param{Name: "objectGroup", Type: "string", Optional: true},
+		Param{Name: "executionContextId", Ref: "Runtime.ExecutionContextId", Optional: true},
+	},
+	"DOM.setAttributeValue": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "name", Type: "string"},
+		Param{Name: "value", Type: "string"},
+	},
+	"DOM.setAttributesAsText": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "text", Type: "string"},
+		Param{Name: "name", Type: "string", Optional: true},
+	},
+	"DOM.setFileInputFiles": {
+		Param{Name: "files", Type: "array", Items: &Param{Type: "string"}},
+		Param{Name: "nodeId", Ref: "DOM.NodeId", Optional: true},
+		Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true},
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true},
+	},
+	"DOM.setNodeStackTracesEnabled": {
+		Param{Name: "enable", Type: "boolean"},
+	},
+	"DOM.getNodeStackTraces": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"DOM.getFileInfo": {
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId"},
+	},
+	"DOM.setInspectedNode": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+	},
+	"DOM.setNodeName": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "name", Type: "string"},
+	},
+	"DOM.setNodeValue": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "value", Type: "string"},
+	},
+	"DOM.setOuterHTML": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "outerHTML", Type: "string"},
+	},
+	"DOM.undo": {
+	},
+	"DOM.getFrameOwner": {
+		Param{Name: "frameId", Ref: "Page.FrameId"},
+	},
+	"DOMDebugger.getEventListeners": {
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId"},
+		Param{Name: "depth", Type: "integer", Optional: true},
+		Param{Name: "pierce", Type: "boolean", Optional: true},
+	},
+	"DOMDebugger.removeDOMBreakpoint": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "type", Ref: "DOMDebugger.DOMBreakpointType"},
+	},
+	"DOMDebugger.removeEventListenerBreakpoint": {
+		Param{Name: "eventName", Type: "string"},
+		Param{Name: "targetName", Type: "string", Optional: true},
+	},
+	"DOMDebugger.removeInstrumentationBreakpoint": {
+		Param{Name: "eventName", Type: "string"},
+	},
+	"DOMDebugger.removeXHRBreakpoint": {
+		Param{Name: "url", Type: "string"},
+	},
+	"DOMDebugger.setDOMBreakpoint": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "type", Ref: "DOMDebugger.DOMBreakpointType"},
+	},
+	"DOMDebugger.setEventListenerBreakpoint": {
+		Param{Name: "eventName", Type: "string"},
+		Param{Name: "targetName", Type: "string", Optional: true},
+	},
+	"DOMDebugger.setInstrumentationBreakpoint": {
+		Param{Name: "eventName", Type: "string"},
+	},
+	"DOMDebugger.setXHRBreakpoint": {
+		Param{Name: "url", Type: "string"},
+	},
+	"DOMSnapshot.disable": {
+	},
+	"DOMSnapshot.enable": {
+	},
+	"DOMSnapshot.getSnapshot": {
+		Param{Name: "computedStyleWhitelist", Type: "array", Items: &Param{Type: "string"}},
+		Param{Name: "includeEventListeners", Type: "boolean", Optional: true},
+		Param{Name: "includePaintOrder", Type: "boolean", Optional: true},
+		Param{Name: "includeUserAgentShadowTree", Type: "boolean", Optional: true},
+	},
+	"DOMSnapshot.captureSnapshot": {
+		Param{Name: "computedStyles", Type: "array", Items: &Param{Type: "string"}},
+		Param{Name: "includePaintOrder", Type: "boolean", Optional: true},
+		Param{Name: "includeDOMRects", Type: "boolean", Optional: true},
+	},
+	"DOMStorage.clear": {
+		Param{Name: "storageId", Ref: "DOMStorage.StorageId"},
+	},
+	"DOMStorage.disable": {
+	},
+	"DOMStorage.enable": {
+	},
+	"DOMStorage.getDOMStorageItems": {
+		Param{Name: "storageId", Ref: "DOMStorage.StorageId"},
+	},
+	"DOMStorage.removeDOMStorageItem": {
+		Param{Name: "storageId", Ref: "DOMStorage.StorageId"},
+		Param{Name: "key", Type: "string"},
+	},
+	"DOMStorage.setDOMStorageItem": {
+		Param{Name: "storageId", Ref: "DOMStorage.StorageId"},
+		Param{Name: "key", Type: "string"},
+		Param{Name: "value", Type: "string"},
+	},
+	"Database.disable": {
+	},
+	"Database.enable": {
+	},
+	"Database.executeSQL": {
+		Param{Name: "databaseId", Ref: "Database.DatabaseId"},
+		Param{Name: "query", Type: "string"},
+	},
+	"Database.getDatabaseTableNames": {
+		Param{Name: "databaseId", Ref: "Database.DatabaseId"},
+	},
+	"DeviceOrientation.clearDeviceOrientationOverride": {
+	},
+	"DeviceOrientation.setDeviceOrientationOverride": {
+		Param{Name: "alpha", Type: "number"},
+		Param{Name: "beta", Type: "number"},
+		Param{Name: "gamma", Type: "number"},
+	},
+	"Emulation.canEmulate": {
+	},
+	"Emulation.clearDeviceMetricsOverride": {
+	},
+	"Emulation.clearGeolocationOverride": {
+	},
+	"Emulation.resetPageScaleFactor": {
+	},
+	"Emulation.setFocusEmulationEnabled": {
+		Param{Name: "enabled", Type: "boolean"},
+	},
+	"Emulation.setCPUThrottlingRate": {
+		Param{Name: "rate", Type: "number"},
+	},
+	"Emulation.setDefaultBackgroundColorOverride": {
+		Param{Name: "color", Ref: "DOM.RGBA", Optional: true},
+	},
+	"Emulation.setDeviceMetricsOverride": {
+		Param{Name: "width", Type: "integer"},
+		Param{Name: "height", Type: "integer"},
+		Param{Name: "deviceScaleFactor", Type: "number"},
+		Param{Name: "mobile", Type: "boolean"},
+		Param{Name: "scale", Type: "number", Optional: true},
+		Param{Name: "screenWidth", Type: "integer", Optional: true},
+		Param{Name: "screenHeight", Type: "integer", Optional: true},
+		Param{Name: "positionX", Type: "integer", Optional: true},
+		Param{Name: "positionY", Type: "integer", Optional: true},
+		Param{Name: "dontSetVisibleSize", Type: "boolean", Optional: true},
+		Param{Name: "screenOrientation", Ref: "Emulation.ScreenOrientation", Optional: true},
+		Param{Name: "viewport", Ref: "Page.Viewport", Optional: true},
+	},
+	"Emulation.setScrollbarsHidden": {
+		Param{Name: "hidden", Type: "boolean"},
+	},
+	"Emulation.setDocumentCookieDisabled": {
+		Param{Name: "disabled", Type: "boolean"},
+	},
+	"Emulation.setEmitTouchEventsForMouse": {
+		Param{Name: "enabled", Type: "boolean"},
+		Param{Name: "configuration", Type: "string", Enum: []string{"mobile", "desktop"}, Optional: true},
+	},
+	"Emulation.setEmulatedMedia": {
+		Param{Name: "media", Type: "string", Optional: true},
+		Param{Name: "features", Type: "array", Optional: true, Items: &Param{Ref: "Emulation.MediaFeature"}},
+	},
+	"Emulation.setGeolocationOverride": {
+		Param{Name: "latitude", Type: "number", Optional: true},
+		Param{Name: "longitude", Type: "number", Optional: true},
+		Param{Name: "accuracy", Type: "number", Optional: true},
+	},
+	"Emulation.setNavigatorOverrides": {
+		Param{Name: "platform", Type: "string"},
+	},
+	"Emulation.setPageScaleFactor": {
+		Param{Name: "pageScaleFactor", Type: "number"},
+	},
+	"Emulation.setScriptExecutionDisabled": {
+		Param{Name: "value", Type: "boolean"},
+	},
+	"Emulation.setTouchEmulationEnabled": {
+		Param{Name: "enabled", Type: "boolean"},
+		Param{Name: "maxTouchPoints", Type: "integer", Optional: true},
+	},
+	"Emulation.setVirtualTimePolicy": {
+		Param{Name: "policy", Ref: "Emulation.VirtualTimePolicy"},
+		Param{Name: "budget", Type: "number", Optional: true},
+		Param{Name: "maxVirtualTimeTaskStarvationCount", Type: "integer", Optional: true},
+		Param{Name: "waitForNavigation", Type: "boolean", Optional: true},
+		Param{Name: "initialVirtualTime", Ref: "Network.TimeSinceEpoch", Optional: true},
+	},
+	"Emulation.setTimezoneOverride": {
+		Param{Name: "timezoneId", Type: "string"},
+	},
+	"Emulation.setVisibleSize": {
+		Param{Name: "width", Type: "integer"},
+		Param{Name: "height", Type: "integer"},
+	},
+	"Emulation.setUserAgentOverride": {
+		Param{Name: "userAgent", Type: "string"},
+		Param{Name: "acceptLanguage", Type: "string", Optional: true},
+		Param{Name: "platform", Type: "string", Optional: true},
+	},
+	"HeadlessExperimental.beginFrame": {
+		Param{Name: "frameTimeTicks", Type: "number", Optional: true},
+		Param{Name: "interval", Type: "number", Optional: true},
+		Param{Name: "noDisplayUpdates", Type: "boolean", Optional: true},
+		Param{Name: "screenshot", Ref: "HeadlessExperimental.ScreenshotParams", Optional: true},
+	},
+	"HeadlessExperimental.disable": {
+	},
+	"HeadlessExperimental.enable": {
+	},
+	"IO.close": {
+		Param{Name: "handle", Ref: "IO.StreamHandle"},
+	},
+	"IO.read": {
+		Param{Name: "handle", Ref: "IO.StreamHandle"},
+		Param{Name: "offset", Type: "integer", Optional: true},
+		Param{Name: "size", Type: "integer", Optional: true},
+	},
+	"IO.resolveBlob": {
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId"},
+	},
+	"IndexedDB.clearObjectStore": {
+		Param{Name: "securityOrigin", Type: "string"},
+		Param{Name: "databaseName", Type: "string"},
+		Param{Name: "objectStoreName", Type: "string"},
+	},
+	"IndexedDB.deleteDatabase": {
+		Param{Name: "securityOrigin", Type: "string"},
+		Param{Name: "databaseName", Type: "string"},
+	},
+	"IndexedDB.deleteObjectStoreEntries": {
+		Param{Name: "securityOrigin", Type: "string"},
+		Param{Name: "databaseName", Type: "string"},
+		Param{Name: "objectStoreName", Type: "string"},
+		Param{Name: "keyRange", Ref: "IndexedDB.KeyRange"},
+	},
+	"IndexedDB.disable": {
+	},
+	"IndexedDB.enable": {
+	},
+	"IndexedDB.requestData": {
+		Param{Name: "securityOrigin", Type: "string"},
+		Param{Name: "databaseName", Type: "string"},
+		Param{Name: "objectStoreName", Type: "string"},
+		Param{Name: "indexName", Type: "string"},
+		Param{Name: "skipCount", Type: "integer"},
+		Param{Name: "pageSize", Type: "integer"},
+		Param{Name: "keyRange", Ref: "IndexedDB.KeyRange", Optional: true},
+	},
+	"IndexedDB.getMetadata": {
+		Param{Name: "securityOrigin", Type: "string"},
+		Param{Name: "databaseName", Type: "string"},
+		Param{Name: "objectStoreName", Type: "string"},
+	},
+	"IndexedDB.requestDatabase": {
+		Param{Name: "securityOrigin", Type: "string"},
+		Param{Name: "databaseName", Type: "string"},
+	},
+	"IndexedDB.requestDatabaseNames": {
+		Param{Name: "securityOrigin", Type: "string"},
+	},
+	"Input.dispatchKeyEvent": {
+		Param{Name: "type", Type: "string", Enum: []string{"keyDown", "keyUp", "rawKeyDown", "char"}},
+		Param{Name: "modifiers", Type: "integer", Optional: true},
+		Param{Name: "timestamp", Ref: "Input.TimeSinceEpoch", Optional: true},
+		Param{Name: "text", Type: "string", Optional: true},
+		Param{Name: "unmodifiedText", Type: "string", Optional: true},
+		Param{Name: "keyIdentifier", Type: "string", Optional: true},
+		Param{Name: "code", Type: "string", Optional: true},
+		Param{Name: "key", Type: "string", Optional: true},
+		Param{Name: "windowsVirtualKeyCode", Type: "integer", Optional: true},
+		Param{Name: "nativeVirtualKeyCode", Type: "integer", Optional: true},
+		Param{Name: "autoRepeat", Type: "boolean", Optional: true},
+		Param{Name: "isKeypad", Type: "boolean", Optional: true},
+		Param{Name: "isSystemKey", Type: "boolean", Optional: true},
+		Param{Name: "location", Type: "integer", Optional: true},
+	},
+	"Input.insertText": {
+		Param{Name: "text", Type: "string"},
+	},
+	"Input.dispatchMouseEvent": {
+		Param{Name: "type", Type: "string", Enum: []string{"mousePressed", "mouseReleased", "mouseMoved", "mouseWheel"}},
+		Param{Name: "x", Type: "number"},
+		Param{Name: "y", Type: "number"},
+		Param{Name: "modifiers", Type: "integer", Optional: true},
+		Param{Name: "timestamp", Ref: "Input.TimeSinceEpoch", Optional: true},
+		Param{Name: "button", Type: "string", Enum: []string{"none", "left", "middle", "right", "back", "forward"}, Optional: true},
+		Param{Name: "buttons", Type: "integer", Optional: true},
+		Param{Name: "clickCount", Type: "integer", Optional: true},
+		Param{Name: "deltaX", Type: "number", Optional: true},
+		Param{Name: "deltaY", Type: "number", Optional: true},
+		Param{Name: "pointerType", Type: "string", Enum: []string{"mouse", "pen"}, Optional: true},
+	},
+	"Input.dispatchTouchEvent": {
+		Param{Name: "type", Type: "string", Enum: []string{"touchStart", "touchEnd", "touchMove", "touchCancel"}},
+		Param{Name: "touchPoints", Type: "array", Items: &Param{Ref: "Input.TouchPoint"}},
+		Param{Name: "modifiers", Type: "integer", Optional: true},
+		Param{Name: "timestamp", Ref: "Input.TimeSinceEpoch", Optional: true},
+	},
+	"Input.emulateTouchFromMouseEvent": {
+		Param{Name: "type", Type: "string", Enum: []string{"mousePressed", "mouseReleased", "mouseMoved", "mouseWheel"}},
+		Param{Name: "x", Type: "integer"},
+		Param{Name: "y", Type: "integer"},
+		Param{Name: "button", Type: "string", Enum: []string{"none", "left", "middle", "right"}},
+		Param{Name: "timestamp", Ref: "Input.TimeSinceEpoch", Optional: true},
+		Param{Name: "deltaX", Type: "number", Optional: true},
+		Param{Name: "deltaY", Type: "number", Optional: true},
+		Param{Name: "modifiers", Type: "integer", Optional: true},
+		Param{Name: "clickCount", Type: "integer", Optional: true},
+	},
+	"Input.setIgnoreInputEvents": {
+		Param{Name: "ignore", Type: "boolean"},
+	},
+	"Input.synthesizePinchGesture": {
+		Param{Name: "x", Type: "number"},
+		Param{Name: "y", Type: "number"},
+		Param{Name: "scaleFactor", Type: "number"},
+		Param{Name: "relativeSpeed", Type: "integer", Optional: true},
+		Param{Name: "gestureSourceType", Ref: "Input.GestureSourceType", Optional: true},
+	},
+	"Input.synthesizeScrollGesture": {
+		Param{Name: "x", Type: "number"},
+		Param{Name: "y", Type: "number"},
+		Param{Name: "xDistance", Type: "number", Optional: true},
+		Param{Name: "yDistance", Type: "number", Optional: true},
+		Param{Name: "xOverscroll", Type: "number", Optional: true},
+		Param{Name: "yOverscroll", Type: "number", Optional: true},
+		Param{Name: "preventFling", Type: "boolean", Optional: true},
+		Param{Name: "speed", Type: "integer", Optional: true},
+		Param{Name: "gestureSourceType", Ref: "Input.GestureSourceType", Optional: true},
+		Param{Name: "repeatCount", Type: "integer", Optional: true},
+		Param{Name: "repeatDelayMs", Type: "integer", Optional: true},
+		Param{Name: "interactionMarkerName", Type: "string", Optional: true},
+	},
+	"Input.synthesizeTapGesture": {
+		Param{Name: "x", Type: "number"},
+		Param{Name: "y", Type: "number"},
+		Param{Name: "duration", Type: "integer", Optional: true},
+		Param{Name: "tapCount", Type: "integer", Optional: true},
+		Param{Name: "gestureSourceType", Ref: "Input.GestureSourceType", Optional: true},
+	},
+	"Inspector.disable": {
+	},
+	"Inspector.enable": {
+	},
+	"LayerTree.compositingReasons": {
+		Param{Name: "layerId", Ref: "LayerTree.LayerId"},
+	},
+	"LayerTree.disable": {
+	},
+	"LayerTree.enable": {
+	},
+	"LayerTree.loadSnapshot": {
+		Param{Name: "tiles", Type: "array", Items: &Param{Ref: "LayerTree.PictureTile"}},
+	},
+	"LayerTree.makeSnapshot": {
+		Param{Name: "layerId", Ref: "LayerTree.LayerId"},
+	},
+	"LayerTree.profileSnapshot": {
+		Param{Name: "snapshotId", Ref: "LayerTree.SnapshotId"},
+		Param{Name: "minRepeatCount", Type: "integer", Optional: true},
+		Param{Name: "minDuration", Type: "number", Optional: true},
+		Param{Name: "clipRect", Ref: "DOM.Rect", Optional: true},
+	},
+	"LayerTree.releaseSnapshot": {
+		Param{Name: "snapshotId", Ref: "LayerTree.SnapshotId"},
+	},
+	"LayerTree.replaySnapshot": {
+		Param{Name: "snapshotId", Ref: "LayerTree.SnapshotId"},
+		Param{Name: "fromStep", Type: "integer", Optional: true},
+		Param{Name: "toStep", Type: "integer", Optional: true},
+		Param{Name: "scale", Type: "number", Optional: true},
+	},
+	"LayerTree.snapshotCommandLog": {
+		Param{Name: "snapshotId", Ref: "LayerTree.SnapshotId"},
+	},
+	"Log.clear": {
+	},
+	"Log.disable": {
+	},
+	"Log.enable": {
+	},
+	"Log.startViolationsReport": {
+		Param{Name: "config", Type: "array", Items: &Param{Ref: "Log.ViolationSetting"}},
+	},
+	"Log.stopViolationsReport": {
+	},
+	"Memory.getDOMCounters": {
+	},
+	"Memory.prepareForLeakDetection": {
+	},
+	"Memory.forciblyPurgeJavaScriptMemory": {
+	},
+	"Memory.setPressureNotificationsSuppressed": {
+		Param{Name: "suppressed", Type: "boolean"},
+	},
+	"Memory.simulatePressureNotification": {
+		Param{Name: "level", Ref: "Memory.PressureLevel"},
+	},
+	"Memory.startSampling": {
+		Param{Name: "samplingInterval", Type: "integer", Optional: true},
+		Param{Name: "suppressRandomness", Type: "boolean", Optional: true},
+	},
+	"Memory.stopSampling": {
+	},
+	"Memory.getAllTimeSamplingProfile": {
+	},
+	"Memory.getBrowserSamplingProfile": {
+	},
+	"Memory.getSamplingProfile": {
+	},
+	"Network.canClearBrowserCache": {
+	},
+	"Network.canClearBrowserCookies": {
+	},
+	"Network.canEmulateNetworkConditions": {
+	},
+	"Network.clearBrowserCache": {
+	},
+	"Network.clearBrowserCookies": {
+	},
+	"Network.continueInterceptedRequest": {
+		Param{Name: "interceptionId", Ref: "Network.InterceptionId"},
+		Param{Name: "errorReason", Ref: "Network.ErrorReason", Optional: true},
+		Param{Name: "rawResponse", Type: "binary", Optional: true},
+		Param{Name: "url", Type: "string", Optional: true},
+		Param{Name: "method", Type: "string", Optional: true},
+		Param{Name: "postData", Type: "string", Optional: true},
+		Param{Name: "headers", Ref: "Network.Headers", Optional: true},
+		Param{Name: "authChallengeResponse", Ref: "Network.AuthChallengeResponse", Optional: true},
+	},
+	"Network.deleteCookies": {
+		Param{Name: "name", Type: "string"},
+		Param{Name: "url", Type: "string", Optional: true},
+		Param{Name: "domain", Type: "string", Optional: true},
+		Param{Name: "path", Type: "string", Optional: true},
+	},
+	"Network.disable": {
+	},
+	"Network.emulateNetworkConditions": {
+		Param{Name: "offline", Type: "boolean"},
+		Param{Name: "latency", Type: "number"},
+		Param{Name: "downloadThroughput", Type: "number"},
+		Param{Name: "uploadThroughput", Type: "number"},
+		Param{Name: "connectionType", Ref: "Network.ConnectionType", Optional: true},
+	},
+	"Network.enable": {
+		Param{Name: "maxTotalBufferSize", Type: "integer", Optional: true},
+		Param{Name: "maxResourceBufferSize", Type: "integer", Optional: true},
+		Param{Name: "maxPostDataSize", Type: "integer", Optional: true},
+	},
+	"Network.getAllCookies": {
+	},
+	"Network.getCertificate": {
+		Param{Name: "origin", Type: "string"},
+	},
+	"Network.getCookies": {
+		Param{Name: "urls", Type: "array", Optional: true, Items: &Param{Type: "string"}},
+	},
+	"Network.getResponseBody": {
+		Param{Name: "requestId", Ref: "Network.RequestId"},
+	},
+	"Network.getRequestPostData": {
+		Param{Name: "requestId", Ref: "Network.RequestId"},
+	},
+	"Network.getResponseBodyForInterception": {
+		Param{Name: "interceptionId", Ref: "Network.InterceptionId"},
+	},
+	"Network.takeResponseBodyForInterceptionAsStream": {
+		Param{Name: "interceptionId", Ref: "Network.InterceptionId"},
+	},
+	"Network.replayXHR": {
+		Param{Name: "requestId", Ref: "Network.RequestId"},
+	},
+	"Network.searchInResponseBody": {
+		Param{Name: "requestId", Ref: "Network.RequestId"},
+		Param{Name: "query", Type: "string"},
+		Param{Name: "caseSensitive", Type: "boolean", Optional: true},
+		Param{Name: "isRegex", Type: "boolean", Optional: true},
+	},
+	"Network.setBlockedURLs": {
+		Param{Name: "urls", Type: "array", Items: &Param{Type: "string"}},
+	},
+	"Network.setBypassServiceWorker": {
+		Param{Name: "bypass", Type: "boolean"},
+	},
+	"Network.setCacheDisabled": {
+		Param{Name: "cacheDisabled", Type: "boolean"},
+	},
+	"Network.setCookie": {
+		Param{Name: "name", Type: "string"},
+		Param{Name: "value", Type: "string"},
+		Param{Name: "url", Type: "string", Optional: true},
+		Param{Name: "domain", Type: "string", Optional: true},
+		Param{Name: "path", Type: "string", Optional: true},
+		Param{Name: "secure", Type: "boolean", Optional: true},
+		Param{Name: "httpOnly", Type: "boolean", Optional: true},
+		Param{Name: "sameSite", Ref: "Network.CookieSameSite", Optional: true},
+		Param{Name: "expires", Ref: "Network.TimeSinceEpoch", Optional: true},
+	},
+	"Network.setCookies": {
+		Param{Name: "cookies", Type: "array", Items: &Param{Ref: "Network.CookieParam"}},
+	},
+	"Network.setDataSizeLimitsForTest": {
+		Param{Name: "maxTotalSize", Type: "integer"},
+		Param{Name: "maxResourceSize", Type: "integer"},
+	},
+	"Network.setExtraHTTPHeaders": {
+		Param{Name: "headers", Ref: "Network.Headers"},
+	},
+	"Network.setRequestInterception": {
+		Param{Name: "patterns", Type: "array", Items: &Param{Ref: "Network.RequestPattern"}},
+	},
+	"Network.setUserAgentOverride": {
+		Param{Name: "userAgent", Type: "string"},
+		Param{Name: "acceptLanguage", Type: "string", Optional: true},
+		Param{Name: "platform", Type: "string", Optional: true},
+	},
+	"Overlay.disable": {
+	},
+	"Overlay.enable": {
+	},
+	"Overlay.getHighlightObjectForTest": {
+		Param{Name: "nodeId", Ref: "DOM.NodeId"},
+		Param{Name: "includeDistance", Type: "boolean", Optional: true},
+		Param{Name: "includeStyle", Type: "boolean", Optional: true},
+	},
+	"Overlay.hideHighlight": {
+	},
+	"Overlay.highlightFrame": {
+		Param{Name: "frameId", Ref: "Page.FrameId"},
+		Param{Name: "contentColor", Ref: "DOM.RGBA", Optional: true},
+		Param{Name: "contentOutlineColor", Ref: "DOM.RGBA", Optional: true},
+	},
+	"Overlay.highlightNode": {
+		Param{Name: "highlightConfig", Ref: "Overlay.HighlightConfig"},
+		Param{Name: "nodeId", Ref: "DOM.NodeId", Optional: true},
+		Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true},
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true},
+		Param{Name: "selector", Type: "string", Optional: true},
+	},
+	"Overlay.highlightQuad": {
+		Param{Name: "quad", Ref: "DOM.Quad"},
+		Param{Name: "color", Ref: "DOM.RGBA", Optional: true},
+		Param{Name: "outlineColor", Ref: "DOM.RGBA", Optional: true},
+	},
+	"Overlay.highlightRect": {
+		Param{Name: "x", Type: "integer"},
+		Param{Name: "y", Type: "integer"},
+		Param{Name: "width", Type: "integer"},
+		Param{Name: "height", Type: "integer"},
+		Param{Name: "color", Ref: "DOM.RGBA", Optional: true},
+		Param{Name: "outlineColor", Ref: "DOM.RGBA", Optional: true},
+	},
+	"Overlay.setInspectMode": {
+		Param{Name: "mode", Ref: "Overlay.InspectMode"},
+		Param{Name: "highlightConfig", Ref: "Overlay.HighlightConfig", Optional: true},
+	},
+	"Overlay.setShowAdHighlights": {
+		Param{Name: "show", Type: "boolean"},
+	},
+	"Overlay.setPausedInDebuggerMessage": {
+		Param{Name: "message", Type: "string", Optional: true},
+	},
+	"Overlay.setShowDebugBorders": {
+		Param{Name: "show", Type: "boolean"},
+	},
+	"Overlay.setShowFPSCounter": {
+		Param{Name: "show", Type: "boolean"},
+	},
+	"Overlay.setShowPaintRects": {
+		Param{Name: "result", Type: "boolean"},
+	},
+	"Overlay.setShowLayoutShiftRegions": {
+		Param{Name: "result", Type: "boolean"},
+	},
+	"Overlay.setShowScrollBottleneckRects": {
+		Param{Name: "show", Type: "boolean"},
+	},
+	"Overlay.setShowHitTestBorders": {
+		Param{Name: "show", Type: "boolean"},
+	},
+	"Overlay.setShowViewportSizeOnResize": {
+		Param{Name: "show", Type: "boolean"},
+	},
+	"Page.addScriptToEvaluateOnLoad": {
+		Param{Name: "scriptSource", Type: "string"},
+	},
+	"Page.addScriptToEvaluateOnNewDocument": {
+		Param{Name: "source", Type: "string"},
+		Param{Name: "worldName", Type: "string", Optional: true},
+	},
+	"Page.bringToFront": {
+	},
+	"Page.captureScreenshot": {
+		Param{Name: "format", Type: "string", Enum: []string{"jpeg", "png"}, Optional: true},
+		Param{Name: "quality", Type: "integer", Optional: true},
+		Param{Name: "clip", Ref: "Page.Viewport", Optional: true},
+		Param{Name: "fromSurface", Type: "boolean", Optional: true},
+	},
+	"Page.captureSnapshot": {
+		Param{Name: "format", Type: "string", Enum: []string{"mhtml"}, Optional: true},
+	},
+	"Page.clearDeviceMetricsOverride": {
+	},
+	"Page.clearDeviceOrientationOverride": {
+	},
+	"Page.clearGeolocationOverride": {
+	},
+	"Page.createIsolatedWorld": {
+		Param{Name: "frameId", Ref: "Page.FrameId"},
+		Param{Name: "worldName", Type: "string", Optional: true},
+		Param{Name: "grantUniveralAccess", Type: "boolean", Optional: true},
+	},
+	"Page.deleteCookie": {
+		Param{Name: "cookieName", Type: "string"},
+		Param{Name: "url", Type: "string"},
+	},
+	"Page.disable": {
+	},
+	"Page.enable": {
+	},
+	"Page.getAppManifest": {
+	},
+	"Page.getInstallabilityErrors": {
+	},
+	"Page.getCookies": {
+	},
+	"Page.getFrameTree": {
+	},
+	"Page.getLayoutMetrics": {
+	},
+	"Page.getNavigationHistory": {
+	},
+	"Page.resetNavigationHistory": {
+	},
+	"Page.getResourceContent": {
+		Param{Name: "frameId", Ref: "Page.FrameId"},
+		Param{Name: "url", Type: "string"},
+	},
+	"Page.getResourceTree": {
+	},
+	"Page.handleJavaScriptDialog": {
+		Param{Name: "accept", Type: "boolean"},
+		Param{Name: "promptText", Type: "string", Optional: true},
+	},
+	"Page.navigate": {
+		Param{Name: "url", Type: "string"},
+		Param{Name: "referrer", Type: "string", Optional: true},
+		Param{Name: "transitionType", Ref: "Page.TransitionType", Optional: true},
+		Param{Name: "frameId", Ref: "Page.FrameId", Optional: true},
+	},
+	"Page.navigateToHistoryEntry": {
+		Param{Name: "entryId", Type: "integer"},
+	},
+	"Page.printToPDF": {
+		Param{Name: "landscape", Type: "boolean", Optional: true},
+		Param{Name: "displayHeaderFooter", Type: "boolean", Optional: true},
+		Param{Name: "printBackground", Type: "boolean", Optional: true},
+		Param{Name: "scale", Type: "number", Optional: true},
+		Param{Name: "paperWidth", Type: "number", Optional: true},
+		Param{Name: "paperHeight", Type: "number", Optional: true},
+		Param{Name: "marginTop", Type: "number", Optional: true},
+		Param{Name: "marginBottom", Type: "number", Optional: true},
+		Param{Name: "marginLeft", Type: "number", Optional: true},
+		Param{Name: "marginRight", Type: "number", Optional: true},
+		Param{Name: "pageRanges", Type: "string", Optional: true},
+		Param{Name: "ignoreInvalidPageRanges", Type: "boolean", Optional: true},
+		Param{Name: "headerTemplate", Type: "string", Optional: true},
+		Param{Name: "footerTemplate", Type: "string", Optional: true},
+		Param{Name: "preferCSSPageSize", Type: "boolean", Optional: true},
+		Param{Name: "transferMode", Type: "string", Enum: []string{"ReturnAsBase64", "ReturnAsStream"}, Optional: true},
+	},
+	"Page.reload": {
+		Param{Name: "ignoreCache", Type: "boolean", Optional: true},
+		Param{Name: "scriptToEvaluateOnLoad", Type: "string", Optional: true},
+	},
+	"Page.removeScriptToEvaluateOnLoad": {
+		Param{Name: "identifier", Ref: "Page.ScriptIdentifier"},
+	},
+	"Page.removeScriptToEvaluateOnNewDocument": {
+		Param{Name: "identifier", Ref: "Page.ScriptIdentifier"},
+	},
+	"Page.screencastFrameAck": {
+		Param{Name: "sessionId", Type: "integer"},
+	},
+	"Page.searchInResource": {
+		Param{Name: "frameId", Ref: "Page.FrameId"},
+		Param{Name: "url", Type: "string"},
+		Param{Name: "query", Type: "string"},
+		Param{Name: "caseSensitive", Type: "boolean", Optional: true},
+		Param{Name: "isRegex", Type: "boolean", Optional: true},
+	},
+	"Page.setAdBlockingEnabled": {
+		Param{Name: "enabled", Type: "boolean"},
+	},
+	"Page.setBypassCSP": {
+		Param{Name: "enabled", Type: "boolean"},
+	},
+	"Page.setDeviceMetricsOverride": {
+		Param{Name: "width", Type: "integer"},
+		Param{Name: "height", Type: "integer"},
+		Param{Name: "deviceScaleFactor", Type: "number"},
+		Param{Name: "mobile", Type: "boolean"},
+		Param{Name: "scale", Type: "number", Optional: true},
+		Param{Name: "screenWidth", Type: "integer", Optional: true},
+		Param{Name: "screenHeight", Type: "integer", Optional: true},
+		Param{Name: "positionX", Type: "integer", Optional: true},
+		Param{Name: "positionY", Type: "integer", Optional: true},
+		Param{Name: "dontSetVisibleSize", Type: "boolean", Optional: true},
+		Param{Name: "screenOrientation", Ref: "Emulation.ScreenOrientation", Optional: true},
+		Param{Name: "viewport", Ref: "Page.Viewport", Optional: true},
+	},
+	"Page.setDeviceOrientationOverride": {
+		Param{Name: "alpha", Type: "number"},
+		Param{Name: "beta", Type: "number"},
+		Param{Name: "gamma", Type: "number"},
+	},
+	"Page.setFontFamilies": {
+		Param{Name: "fontFamilies", Ref: "Page.FontFamilies"},
+	},
+	"Page.setFontSizes": {
+		Param{Name: "fontSizes", Ref: "Page.FontSizes"},
+	},
+	"Page.setDocumentContent": {
+		Param{Name: "frameId", Ref: "Page.FrameId"},
+		Param{Name: "html", Type: "string"},
+	},
+	"Page.setDownloadBehavior": {
+		Param{Name: "behavior", Type: "string", Enum: []string{"deny", "allow", "default"}},
+		Param{Name: "downloadPath", Type: "string", Optional: true},
+	},
+	"Page.setGeolocationOverride": {
+		Param{Name: "latitude", Type: "number", Optional: true},
+		Param{Name: "longitude", Type: "number", Optional: true},
+		Param{Name: "accuracy", Type: "number", Optional: true},
+	},
+	"Page.setLifecycleEventsEnabled": {
+		Param{Name: "enabled", Type: "boolean"},
+	},
+	"Page.setTouchEmulationEnabled": {
+		Param{Name: "enabled", Type: "boolean"},
+		Param{Name: "configuration", Type: "string", Enum: []string{"mobile", "desktop"}, Optional: true},
+	},
+	"Page.startScreencast": {
+		Param{Name: "format", Type: "string", Enum: []string{"jpeg", "png"}, Optional: true},
+		Param{Name: "quality", Type: "integer", Optional: true},
+		Param{Name: "maxWidth", Type: "integer", Optional: true},
+		Param{Name: "maxHeight", Type: "integer", Optional: true},
+		Param{Name: "everyNthFrame", Type: "integer", Optional: true},
+	},
+	"Page.stopLoading": {
+	},
+	"Page.crash": {
+	},
+	"Page.close": {
+	},
+	"Page.setWebLifecycleState": {
+		Param{Name: "state", Type: "string", Enum: []string{"frozen", "active"}},
+	},
+	"Page.stopScreencast": {
+	},
+	"Page.setProduceCompilationCache": {
+		Param{Name: "enabled", Type: "boolean"},
+	},
+	"Page.addCompilationCache": {
+		Param{Name: "url", Type: "string"},
+		Param{Name: "data", Type: "binary"},
+	},
+	"Page.clearCompilationCache": {
+	},
+	"Page.generateTestReport": {
+		Param{Name: "message", Type: "string"},
+		Param{Name: "group", Type: "string", Optional: true},
+	},
+	"Page.waitForDebugger": {
+	},
+	"Page.setInterceptFileChooserDialog": {
+		Param{Name: "enabled", Type: "boolean"},
+	},
+	"Page.handleFileChooser": {
+		Param{Name: "action", Type: "string", Enum: []string{"accept", "cancel", "fallback"}},
+		Param{Name: "files", Type: "array", Optional: true, Items: &Param{Type: "string"}},
+	},
+	"Performance.disable": {
+	},
+	"Performance.enable": {
+	},
+	"Performance.setTimeDomain": {
+		Param{Name: "timeDomain", Type: "string", Enum: []string{"timeTicks", "threadTicks"}},
+	},
+	"Performance.getMetrics": {
+	},
+	"Security.disable": {
+	},
+	"Security.enable": {
+	},
+	"Security.setIgnoreCertificateErrors": {
+		Param{Name: "ignore", Type: "boolean"},
+	},
+	"Security.handleCertificateError": {
+		Param{Name: "eventId", Type: "integer"},
+		Param{Name: "action", Ref: "Security.CertificateErrorAction"},
+	},
+	"Security.setOverrideCertificateErrors": {
+		Param{Name: "override", Type: "boolean"},
+	},
+	"ServiceWorker.deliverPushMessage": {
+		Param{Name: "origin", Type: "string"},
+		Param{Name: "registrationId", Ref: "ServiceWorker.RegistrationID"},
+		Param{Name: "data", Type: "string"},
+	},
+	"ServiceWorker.disable": {
+	},
+	"ServiceWorker.dispatchSyncEvent": {
+		Param{Name: "origin", Type: "string"},
+		Param{Name: "registrationId", Ref: "ServiceWorker.RegistrationID"},
+		Param{Name: "tag", Type: "string"},
+		Param{Name: "lastChance", Type: "boolean"},
+	},
+	"ServiceWorker.dispatchPeriodicSyncEvent": {
+		Param{Name: "origin", Type: "string"},
+		Param{Name: "registrationId", Ref: "ServiceWorker.RegistrationID"},
+		Param{Name: "tag", Type: "string"},
+	},
+	"ServiceWorker.enable": {
+	},
+	"ServiceWorker.inspectWorker": {
+		Param{Name: "versionId", Type: "string"},
+	},
+	"ServiceWorker.setForceUpdateOnPageLoad": {
+		Param{Name: "forceUpdateOnPageLoad", Type: "boolean"},
+	},
+	"ServiceWorker.skipWaiting": {
+		Param{Name: "scopeURL", Type: "string"},
+	},
+	"ServiceWorker.startWorker": {
+		Param{Name: "scopeURL", Type: "string"},
+	},
+	"ServiceWorker.stopAllWorkers": {
+	},
+	"ServiceWorker.stopWorker": {
+		Param{Name: "versionId", Type: "string"},
+	},
+	"ServiceWorker.unregister": {
+		Param{Name: "scopeURL", Type: "string"},
+	},
+	"ServiceWorker.updateRegistration": {
+		Param{Name: "scopeURL", Type: "string"},
+	},
+	"Storage.clearDataForOrigin": {
+		Param{Name: "origin", Type: "string"},
+		Param{Name: "storageTypes", Type: "string"},
+	},
+	"Storage.getUsageAndQuota": {
+		Param{Name: "origin", Type: "string"},
+	},
+	"Storage.trackCacheStorageForOrigin": {
+		Param{Name: "origin", Type: "string"},
+	},
+	"Storage.trackIndexedDBForOrigin": {
+		Param{Name: "origin", Type: "string"},
+	},
+	"Storage.untrackCacheStorageForOrigin": {
+		Param{Name: "origin", Type: "string"},
+	},
+	"Storage.untrackIndexedDBForOrigin": {
+		Param{Name: "origin", Type: "string"},
+	},
+	"SystemInfo.getInfo": {
+	},
+	"SystemInfo.getProcessInfo": {
+	},
+	"Target.activateTarget": {
+		Param{Name: "targetId", Ref: "Target.TargetID"},
+	},
+	"Target.attachToTarget": {
+		Param{Name: "targetId", Ref: "Target.TargetID"},
+		Param{Name: "flatten", Type: "boolean", Optional: true},
+	},
+	"Target.attachToBrowserTarget": {
+	},
+	"Target.closeTarget": {
+		Param{Name: "targetId", Ref: "Target.TargetID"},
+	},
+	"Target.exposeDevToolsProtocol": {
+		Param{Name: "targetId", Ref: "Target.TargetID"},
+		Param{Name: "bindingName", Type: "string", Optional: true},
+	},
+	"Target.createBrowserContext": {
+	},
+	"Target.getBrowserContexts": {
+	},
+	"Target.createTarget": {
+		Param{Name: "url", Type: "string"},
+		Param{Name: "width", Type: "integer", Optional: true},
+		Param{Name: "height", Type: "integer", Optional: true},
+		Param{Name: "browserContextId", Ref: "Target.BrowserContextID", Optional: true},
+		Param{Name: "enableBeginFrameControl", Type: "boolean", Optional: true},
+		Param{Name: "newWindow", Type: "boolean", Optional: true},
+		Param{Name: "background", Type: "boolean", Optional: true},
+	},
+	"Target.detachFromTarget": {
+		Param{Name: "sessionId", Ref: "Target.SessionID", Optional: true},
+		Param{Name: "targetId", Ref: "Target.TargetID", Optional: true},
+	},
+	"Target.disposeBrowserContext": {
+		Param{Name: "browserContextId", Ref: "Target.BrowserContextID"},
+	},
+	"Target.getTargetInfo": {
+		Param{Name: "targetId", Ref: "Target.TargetID", Optional: true},
+	},
+	"Target.getTargets": {
+	},
+	"Target.sendMessageToTarget": {
+		Param{Name: "message", Type: "string"},
+		Param{Name: "sessionId", Ref: "Target.SessionID", Optional: true},
+		Param{Name: "targetId", Ref: "Target.TargetID", Optional: true},
+	},
+	"Target.setAutoAttach": {
+		Param{Name: "autoAttach", Type: "boolean"},
+		Param{Name: "waitForDebuggerOnStart", Type: "boolean"},
+		Param{Name: "flatten", Type: "boolean", Optional: true},
+		Param{Name: "windowOpen", Type: "boolean", Optional: true},
+	},
+	"Target.setDiscoverTargets": {
+		Param{Name: "discover", Type: "boolean"},
+	},
+	"Target.setRemoteLocations": {
+		Param{Name: "locations", Type: "array", Items: &Param{Ref: "Target.RemoteLocation"}},
+	},
+	"Tethering.bind": {
+		Param{Name: "port", Type: "integer"},
+	},
+	"Tethering.unbind": {
+		Param{Name: "port", Type: "integer"},
+	},
+	"Tracing.end": {
+	},
+	"Tracing.getCategories": {
+	},
+	"Tracing.recordClockSyncMarker": {
+		Param{Name: "syncId", Type: "string"},
+	},
+	"Tracing.requestMemoryDump": {
+		Param{Name: "deterministic", Type: "boolean", Optional: true},
+	},
+	"Tracing.start": {
+		Param{Name: "categories", Type: "string", Optional: true},
+		Param{Name: "options", Type: "string", Optional: true},
+		Param{Name: "bufferUsageReportingInterval", Type: "number", Optional: true},
+		Param{Name: "transferMode", Type: "string", Enum: []string{"ReportEvents", "ReturnAsStream"}, Optional: true},
+		Param{Name: "streamFormat", Ref: "Tracing.StreamFormat", Optional: true},
+		Param{Name: "streamCompression", Ref: "Tracing.StreamCompression", Optional: true},
+		Param{Name: "traceConfig", Ref: "Tracing.TraceConfig", Optional: true},
+	},
+	"Fetch.disable": {
+	},
+	"Fetch.enable": {
+		Param{Name: "patterns", Type: "array", Optional: true, Items: &Param{Ref: "Fetch.RequestPattern"}},
+		Param{Name: "handleAuthRequests", Type: "boolean", Optional: true},
+	},
+	"Fetch.failRequest": {
+		Param{Name: "requestId", Ref: "Fetch.RequestId"},
+		Param{Name: "errorReason", Ref: "Network.ErrorReason"},
+	},
+	"Fetch.fulfillRequest": {
+		Param{Name: "requestId", Ref: "Fetch.RequestId"},
+		Param{Name: "responseCode", Type: "integer"},
+		Param{Name: "responseHeaders", Type: "array", Optional: true, Items: &Param{Ref: "Fetch.HeaderEntry"}},
+		Param{Name: "binaryResponseHeaders", Type: "binary", Optional: true},
+		Param{Name: "body", Type: "binary", Optional: true},
+		Param{Name: "responsePhrase", Type: "string", Optional: true},
+	},
+	"Fetch.continueRequest": {
+		Param{Name: "requestId", Ref: "Fetch.RequestId"},
+		Param{Name: "url", Type: "string", Optional: true},
+		Param{Name: "method", Type: "string", Optional: true},
+		Param{Name: "postData", Type: "string", Optional: true},
+		Param{Name: "headers", Type: "array", Optional: true, Items: &Param{Ref: "Fetch.HeaderEntry"}},
+	},
+	"Fetch.continueWithAuth": {
+		Param{Name: "requestId", Ref: "Fetch.RequestId"},
+		Param{Name: "authChallengeResponse", Ref: "Fetch.AuthChallengeResponse"},
+	},
+	"Fetch.getResponseBody": {
+		Param{Name: "requestId", Ref: "Fetch.RequestId"},
+	},
+	"Fetch.takeResponseBodyAsStream": {
+		Param{Name: "requestId", Ref: "Fetch.RequestId"},
+	},
+	"WebAudio.enable": {
+	},
+	"WebAudio.disable": {
+	},
+	"WebAudio.getRealtimeData": {
+		Param{Name: "contextId", Ref: "WebAudio.GraphObjectId"},
+	},
+	"WebAuthn.enable": {
+	},
+	"WebAuthn.disable": {
+	},
+	"WebAuthn.addVirtualAuthenticator": {
+		Param{Name: "options", Ref: "WebAuthn.VirtualAuthenticatorOptions"},
+	},
+	"WebAuthn.removeVirtualAuthenticator": {
+		Param{Name: "authenticatorId", Ref: "WebAuthn.AuthenticatorId"},
+	},
+	"WebAuthn.addCredential": {
+		Param{Name: "authenticatorId", Ref: "WebAuthn.AuthenticatorId"},
+		Param{Name: "credential", Ref: "WebAuthn.Credential"},
+	},
+	"WebAuthn.getCredential": {
+		Param{Name: "authenticatorId", Ref: "WebAuthn.AuthenticatorId"},
+		Param{Name: "credentialId", Type: "binary"},
+	},
+	"WebAuthn.getCredentials": {
+		Param{Name: "authenticatorId", Ref: "WebAuthn.AuthenticatorId"},
+	},
+	"WebAuthn.removeCredential": {
+		Param{Name: "authenticatorId", Ref: "WebAuthn.AuthenticatorId"},
+		Param{Name: "credentialId", Type: "binary"},
+	},
+	"WebAuthn.clearCredentials": {
+		Param{Name: "authenticatorId", Ref: "WebAuthn.AuthenticatorId"},
+	},
+	"WebAuthn.setUserVerified": {
+		Param{Name: "authenticatorId", Ref: "WebAuthn.AuthenticatorId"},
+		Param{Name: "isUserVerified", Type: "boolean"},
+	},
+	"Media.enable": {
+	},
+	"Media.disable": {
+	},
+	"Console.clearMessages": {
+	},
+	"Console.disable": {
+	},
+	"Console.enable": {
+	},
+	"Debugger.continueToLocation": {
+		Param{Name: "location", Ref: "Debugger.Location"},
+		Param{Name: "targetCallFrames", Type: "string", Enum: []string{"any", "current"}, Optional: true},
+	},
+	"Debugger.disable": {
+	},
+	"Debugger.enable": {
+		Param{Name: "maxScriptsCacheSize", Type: "number", Optional: true},
+	},
+	"Debugger.evaluateOnCallFrame": {
+		Param{Name: "callFrameId", Ref: "Debugger.CallFrameId"},
+		Param{Name: "expression", Type: "string"},
+		Param{Name: "objectGroup", Type: "string", Optional: true},
+		Param{Name: "includeCommandLineAPI", Type: "boolean", Optional: true},
+		Param{Name: "silent", Type: "boolean", Optional: true},
+		Param{Name: "returnByValue", Type: "boolean", Optional: true},
+		Param{Name: "generatePreview", Type: "boolean", Optional: true},
+		Param{Name: "throwOnSideEffect", Type: "boolean", Optional: true},
+		Param{Name: "timeout", Ref: "Runtime.TimeDelta", Optional: true},
+	},
+	"Debugger.getPossibleBreakpoints": {
+		Param{Name: "start", Ref: "Debugger.Location"},
+		Param{Name: "end", Ref: "Debugger.Location", Optional: true},
+		Param{Name: "restrictToFunction", Type: "boolean", Optional: true},
+	},
+	"Debugger.getScriptSource": {
+		Param{Name: "scriptId", Ref: "Runtime.ScriptId"},
+	},
+	"Debugger.getWasmBytecode": {
+		Param{Name: "scriptId", Ref: "Runtime.ScriptId"},
+	},
+	"Debugger.getStackTrace": {
+		Param{Name: "stackTraceId", Ref: "Runtime.StackTraceId"},
+	},
+	"Debugger.pause": {
+	},
+	"Debugger.pauseOnAsyncCall": {
+		Param{Name: "parentStackTraceId", Ref: "Runtime.StackTraceId"},
+	},
+	"Debugger.removeBreakpoint": {
+		Param{Name: "breakpointId", Ref: "Debugger.BreakpointId"},
+	},
+	"Debugger.restartFrame": {
+		Param{Name: "callFrameId", Ref: "Debugger.CallFrameId"},
+	},
+	"Debugger.resume": {
+	},
+	"Debugger.searchInContent": {
+		Param{Name: "scriptId", Ref: "Runtime.ScriptId"},
+		Param{Name: "query", Type: "string"},
+		Param{Name: "caseSensitive", Type: "boolean", Optional: true},
+		Param{Name: "isRegex", Type: "boolean", Optional: true},
+	},
+	"Debugger.setAsyncCallStackDepth": {
+		Param{Name: "maxDepth", Type: "integer"},
+	},
+	"Debugger.setBlackboxPatterns": {
+		Param{Name: "patterns", Type: "array", Items: &Param{Type: "string"}},
+	},
+	"Debugger.setBlackboxedRanges": {
+		Param{Name: "scriptId", Ref: "Runtime.ScriptId"},
+		Param{Name: "positions", Type: "array", Items: &Param{Ref: "Debugger.ScriptPosition"}},
+	},
+	"Debugger.setBreakpoint": {
+		Param{Name: "location", Ref: "Debugger.Location"},
+		Param{Name: "condition", Type: "string", Optional: true},
+	},
+	"Debugger.setInstrumentationBreakpoint": {
+		Param{Name: "instrumentation", Type: "string", Enum: []string{"beforeScriptExecution", "beforeScriptWithSourceMapExecution"}},
+	},
+	"Debugger.setBreakpointByUrl": {
+		Param{Name: "lineNumber", Type: "integer"},
+		Param{Name: "url", Type: "string", Optional: true},
+		Param{Name: "urlRegex", Type: "string", Optional: true},
+		Param{Name: "scriptHash", Type: "string", Optional: true},
+		Param{Name: "columnNumber", Type: "integer", Optional: true},
+		Param{Name: "condition", Type: "string", Optional: true},
+	},
+	"Debugger.setBreakpointOnFunctionCall": {
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId"},
+		Param{Name: "condition", Type: "string", Optional: true},
+	},
+	"Debugger.setBreakpointsActive": {
+		Param{Name: "active", Type: "boolean"},
+	},
+	"Debugger.setPauseOnExceptions": {
+		Param{Name: "state", Type: "string", Enum: []string{"none", "uncaught", "all"}},
+	},
+	"Debugger.setReturnValue": {
+		Param{Name: "newValue", Ref: "Runtime.CallArgument"},
+	},
+	"Debugger.setScriptSource": {
+		Param{Name: "scriptId", Ref: "Runtime.ScriptId"},
+		Param{Name: "scriptSource", Type: "string"},
+		Param{Name: "dryRun", Type: "boolean", Optional: true},
+	},
+	"Debugger.setSkipAllPauses": {
+		Param{Name: "skip", Type: "boolean"},
+	},
+	"Debugger.setVariableValue": {
+		Param{Name: "scopeNumber", Type: "integer"},
+		Param{Name: "variableName", Type: "string"},
+		Param{Name: "newValue", Ref: "Runtime.CallArgument"},
+		Param{Name: "callFrameId", Ref: "Debugger.CallFrameId"},
+	},
+	"Debugger.stepInto": {
+		Param{Name: "breakOnAsyncCall", Type: "boolean", Optional: true},
+	},
+	"Debugger.stepOut": {
+	},
+	"Debugger.stepOver": {
+	},
+	"HeapProfiler.addInspectedHeapObject": {
+		Param{Name: "heapObjectId", Ref: "HeapProfiler.HeapSnapshotObjectId"},
+	},
+	"HeapProfiler.collectGarbage": {
+	},
+	"HeapProfiler.disable": {
+	},
+	"HeapProfiler.enable": {
+	},
+	"HeapProfiler.getHeapObjectId": {
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId"},
+	},
+	"HeapProfiler.getObjectByHeapObjectId": {
+		Param{Name: "objectId", Ref: "HeapProfiler.HeapSnapshotObjectId"},
+		Param{Name: "objectGroup", Type: "string", Optional: true},
+	},
+	"HeapProfiler.getSamplingProfile": {
+	},
+	"HeapProfiler.startSampling": {
+		Param{Name: "samplingInterval", Type: "number", Optional: true},
+	},
+	"HeapProfiler.startTrackingHeapObjects": {
+		Param{Name: "trackAllocations", Type: "boolean", Optional: true},
+	},
+	"HeapProfiler.stopSampling": {
+	},
+	"HeapProfiler.stopTrackingHeapObjects": {
+		Param{Name: "reportProgress", Type: "boolean", Optional: true},
+	},
+	"HeapProfiler.takeHeapSnapshot": {
+		Param{Name: "reportProgress", Type: "boolean", Optional: true},
+	},
+	"Profiler.disable": {
+	},
+	"Profiler.enable": {
+	},
+	"Profiler.getBestEffortCoverage": {
+	},
+	"Profiler.setSamplingInterval": {
+		Param{Name: "interval", Type: "integer"},
+	},
+	"Profiler.start": {
+	},
+	"Profiler.startPreciseCoverage": {
+		Param{Name: "callCount", Type: "boolean", Optional: true},
+		Param{Name: "detailed", Type: "boolean", Optional: true},
+	},
+	"Profiler.startTypeProfile": {
+	},
+	"Profiler.stop": {
+	},
+	"Profiler.stopPreciseCoverage": {
+	},
+	"Profiler.stopTypeProfile": {
+	},
+	"Profiler.takePreciseCoverage": {
+	},
+	"Profiler.takeTypeProfile": {
+	},
+	"Runtime.awaitPromise": {
+		Param{Name: "promiseObjectId", Ref: "Runtime.RemoteObjectId"},
+		Param{Name: "returnByValue", Type: "boolean", Optional: true},
+		Param{Name: "generatePreview", Type: "boolean", Optional: true},
+	},
+	"Runtime.callFunctionOn": {
+		Param{Name: "functionDeclaration", Type: "string"},
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true},
+		Param{Name: "arguments", Type: "array", Optional: true, Items: &Param{Ref: "Runtime.CallArgument"}},
+		Param{Name: "silent", Type: "boolean", Optional: true},
+		Param{Name: "returnByValue", Type: "boolean", Optional: true},
+		Param{Name: "generatePreview", Type: "boolean", Optional: true},
+		Param{Name: "userGesture", Type: "boolean", Optional: true},
+		Param{Name: "awaitPromise", Type: "boolean", Optional: true},
+		Param{Name: "executionContextId", Ref: "Runtime.ExecutionContextId", Optional: true},
+		Param{Name: "objectGroup", Type: "string", Optional: true},
+	},
+	"Runtime.compileScript": {
+		Param{Name: "expression", Type: "string"},
+		Param{Name: "sourceURL", Type: "string"},
+		Param{Name: "persistScript", Type: "boolean"},
+		Param{Name: "executionContextId", Ref: "Runtime.ExecutionContextId", Optional: true},
+	},
+	"Runtime.disable": {
+	},
+	"Runtime.discardConsoleEntries": {
+	},
+	"Runtime.enable": {
+	},
+	"Runtime.evaluate": {
+		Param{Name: "expression", Type: "string"},
+		Param{Name: "objectGroup", Type: "string", Optional: true},
+		Param{Name: "includeCommandLineAPI", Type: "boolean", Optional: true},
+		Param{Name: "silent", Type: "boolean", Optional: true},
+		Param{Name: "contextId", Ref: "Runtime.ExecutionContextId", Optional: true},
+		Param{Name: "returnByValue", Type: "boolean", Optional: true},
+		Param{Name: "generatePreview", Type: "boolean", Optional: true},
+		Param{Name: "userGesture", Type: "boolean", Optional: true},
+		Param{Name: "awaitPromise", Type: "boolean", Optional: true},
+		Param{Name: "throwOnSideEffect", Type: "boolean", Optional: true},
+		Param{Name: "timeout", Ref: "Runtime.TimeDelta", Optional: true},
+		Param{Name: "disableBreaks", Type: "boolean", Optional: true},
+	},
+	"Runtime.getIsolateId": {
+	},
+	"Runtime.getHeapUsage": {
+	},
+	"Runtime.getProperties": {
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId"},
+		Param{Name: "ownProperties", Type: "boolean", Optional: true},
+		Param{Name: "accessorPropertiesOnly", Type: "boolean", Optional: true},
+		Param{Name: "generatePreview", Type: "boolean", Optional: true},
+	},
+	"Runtime.globalLexicalScopeNames": {
+		Param{Name: "executionContextId", Ref: "Runtime.ExecutionContextId", Optional: true},
+	},
+	"Runtime.queryObjects": {
+		Param{Name: "prototypeObjectId", Ref: "Runtime.RemoteObjectId"},
+		Param{Name: "objectGroup", Type: "string", Optional: true},
+	},
+	"Runtime.releaseObject": {
+		Param{Name: "objectId", Ref: "Runtime.RemoteObjectId"},
+	},
+	"Runtime.releaseObjectGroup": {
+		Param{Name: "objectGroup", Type: "string"},
+	},
+	"Runtime.runIfWaitingForDebugger": {
+	},
+	"Runtime.runScript": {
+		Param{Name: "scriptId", Ref: "Runtime.ScriptId"},
+		Param{Name: "executionContextId", Ref: "Runtime.ExecutionContextId", Optional: true},
+		Param{Name: "objectGroup", Type: "string", Optional: true},
+		Param{Name: "silent", Type: "boolean", Optional: true},
+		Param{Name: "includeCommandLineAPI", Type: "boolean", Optional: true},
+		Param{Name: "returnByValue", Type: "boolean", Optional: true},
+		Param{Name: "generatePreview", Type: "boolean", Optional: true},
+		Param{Name: "awaitPromise", Type: "boolean", Optional: true},
+	},
+	"Runtime.setAsyncCallStackDepth": {
+		Param{Name: "maxDepth", Type: "integer"},
+	},
+	"Runtime.setCustomObjectFormatterEnabled": {
+		Param{Name: "enabled", Type: "boolean"},
+	},
+	"Runtime.setMaxCallStackSizeToCapture": {
+		Param{Name: "size", Type: "integer"},
+	},
+	"Runtime.terminateExecution": {
+	},
+	"Runtime.addBinding": {
+		Param{Name: "name", Type: "string"},
+		Param{Name: "executionContextId", Ref: "Runtime.ExecutionContextId", Optional: true},
+	},
+	"Runtime.removeBinding": {
+		Param{Name: "name", Type: "string"},
+	},
+	"Schema.getDomains": {
+	},
+}
+
+// Named types, keyed by qualified type name.
+var Types = map[string]Param{
+	"Accessibility.AXNodeId": Param{Type: "string"},
+	"Accessibility.AXValueType": Param{Type: "string", Enum: []string{"boolean", "tristate", "booleanOrUndefined", "idref", "idrefList", "integer", "node", "nodeList", "number", "string", "computedString", "token", "tokenList", "domRelation", "role", "internalRole", "valueUndefined"}},
+	"Accessibility.AXValueSourceType": Param{Type: "string", Enum: []string{"attribute", "implicit", "style", "contents", "placeholder", "relatedElement"}},
+	"Accessibility.AXValueNativeSourceType": Param{Type: "string", Enum: []string{"figcaption", "label", "labelfor", "labelwrapped", "legend", "tablecaption", "title", "other"}},
+	"Accessibility.AXValueSource": Param{Type: "object", Properties: []Param{Param{Name: "type", Ref: "Accessibility.AXValueSourceType"}, Param{Name: "value", Ref: "Accessibility.AXValue", Optional: true}, Param{Name: "attribute", Type: "string", Optional: true}, Param{Name: "attributeValue", Ref: "Accessibility.AXValue", Optional: true}, Param{Name: "superseded", Type: "boolean", Optional: true}, Param{Name: "nativeSource", Ref: "Accessibility.AXValueNativeSourceType", Optional: true}, Param{Name: "nativeSourceValue", Ref: "Accessibility.AXValue", Optional: true}, Param{Name: "invalid", Type: "boolean", Optional: true}, Param{Name: "invalidReason", Type: "string", Optional: true}}},
+	"Accessibility.AXRelatedNode": Param{Type: "object", Properties: []Param{Param{Name: "backendDOMNodeId", Ref: "DOM.BackendNodeId"}, Param{Name: "idref", Type: "string", Optional: true}, Param{Name: "text", Type: "string", Optional: true}}},
+	"Accessibility.AXProperty": Param{Type: "object", Properties: []Param{Param{Name: "name", Ref: "Accessibility.AXPropertyName"}, Param{Name: "value", Ref: "Accessibility.AXValue"}}},
+	"Accessibility.AXValue": Param{Type: "object", Properties: []Param{Param{Name: "type", Ref: "Accessibility.AXValueType"}, Param{Name: "value", Type: "any", Optional: true}, Param{Name: "relatedNodes", Type: "array", Optional: true, Items: &Param{Ref: "Accessibility.AXRelatedNode"}}, Param{Name: "sources", Type: "array", Optional: true, Items: &Param{Ref: "Accessibility.AXValueSource"}}}},
+	"Accessibility.AXPropertyName": Param{Type: "string", Enum: []string{"busy", "disabled", "editable", "focusable", "focused", "hidden", "hiddenRoot", "invalid", "keyshortcuts", "settable", "roledescription", "live", "atomic", "relevant", "root", "autocomplete", "hasPopup", "level", "multiselectable", "orientation", "multiline", "readonly", "required", "valuemin", "valuemax", "valuetext", "checked", "expanded", "modal", "pressed", "selected", "activedescendant", "controls", "describedby", "details", "errormessage", "flowto", "labelledby", "owns"}},
+	"Accessibility.AXNode": Param{Type: "object", Properties: []Param{Param{Name: "nodeId", Ref: "Accessibility.AXNodeId"}, Param{Name: "ignored", Type: "boolean"}, Param{Name: "ignoredReasons", Type: "array", Optional: true, Items: &Param{Ref: "Accessibility.AXProperty"}}, Param{Name: "role", Ref: "Accessibility.AXValue", Optional: true}, Param{Name: "name", Ref: "Accessibility.AXValue", Optional: true}, Param{Name: "description", Ref: "Accessibility.AXValue", Optional: true}, Param{Name: "value", Ref: "Accessibility.AXValue", Optional: true}, Param{Name: "properties", Type: "array", Optional: true, Items: &Param{Ref: "Accessibility.AXProperty"}}, Param{Name: "childIds", Type: "array", Optional: true, Items: &Param{Ref: "Accessibility.AXNodeId"}}, Param{Name: "backendDOMNodeId", Ref: "DOM.BackendNodeId", Optional: true}}},
+	"Animation.Animation": Param{Type: "object", Properties: []Param{Param{Name: "id", Type: "string"}, Param{Name: "name", Type: "string"}, Param{Name: "pausedState", Type: "boolean"}, Param{Name: "playState", Type: "string"}, Param{Name: "playbackRate", Type: "number"}, Param{Name: "startTime", Type: "number"}, Param{Name: "currentTime", Type: "number"}, Param{Name: "type", Type: "string", Enum: []string{"CSSTransition", "CSSAnimation", "WebAnimation"}}, Param{Name: "source", Ref: "Animation.AnimationEffect", Optional: true}, Param{Name: "cssId", Type: "string", Optional: true}}},
+	"Animation.AnimationEffect": Param{Type: "object", Properties: []Param{Param{Name: "delay", Type: "number"}, Param{Name: "endDelay", Type: "number"}, Param{Name: "iterationStart", Type: "number"}, Param{Name: "iterations", Type: "number"}, Param{Name: "duration", Type: "number"}, Param{Name: "direction", Type: "string"}, Param{Name: "fill", Type: "string"}, Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true}, Param{Name: "keyframesRule", Ref: "Animation.KeyframesRule", Optional: true}, Param{Name: "easing", Type: "string"}}},
+	"Animation.KeyframesRule": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string", Optional: true}, Param{Name: "keyframes", Type: "array", Items: &Param{Ref: "Animation.KeyframeStyle"}}}},
+	"Animation.KeyframeStyle": Param{Type: "object", Properties: []Param{Param{Name: "offset", Type: "string"}, Param{Name: "easing", Type: "string"}}},
+	"ApplicationCache.ApplicationCacheResource": Param{Type: "object", Properties: []Param{Param{Name: "url", Type: "string"}, Param{Name: "size", Type: "integer"}, Param{Name: "type", Type: "string"}}},
+	"ApplicationCache.ApplicationCache": Param{Type: "object", Properties: []Param{Param{Name: "manifestURL", Type: "string"}, Param{Name: "size", Type: "number"}, Param{Name: "creationTime", Type: "number"}, Param{Name: "updateTime", Type: "number"}, Param{Name: "resources", Type: "array", Items: &Param{Ref: "ApplicationCache.ApplicationCacheResource"}}}},
+	"ApplicationCache.FrameWithManifest": Param{Type: "object", Properties: []Param{Param{Name: "frameId", Ref: "Page.FrameId"}, Param{Name: "manifestURL", Type: "string"}, Param{Name: "status", Type: "integer"}}},
+	"BackgroundService.ServiceName": Param{Type: "string", Enum: []string{"backgroundFetch", "backgroundSync", "pushMessaging", "notifications", "paymentHandler", "periodicBackgroundSync"}},
+	"BackgroundService.EventMetadata": Param{Type: "object", Properties: []Param{Param{Name: "key", Type: "string"}, Param{Name: "value", Type: "string"}}},
+	"BackgroundService.BackgroundServiceEvent": Param{Type: "object", Properties: []Param{Param{Name: "timestamp", Ref: "Network.TimeSinceEpoch"}, Param{Name: "origin", Type: "string"}, Param{Name: "serviceWorkerRegistrationId", Ref: "ServiceWorker.RegistrationID"}, Param{Name: "service", Ref: "BackgroundService.ServiceName"}, Param{Name: "eventName", Type: "string"}, Param{Name: "instanceId", Type: "string"}, Param{Name: "eventMetadata", Type: "array", Items: &Param{Ref: "BackgroundService.EventMetadata"}}}},
+	"Browser.WindowID": Param{Type: "integer"},
+	"Browser.WindowState": Param{Type: "string", Enum: []string{"normal", "minimized", "maximized", "fullscreen"}},
+	"Browser.Bounds": Param{Type: "object", Properties: []Param{Param{Name: "left", Type: "integer", Optional: true}, Param{Name: "top", Type: "integer", Optional: true}, Param{Name: "width", Type: "integer", Optional: true}, Param{Name: "height", Type: "integer", Optional: true}, Param{Name: "windowState", Ref: "Browser.WindowState", Optional: true}}},
+	"Browser.PermissionType": Param{Type: "string", Enum: []string{"accessibilityEvents", "audioCapture", "backgroundSync", "backgroundFetch", "clipboardRead", "clipboardWrite", "durableStorage", "flash", "geolocation", "midi", "midiSysex", "notifications", "paymentHandler", "periodicBackgroundSync", "protectedMediaIdentifier", "sensors", "videoCapture", "idleDetection", "wakeLockScreen", "wakeLockSystem"}},
+	"Browser.PermissionSetting": Param{Type: "string", Enum: []string{"granted", "denied", "prompt"}},
+	"Browser.PermissionDescriptor": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "sysex", Type: "boolean", Optional: true}, Param{Name: "userVisibleOnly", Type: "boolean", Optional: true}, Param{Name: "type", Type: "string", Optional: true}}},
+	"Browser.Bucket": Param{Type: "object", Properties: []Param{Param{Name: "low", Type: "integer"}, Param{Name: "high", Type: "integer"}, Param{Name: "count", Type: "integer"}}},
+	"Browser.Histogram": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "sum", Type: "integer"}, Param{Name: "count", Type: "integer"}, Param{Name: "buckets", Type: "array", Items: &Param{Ref: "Browser.Bucket"}}}},
+	"CSS.StyleSheetId": Param{Type: "string"},
+	"CSS.StyleSheetOrigin": Param{Type: "string", Enum: []string{"injected", "user-agent", "inspector", "regular"}},
+	"CSS.PseudoElementMatches": Param{Type: "object", Properties: []Param{Param{Name: "pseudoType", Ref: "DOM.PseudoType"}, Param{Name: "matches", Type: "array", Items: &Param{Ref: "CSS.RuleMatch"}}}},
+	"CSS.InheritedStyleEntry": Param{Type: "object", Properties: []Param{Param{Name: "inlineStyle", Ref: "CSS.CSSStyle", Optional: true}, Param{Name: "matchedCSSRules", Type: "array", Items: &Param{Ref: "CSS.RuleMatch"}}}},
+	"CSS.RuleMatch": Param{Type: "object", Properties: []Param{Param{Name: "rule", Ref: "CSS.CSSRule"}, Param{Name: "matchingSelectors", Type: "array", Items: &Param{Type: "integer"}}}},
+	"CSS.Value": Param{Type: "object", Properties: []Param{Param{Name: "text", Type: "string"}, Param{Name: "range", Ref: "CSS.SourceRange", Optional: true}}},
+	"CSS.SelectorList": Param{Type: "object", Properties: []Param{Param{Name: "selectors", Type: "array", Items: &Param{Ref: "CSS.Value"}}, Param{Name: "text", Type: "string"}}},
+	"CSS.CSSStyleSheetHeader": Param{Type: "object", Properties: []Param{Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"}, Param{Name: "frameId", Ref: "Page.FrameId"}, Param{Name: "sourceURL", Type: "string"}, Param{Name: "sourceMapURL", Type: "string", Optional: true}, Param{Name: "origin", Ref: "CSS.StyleSheetOrigin"}, Param{Name: "title", Type: "string"}, Param{Name: "ownerNode", Ref: "DOM.BackendNodeId", Optional: true}, Param{Name: "disabled", Type: "boolean"}, Param{Name: "hasSourceURL", Type: "boolean", Optional: true}, Param{Name: "isInline", Type: "boolean"}, Param{Name: "startLine", Type: "number"}, Param{Name: "startColumn", Type: "number"}, Param{Name: "length", Type: "number"}, Param{Name: "endLine", Type: "number"}, Param{Name: "endColumn", Type: "number"}}},
+	"CSS.CSSRule": Param{Type: "object", Properties: []Param{Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId", Optional: true}, Param{Name: "selectorList", Ref: "CSS.SelectorList"}, Param{Name: "origin", Ref: "CSS.StyleSheetOrigin"}, Param{Name: "style", Ref: "CSS.CSSStyle"}, Param{Name: "media", Type: "array", Optional: true, Items: &Param{Ref: "CSS.CSSMedia"}}}},
+	"CSS.RuleUsage": Param{Type: "object", Properties: []Param{Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"}, Param{Name: "startOffset", Type: "number"}, Param{Name: "endOffset", Type: "number"}, Param{Name: "used", Type: "boolean"}}},
+	"CSS.SourceRange": Param{Type: "object", Properties: []Param{Param{Name: "startLine", Type: "integer"}, Param{Name: "startColumn", Type: "integer"}, Param{Name: "endLine", Type: "integer"}, Param{Name: "endColumn", Type: "integer"}}},
+	"CSS.ShorthandEntry": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}, Param{Name: "important", Type: "boolean", Optional: true}}},
+	"CSS.CSSComputedStyleProperty": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}}},
+	"CSS.CSSStyle": Param{Type: "object", Properties: []Param{Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId", Optional: true}, Param{Name: "cssProperties", Type: "array", Items: &Param{Ref: "CSS.CSSProperty"}}, Param{Name: "shorthandEntries", Type: "array", Items: &Param{Ref: "CSS.ShorthandEntry"}}, Param{Name: "cssText", Type: "string", Optional: true}, Param{Name: "range", Ref: "CSS.SourceRange", Optional: true}}},
+	"CSS.CSSProperty": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}, Param{Name: "important", Type: "boolean", Optional: true}, Param{Name: "implicit", Type: "boolean", Optional: true}, Param{Name: "text", Type: "string", Optional: true}, Param{Name: "parsedOk", Type: "boolean", Optional: true}, Param{Name: "disabled", Type: "boolean", Optional: true}, Param{Name: "range", Ref: "CSS.SourceRange", Optional: true}}},
+	"CSS.CSSMedia": Param{Type: "object", Properties: []Param{Param{Name: "text", Type: "string"}, Param{Name: "source", Type: "string", Enum: []string{"mediaRule", "importRule", "linkedSheet", "inlineSheet"}}, Param{Name: "sourceURL", Type: "string", Optional: true}, Param{Name: "range", Ref: "CSS.SourceRange", Optional: true}, Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId", Optional: true}, Param{Name: "mediaList", Type: "array", Optional: true, Items: &Param{Ref: "CSS.MediaQuery"}}}},
+	"CSS.MediaQuery": Param{Type: "object", Properties: []Param{Param{Name: "expressions", Type: "array", Items: &Param{Ref: "CSS.MediaQueryExpression"}}, Param{Name: "active", Type: "boolean"}}},
+	"CSS.MediaQueryExpression": Param{Type: "object", Properties: []Param{Param{Name: "value", Type: "number"}, Param{Name: "unit", Type: "string"}, Param{Name: "feature", Type: "string"}, Param{Name: "valueRange", Ref: "CSS.SourceRange", Optional: true}, Param{Name: "computedLength", Type: "number", Optional: true}}},
+	"CSS.PlatformFontUsage": Param{Type: "object", Properties: []Param{Param{Name: "familyName", Type: "string"}, Param{Name: "isCustomFont", Type: "boolean"}, Param{Name: "glyphCount", Type: "number"}}},
+	"CSS.FontFace": Param{Type: "object", Properties: []Param{Param{Name: "fontFamily", Type: "string"}, Param{Name: "fontStyle", Type: "string"}, Param{Name: "fontVariant", Type: "string"}, Param{Name: "fontWeight", Type: "string"}, Param{Name: "fontStretch", Type: "string"}, Param{Name: "unicodeRange", Type: "string"}, Param{Name: "src", Type: "string"}, Param{Name: "platformFontFamily", Type: "string"}}},
+	"CSS.CSSKeyframesRule": Param{Type: "object", Properties: []Param{Param{Name: "animationName", Ref: "CSS.Value"}, Param{Name: "keyframes", Type: "array", Items: &Param{Ref: "CSS.CSSKeyframeRule"}}}},
+	"CSS.CSSKeyframeRule": Param{Type: "object", Properties: []Param{Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId", Optional: true}, Param{Name: "origin", Ref: "CSS.StyleSheetOrigin"}, Param{Name: "keyText", Ref: "CSS.Value"}, Param{Name: "style", Ref: "CSS.CSSStyle"}}},
+	"CSS.StyleDeclarationEdit": Param{Type: "object", Properties: []Param{Param{Name: "styleSheetId", Ref: "CSS.StyleSheetId"}, Param{Name: "range", Ref: "CSS.SourceRange"}, Param{Name: "text", Type: "string"}}},
+	"CacheStorage.CacheId": Param{Type: "string"},
+	"CacheStorage.CachedResponseType": Param{Type: "string", Enum: []string{"basic", "cors", "default", "error", "opaqueResponse", "opaqueRedirect"}},
+	"CacheStorage.DataEntry": Param{Type: "object", Properties: []Param{Param{Name: "requestURL", Type: "string"}, Param{Name: "requestMethod", Type: "string"}, Param{Name: "requestHeaders", Type: "array", Items: &Param{Ref: "CacheStorage.Header"}}, Param{Name: "responseTime", Type: "number"}, Param{Name: "responseStatus", Type: "integer"}, Param{Name: "responseStatusText", Type: "string"}, Param{Name: "responseType", Ref: "CacheStorage.CachedResponseType"}, Param{Name: "responseHeaders", Type: "array", Items: &Param{Ref: "CacheStorage.Header"}}}},
+	"CacheStorage.Cache": Param{Type: "object", Properties: []Param{Param{Name: "cacheId", Ref: "CacheStorage.CacheId"}, Param{Name: "securityOrigin", Type: "string"}, Param{Name: "cacheName", Type: "string"}}},
+	"CacheStorage.Header": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}}},
+	"CacheStorage.CachedResponse": Param{Type: "object", Properties: []Param{Param{Name: "body", Type: "binary"}}},
+	"Cast.Sink": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "id", Type: "string"}, Param{Name: "session", Type: "string", Optional: true}}},
+	"DOM.NodeId": Param{Type: "integer"},
+	"DOM.BackendNodeId": Param{Type: "integer"},
+	"DOM.BackendNode": Param{Type: "object", Properties: []Param{Param{Name: "nodeType", Type: "integer"}, Param{Name: "nodeName", Type: "string"}, Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId"}}},
+	"DOM.PseudoType": Param{Type: "string", Enum: []string{"first-line", "first-letter", "before", "after", "backdrop", "selection", "first-line-inherited", "scrollbar", "scrollbar-thumb", "scrollbar-button", "scrollbar-track", "scrollbar-track-piece", "scrollbar-corner", "resizer", "input-list-button"}},
+	"DOM.ShadowRootType": Param{Type: "string", Enum: []string{"user-agent", "open", "closed"}},
+	"DOM.Node": Param{Type: "object", Properties: []Param{Param{Name: "nodeId", Ref: "DOM.NodeId"}, Param{Name: "parentId", Ref: "DOM.NodeId", Optional: true}, Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId"}, Param{Name: "nodeType", Type: "integer"}, Param{Name: "nodeName", Type: "string"}, Param{Name: "localName", Type: "string"}, Param{Name: "nodeValue", Type: "string"}, Param{Name: "childNodeCount", Type: "integer", Optional: true}, Param{Name: "children", Type: "array", Optional: true, Items: &Param{Ref: "DOM.Node"}}, Param{Name: "attributes", Type: "array", Optional: true, Items: &Param{Type: "string"}}, Param{Name: "documentURL", Type: "string", Optional: true}, Param{Name: "baseURL", Type: "string", Optional: true}, Param{Name: "publicId", Type: "string", Optional: true}, Param{Name: "systemId", Type: "string", Optional: true}, Param{Name: "internalSubset", Type: "string", Optional: true}, Param{Name: "xmlVersion", Type: "string", Optional: true}, Param{Name: "name", Type: "string", Optional: true}, Param{Name: "value", Type: "string", Optional: true}, Param{Name: "pseudoType", Ref: "DOM.PseudoType", Optional: true}, Param{Name: "shadowRootType", Ref: "DOM.ShadowRootType", Optional: true}, Param{Name: "frameId", Ref: "Page.FrameId", Optional: true}, Param{Name: "contentDocument", Ref: "DOM.Node", Optional: true}, Param{Name: "shadowRoots", Type: "array", Optional: true, Items: &Param{Ref: "DOM.Node"}}, Param{Name: "templateContent", Ref: "DOM.Node", Optional: true}, Param{Name: "pseudoElements", Type: "array", Optional: true, Items: &Param{Ref: "DOM.Node"}}, Param{Name: "importedDocument", Ref: "DOM.Node", Optional: true}, Param{Name: "distributedNodes", Type: "array", Optional: true, Items: &Param{Ref: "DOM.BackendNode"}}, Param{Name: "isSVG", Type: "boolean", Optional: true}}},
+	"DOM.RGBA": Param{Type: "object", Properties: []Param{Param{Name: "r", Type: "integer"}, Param{Name: "g", Type: "integer"}, Param{Name: "b", Type: "integer"}, Param{Name: "a", Type: "number", Optional: true}}},
+	"DOM.Quad": Param{Type: "array", Items: &Param{Type: "number"}},
+	"DOM.BoxModel": Param{Type: "object", Properties: []Param{Param{Name: "content", Ref: "DOM.Quad"}, Param{Name: "padding", Ref: "DOM.Quad"}, Param{Name: "border", Ref: "DOM.Quad"}, Param{Name: "margin", Ref: "DOM.Quad"}, Param{Name: "width", Type: "integer"}, Param{Name: "height", Type: "integer"}, Param{Name: "shapeOutside", Ref: "DOM.ShapeOutsideInfo", Optional: true}}},
+	"DOM.ShapeOutsideInfo": Param{Type: "object", Properties: []Param{Param{Name: "bounds", Ref: "DOM.Quad"}, Param{Name: "shape", Type: "array", Items: &Param{Type: "any"}}, Param{Name: "marginShape", Type: "array", Items: &Param{Type: "any"}}}},
+	"DOM.Rect": Param{Type: "object", Properties: []Param{Param{Name: "x", Type: "number"}, Param{Name: "y", Type: "number"}, Param{Name: "width", Type: "number"}, Param{Name: "height", Type: "number"}}},
+	"DOMDebugger.DOMBreakpointType": Param{Type: "string", Enum: []string{"subtree-modified", "attribute-modified", "node-removed"}},
+	"DOMDebugger.EventListener": Param{Type: "object", Properties: []Param{Param{Name: "type", Type: "string"}, Param{Name: "useCapture", Type: "boolean"}, Param{Name: "passive", Type: "boolean"}, Param{Name: "once", Type: "boolean"}, Param{Name: "scriptId", Ref: "Runtime.ScriptId"}, Param{Name: "lineNumber", Type: "integer"}, Param{Name: "columnNumber", Type: "integer"}, Param{Name: "handler", Ref: "Runtime.RemoteObject", Optional: true}, Param{Name: "originalHandler", Ref: "Runtime.RemoteObject", Optional: true}, Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true}}},
+	"DOMSnapshot.DOMNode": Param{Type: "object", Properties: []Param{Param{Name: "nodeType", Type: "integer"}, Param{Name: "nodeName", Type: "string"}, Param{Name: "nodeValue", Type: "string"}, Param{Name: "textValue", Type: "string", Optional: true}, Param{Name: "inputValue", Type: "string", Optional: true}, Param{Name: "inputChecked", Type: "boolean", Optional: true}, Param{Name: "optionSelected", Type: "boolean", Optional: true}, Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId"}, Param{Name: "childNodeIndexes", Type: "array", Optional: true, Items: &Param{Type: "integer"}}, Param{Name: "attributes", Type: "array", Optional: true, Items: &Param{Ref: "DOMSnapshot.NameValue"}}, Param{Name: "pseudoElementIndexes", Type: "array", Optional: true, Items: &Param{Type: "integer"}}, Param{Name: "layoutNodeIndex", Type: "integer", Optional: true}, Param{Name: "documentURL", Type: "string", Optional: true}, Param{Name: "baseURL", Type: "string", Optional: true}, Param{Name: "contentLanguage", Type: "string", Optional: true}, Param{Name: "documentEncoding", Type: "string", Optional: true}, Param{Name: "publicId", Type: "string", Optional: true}, Param{Name: "systemId", Type: "string", Optional: true}, Param{Name: "frameId", Ref: "Page.FrameId", Optional: true}, Param{Name: "contentDocumentIndex", Type: "integer", Optional: true}, Param{Name: "pseudoType", Ref: "DOM.PseudoType", Optional: true}, Param{Name: "shadowRootType", Ref: "DOM.ShadowRootType", Optional: true}, Param{Name: "isClickable", Type: "boolean", Optional: true}, Param{Name: "eventListeners", Type: "array", Optional: true, Items: &Param{Ref: "DOMDebugger.EventListener"}}, Param{Name: "currentSourceURL", Type: "string", Optional: true}, Param{Name: "originURL", Type: "string", Optional: true}, Param{Name: "scrollOffsetX", Type: "number", Optional: true}, Param{Name: "scrollOffsetY", Type: "number", Optional: true}}},
+	"DOMSnapshot.InlineTextBox": Param{Type: "object", Properties: []Param{Param{Name: "boundingBox", Ref: "DOM.Rect"}, Param{Name: "startCharacterIndex", Type: "integer"}, Param{Name: "numCharacters", Type: "integer"}}},
+	"DOMSnapshot.LayoutTreeNode": Param{Type: "object", Properties: []Param{Param{Name: "domNodeIndex", Type: "integer"}, Param{Name: "boundingBox", Ref: "DOM.Rect"}, Param{Name: "layoutText", Type: "string", Optional: true}, Param{Name: "inlineTextNodes", Type: "array", Optional: true, Items: &Param{Ref: "DOMSnapshot.InlineTextBox"}}, Param{Name: "styleIndex", Type: "integer", Optional: true}, Param{Name: "paintOrder", Type: "integer", Optional: true}, Param{Name: "isStackingContext", Type: "boolean", Optional: true}}},
+	"DOMSnapshot.ComputedStyle": Param{Type: "object", Properties: []Param{Param{Name: "properties", Type: "array", Items: &Param{Ref: "DOMSnapshot.NameValue"}}}},
+	"DOMSnapshot.NameValue": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}}},
+	"DOMSnapshot.StringIndex": Param{Type: "integer"},
+	"DOMSnapshot.ArrayOfStrings": Param{Type: "array", Items: &Param{Ref: "DOMSnapshot.StringIndex"}},
+	"DOMSnapshot.RareStringData": Param{Type: "object", Properties: []Param{Param{Name: "index", Type: "array", Items: &Param{Type: "integer"}}, Param{Name: "value", Type: "array", Items: &Param{Ref: "DOMSnapshot.StringIndex"}}}},
+	"DOMSnapshot.RareBooleanData": Param{Type: "object", Properties: []Param{Param{Name: "index", Type: "array", Items: &Param{Type: "integer"}}}},
+	"DOMSnapshot.RareIntegerData": Param{Type: "object", Properties: []Param{Param{Name: "index", Type: "array", Items: &Param{Type: "integer"}}, Param{Name: "value", Type: "array", Items: &Param{Type: "integer"}}}},
+	"DOMSnapshot.Rectangle": Param{Type: "array", Items: &Param{Type: "number"}},
+	"DOMSnapshot.DocumentSnapshot": Param{Type: "object", Properties: []Param{Param{Name: "documentURL", Ref: "DOMSnapshot.StringIndex"}, Param{Name: "title", Ref: "DOMSnapshot.StringIndex"}, Param{Name: "baseURL", Ref: "DOMSnapshot.StringIndex"}, Param{Name: "contentLanguage", Ref: "DOMSnapshot.StringIndex"}, Param{Name: "encodingName", Ref: "DOMSnapshot.StringIndex"}, Param{Name: "publicId", Ref: "DOMSnapshot.StringIndex"}, Param{Name: "systemId", Ref: "DOMSnapshot.StringIndex"}, Param{Name: "frameId", Ref: "DOMSnapshot.StringIndex"}, Param{Name: "nodes", Ref: "DOMSnapshot.NodeTreeSnapshot"}, Param{Name: "layout", Ref: "DOMSnapshot.LayoutTreeSnapshot"}, Param{Name: "textBoxes", Ref: "DOMSnapshot.TextBoxSnapshot"}, Param{Name: "scrollOffsetX", Type: "number", Optional: true}, Param{Name: "scrollOffsetY", Type: "number", Optional: true}, Param{Name: "contentWidth", Type: "number", Optional: true}, Param{Name: "contentHeight", Type: "number", Optional: true}}},
+	"DOMSnapshot.NodeTreeSnapshot": Param{Type: "object", Properties: []Param{Param{Name: "parentIndex", Type: "array", Optional: true, Items: &Param{Type: "integer"}}, Param{Name: "nodeType", Type: "array", Optional: true, Items: &Param{Type: "integer"}}, Param{Name: "nodeName", Type: "array", Optional: true, Items: &Param{Ref: "DOMSnapshot.StringIndex"}}, Param{Name: "nodeValue", Type: "array", Optional: true, Items: &Param{Ref: "DOMSnapshot.StringIndex"}}, Param{Name: "backendNodeId", Type: "array", Optional: true, Items: &Param{Ref: "DOM.BackendNodeId"}}, Param{Name: "attributes", Type: "array", Optional: true, Items: &Param{Ref: "DOMSnapshot.ArrayOfStrings"}}, Param{Name: "textValue", Ref: "DOMSnapshot.RareStringData", Optional: true}, Param{Name: "inputValue", Ref: "DOMSnapshot.RareStringData", Optional: true}, Param{Name: "inputChecked", Ref: "DOMSnapshot.RareBooleanData", Optional: true}, Param{Name: "optionSelected", Ref: "DOMSnapshot.RareBooleanData", Optional: true}, Param{Name: "contentDocumentIndex", Ref: "DOMSnapshot.RareIntegerData", Optional: true}, Param{Name: "pseudoType", Ref: "DOMSnapshot.RareStringData", Optional: true}, Param{Name: "isClickable", Ref: "DOMSnapshot.RareBooleanData", Optional: true}, Param{Name: "currentSourceURL", Ref: "DOMSnapshot.RareStringData", Optional: true}, Param{Name: "originURL", Ref: "DOMSnapshot.RareStringData", Optional: true}}},
+	"DOMSnapshot.LayoutTreeSnapshot": Param{Type: "object", Properties: []Param{Param{Name: "nodeIndex", Type: "array", Items: &Param{Type: "integer"}}, Param{Name: "styles", Type: "array", Items: &Param{Ref: "DOMSnapshot.ArrayOfStrings"}}, Param{Name: "bounds", Type: "array", Items: &Param{Ref: "DOMSnapshot.Rectangle"}}, Param{Name: "text", Type: "array", Items: &Param{Ref: "DOMSnapshot.StringIndex"}}, Param{Name: "stackingContexts", Ref: "DOMSnapshot.RareBooleanData"}, Param{Name: "paintOrders", Type: "array", Optional: true, Items: &Param{Type: "integer"}}, Param{Name: "offsetRects", Type: "array", Optional: true, Items: &Param{Ref: "DOMSnapshot.Rectangle"}}, Param{Name: "scrollRects", Type: "array", Optional: true, Items: &Param{Ref: "DOMSnapshot.Rectangle"}}, Param{Name: "clientRects", Type: "array", Optional: true, Items: &Param{Ref: "DOMSnapshot.Rectangle"}}}},
+	"DOMSnapshot.TextBoxSnapshot": Param{Type: "object", Properties: []Param{Param{Name: "layoutIndex", Type: "array", Items: &Param{Type: "integer"}}, Param{Name: "bounds", Type: "array", Items: &Param{Ref: "DOMSnapshot.Rectangle"}}, Param{Name: "start", Type: "array", Items: &Param{Type: "integer"}}, Param{Name: "length", Type: "array", Items: &Param{Type: "integer"}}}},
+	"DOMStorage.StorageId": Param{Type: "object", Properties: []Param{Param{Name: "securityOrigin", Type: "string"}, Param{Name: "isLocalStorage", Type: "boolean"}}},
+	"DOMStorage.Item": Param{Type: "array", Items: &Param{Type: "string"}},
+	"Database.DatabaseId": Param{Type: "string"},
+	"Database.Database": Param{Type: "object", Properties: []Param{Param{Name: "id", Ref: "Database.DatabaseId"}, Param{Name: "domain", Type: "string"}, Param{Name: "name", Type: "string"}, Param{Name: "version", Type: "string"}}},
+	"Database.Error": Param{Type: "object", Properties: []Param{Param{Name: "message", Type: "string"}, Param{Name: "code", Type: "integer"}}},
+	"Emulation.ScreenOrientation": Param{Type: "object", Properties: []Param{Param{Name: "type", Type: "string", Enum: []string{"portraitPrimary", "portraitSecondary", "landscapePrimary", "landscapeSecondary"}}, Param{Name: "angle", Type: "integer"}}},
+	"Emulation.MediaFeature": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}}},
+	"Emulation.VirtualTimePolicy": Param{Type: "string", Enum: []string{"advance", "pause", "pauseIfNetworkFetchesPending"}},
+	"HeadlessExperimental.ScreenshotParams": Param{Type: "object", Properties: []Param{Param{Name: "format", Type: "string", Enum: []string{"jpeg", "png"}, Optional: true}, Param{Name: "quality", Type: "integer", Optional: true}}},
+	"IO.StreamHandle": Param{Type: "string"},
+	"IndexedDB.DatabaseWithObjectStores": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "version", Type: "number"}, Param{Name: "objectStores", Type: "array", Items: &Param{Ref: "IndexedDB.ObjectStore"}}}},
+	"IndexedDB.ObjectStore": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "keyPath", Ref: "IndexedDB.KeyPath"}, Param{Name: "autoIncrement", Type: "boolean"}, Param{Name: "indexes", Type: "array", Items: &Param{Ref: "IndexedDB.ObjectStoreIndex"}}}},
+	"IndexedDB.ObjectStoreIndex": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "keyPath", Ref: "IndexedDB.KeyPath"}, Param{Name: "unique", Type: "boolean"}, Param{Name: "multiEntry", Type: "boolean"}}},
+	"IndexedDB.Key": Param{Type: "object", Properties: []Param{Param{Name: "type", Type: "string", Enum: []string{"number", "string", "date", "array"}}, Param{Name: "number", Type: "number", Optional: true}, Param{Name: "string", Type: "string", Optional: true}, Param{Name: "date", Type: "number", Optional: true}, Param{Name: "array", Type: "array", Optional: true, Items: &Param{Ref: "IndexedDB.Key"}}}},
+	"IndexedDB.KeyRange": Param{Type: "object", Properties: []Param{Param{Name: "lower", Ref: "IndexedDB.Key", Optional: true}, Param{Name: "upper", Ref: "IndexedDB.Key", Optional: true}, Param{Name: "lowerOpen", Type: "boolean"}, Param{Name: "upperOpen", Type: "boolean"}}},
+	"IndexedDB.DataEntry": Param{Type: "object", Properties: []Param{Param{Name: "key", Ref: "Runtime.RemoteObject"}, Param{Name: "primaryKey", Ref: "Runtime.RemoteObject"}, Param{Name: "value", Ref: "Runtime.RemoteObject"}}},
+	"IndexedDB.KeyPath": Param{Type: "object", Properties: []Param{Param{Name: "type", Type: "string", Enum: []string{"null", "string", "array"}}, Param{Name: "string", Type: "string", Optional: true}, Param{Name: "array", Type: "array", Optional: true, Items: &Param{Type: "string"}}}},
+	"Input.TouchPoint": Param{Type: "object", Properties: []Param{Param{Name: "x", Type: "number"}, Param{Name: "y", Type: "number"}, Param{Name: "radiusX", Type: "number", Optional: true}, Param{Name: "radiusY", Type: "number", Optional: true}, Param{Name: "rotationAngle", Type: "number", Optional: true}, Param{Name: "force", Type: "number", Optional: true}, Param{Name: "id", Type: "number", Optional: true}}},
+	"Input.GestureSourceType": Param{Type: "string", Enum: []string{"default", "touch", "mouse"}},
+	"Input.TimeSinceEpoch": Param{Type: "number"},
+	"LayerTree.LayerId": Param{Type: "string"},
+	"LayerTree.SnapshotId": Param{Type: "string"},
+	"LayerTree.ScrollRect": Param{Type: "object", Properties: []Param{Param{Name: "rect", Ref: "DOM.Rect"}, Param{Name: "type", Type: "string", Enum: []string{"RepaintsOnScroll", "TouchEventHandler", "WheelEventHandler"}}}},
+	"LayerTree.StickyPositionConstraint": Param{Type: "object", Properties: []Param{Param{Name: "stickyBoxRect", Ref: "DOM.Rect"}, Param{Name: "containingBlockRect", Ref: "DOM.Rect"}, Param{Name: "nearestLayerShiftingStickyBox", Ref: "LayerTree.LayerId", Optional: true}, Param{Name: "nearestLayerShiftingContainingBlock", Ref: "LayerTree.LayerId", Optional: true}}},
+	"LayerTree.PictureTile": Param{Type: "object", Properties: []Param{Param{Name: "x", Type: "number"}, Param{Name: "y", Type: "number"}, Param{Name: "picture", Type: "binary"}}},
+	"LayerTree.Layer": Param{Type: "object", Properties: []Param{Param{Name: "layerId", Ref: "LayerTree.LayerId"}, Param{Name: "parentLayerId", Ref: "LayerTree.LayerId", Optional: true}, Param{Name: "backendNodeId", Ref: "DOM.BackendNodeId", Optional: true}, Param{Name: "offsetX", Type: "number"}, Param{Name: "offsetY", Type: "number"}, Param{Name: "width", Type: "number"}, Param{Name: "height", Type: "number"}, Param{Name: "transform", Type: "array", Optional: true, Items: &Param{Type: "number"}}, Param{Name: "anchorX", Type: "number", Optional: true}, Param{Name: "anchorY", Type: "number", Optional: true}, Param{Name: "anchorZ", Type: "number", Optional: true}, Param{Name: "paintCount", Type: "integer"}, Param{Name: "drawsContent", Type: "boolean"}, Param{Name: "invisible", Type: "boolean", Optional: true}, Param{Name: "scrollRects", Type: "array", Optional: true, Items: &Param{Ref: "LayerTree.ScrollRect"}}, Param{Name: "stickyPositionConstraint", Ref: "LayerTree.StickyPositionConstraint", Optional: true}}},
+	"LayerTree.PaintProfile": Param{Type: "array", Items: &Param{Type: "number"}},
+	"Log.LogEntry": Param{Type: "object", Properties: []Param{Param{Name: "source", Type: "string", Enum: []string{"xml", "javascript", "network", "storage", "appcache", "rendering", "security", "deprecation", "worker", "violation", "intervention", "recommendation", "other"}}, Param{Name: "level", Type: "string", Enum: []string{"verbose", "info", "warning", "error"}}, Param{Name: "text", Type: "string"}, Param{Name: "timestamp", Ref: "Runtime.Timestamp"}, Param{Name: "url", Type: "string", Optional: true}, Param{Name: "lineNumber", Type: "integer", Optional: true}, Param{Name: "stackTrace", Ref: "Runtime.StackTrace", Optional: true}, Param{Name: "networkRequestId", Ref: "Network.RequestId", Optional: true}, Param{Name: "workerId", Type: "string", Optional: true}, Param{Name: "args", Type: "array", Optional: true, Items: &Param{Ref: "Runtime.RemoteObject"}}}},
+	"Log.ViolationSetting": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string", Enum: []string{"longTask", "longLayout", "blockedEvent", "blockedParser", "discouragedAPIUse", "handler", "recurringHandler"}}, Param{Name: "threshold", Type: "number"}}},
+	"Memory.PressureLevel": Param{Type: "string", Enum: []string{"moderate", "critical"}},
+	"Memory.SamplingProfileNode": Param{Type: "object", Properties: []Param{Param{Name: "size", Type: "number"}, Param{Name: "total", Type: "number"}, Param{Name: "stack", Type: "array", Items: &Param{Type: "string"}}}},
+	"Memory.SamplingProfile": Param{Type: "object", Properties: []Param{Param{Name: "samples", Type: "array", Items: &Param{Ref: "Memory.SamplingProfileNode"}}, Param{Name: "modules", Type: "array", Items: &Param{Ref: "Memory.Module"}}}},
+	"Memory.Module": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "uuid", Type: "string"}, Param{Name: "baseAddress", Type: "string"}, Param{Name: "size", Type: "number"}}},
+	"Network.ResourceType": Param{Type: "string", Enum: []string{"Document", "Stylesheet", "Image", "Media", "Font", "Script", "TextTrack", "XHR", "Fetch", "EventSource", "WebSocket", "Manifest", "SignedExchange", "Ping", "CSPViolationReport", "Other"}},
+	"Network.LoaderId": Param{Type: "string"},
+	"Network.RequestId": Param{Type: "string"},
+	"Network.InterceptionId": Param{Type: "string"},
+	"Network.ErrorReason": Param{Type: "string", Enum: []string{"Failed", "Aborted", "TimedOut", "AccessDenied", "ConnectionClosed", "ConnectionReset", "ConnectionRefused", "ConnectionAborted", "ConnectionFailed", "NameNotResolved", "InternetDisconnected", "AddressUnreachable", "BlockedByClient", "BlockedByResponse"}},
+	"Network.TimeSinceEpoch": Param{Type: "number"},
+	"Network.MonotonicTime": Param{Type: "number"},
+	"Network.Headers": Param{Type: "object"},
+	"Network.ConnectionType": Param{Type: "string", Enum: []string{"none", "cellular2g", "cellular3g", "cellular4g", "bluetooth", "ethernet", "wifi", "wimax", "other"}},
+	"Network.CookieSameSite": Param{Type: "string", Enum: []string{"Strict", "Lax", "Extended", "None"}},
+	"Network.ResourceTiming": Param{Type: "object", Properties: []Param{Param{Name: "requestTime", Type: "number"}, Param{Name: "proxyStart", Type: "number"}, Param{Name: "proxyEnd", Type: "number"}, Param{Name: "dnsStart", Type: "number"}, Param{Name: "dnsEnd", Type: "number"}, Param{Name: "connectStart", Type: "number"}, Param{Name: "connectEnd", Type: "number"}, Param{Name: "sslStart", Type: "number"}, Param{Name: "sslEnd", Type: "number"}, Param{Name: "workerStart", Type: "number"}, Param{Name: "workerReady", Type: "number"}, Param{Name: "sendStart", Type: "number"}, Param{Name: "sendEnd", Type: "number"}, Param{Name: "pushStart", Type: "number"}, Param{Name: "pushEnd", Type: "number"}, Param{Name: "receiveHeadersEnd", Type: "number"}}},
+	"Network.ResourcePriority": Param{Type: "string", Enum: []string{"VeryLow", "Low", "Medium", "High", "VeryHigh"}},
+	"Network.Request": Param{Type: "object", Properties: []Param{Param{Name: "url", Type: "string"}, Param{Name: "urlFragment", Type: "string", Optional: true}, Param{Name: "method", Type: "string"}, Param{Name: "headers", Ref: "Network.Headers"}, Param{Name: "postData", Type: "string", Optional: true}, Param{Name: "hasPostData", Type: "boolean", Optional: true}, Param{Name: "mixedContentType", Ref: "Security.MixedContentType", Optional: true}, Param{Name: "initialPriority", Ref: "Network.ResourcePriority"}, Param{Name: "referrerPolicy", Type: "string", Enum: []string{"unsafe-url", "no-referrer-when-downgrade", "no-referrer", "origin", "origin-when-cross-origin", "same-origin", "strict-origin", "strict-origin-when-cross-origin"}}, Param{Name: "isLinkPreload", Type: "boolean", Optional: true}}},
+	"Network.SignedCertificateTimestamp": Param{Type: "object", Properties: []Param{Param{Name: "status", Type: "string"}, Param{Name: "origin", Type: "string"}, Param{Name: "logDescription", Type: "string"}, Param{Name: "logId", Type: "string"}, Param{Name: "timestamp", Ref: "Network.TimeSinceEpoch"}, Param{Name: "hashAlgorithm", Type: "string"}, Param{Name: "signatureAlgorithm", Type: "string"}, Param{Name: "signatureData", Type: "string"}}},
+	"Network.SecurityDetails": Param{Type: "object", Properties: []Param{Param{Name: "protocol", Type: "string"}, Param{Name: "keyExchange", Type: "string"}, Param{Name: "keyExchangeGroup", Type: "string", Optional: true}, Param{Name: "cipher", Type: "string"}, Param{Name: "mac", Type: "string", Optional: true}, Param{Name: "certificateId", Ref: "Security.CertificateId"}, Param{Name: "subjectName", Type: "string"}, Param{Name: "sanList", Type: "array", Items: &Param{Type: "string"}}, Param{Name: "issuer", Type: "string"}, Param{Name: "validFrom", Ref: "Network.TimeSinceEpoch"}, Param{Name: "validTo", Ref: "Network.TimeSinceEpoch"}, Param{Name: "signedCertificateTimestampList", Type: "array", Items: &Param{Ref: "Network.SignedCertificateTimestamp"}}, Param{Name: "certificateTransparencyCompliance", Ref: "Network.CertificateTransparencyCompliance"}}},
+	"Network.CertificateTransparencyCompliance": Param{Type: "string", Enum: []string{"unknown", "not-compliant", "compliant"}},
+	"Network.BlockedReason": Param{Type: "string", Enum: []string{"other", "csp", "mixed-content", "origin", "inspector", "subresource-filter", "content-type", "collapsed-by-client"}},
+	"Network.Response": Param{Type: "object", Properties: []Param{Param{Name: "url", Type: "string"}, Param{Name: "status", Type: "integer"}, Param{Name: "statusText", Type: "string"}, Param{Name: "headers", Ref: "Network.Headers"}, Param{Name: "headersText", Type: "string", Optional: true}, Param{Name: "mimeType", Type: "string"}, Param{Name: "requestHeaders", Ref: "Network.Headers", Optional: true}, Param{Name: "requestHeadersText", Type: "string", Optional: true}, Param{Name: "connectionReused", Type: "boolean"}, Param{Name: "connectionId", Type: "number"}, Param{Name: "remoteIPAddress", Type: "string", Optional: true}, Param{Name: "remotePort", Type: "integer", Optional: true}, Param{Name: "fromDiskCache", Type: "boolean", Optional: true}, Param{Name: "fromServiceWorker", Type: "boolean", Optional: true}, Param{Name: "fromPrefetchCache", Type: "boolean", Optional: true}, Param{Name: "encodedDataLength", Type: "number"}, Param{Name: "timing", Ref: "Network.ResourceTiming", Optional: true}, Param{Name: "protocol", Type: "string", Optional: true}, Param{Name: "securityState", Ref: "Security.SecurityState"}, Param{Name: "securityDetails", Ref: "Network.SecurityDetails", Optional: true}}},
+	"Network.WebSocketRequest": Param{Type: "object", Properties: []Param{Param{Name: "headers", Ref: "Network.Headers"}}},
+	"Network.WebSocketResponse": Param{Type: "object", Properties: []Param{Param{Name: "status", Type: "integer"}, Param{Name: "statusText", Type: "string"}, Param{Name: "headers", Ref: "Network.Headers"}, Param{Name: "headersText", Type: "string", Optional: true}, Param{Name: "requestHeaders", Ref: "Network.Headers", Optional: true}, Param{Name: "requestHeadersText", Type: "string", Optional: true}}},
+	"Network.WebSocketFrame": Param{Type: "object", Properties: []Param{Param{Name: "opcode", Type: "number"}, Param{Name: "mask", Type: "boolean"}, Param{Name: "payloadData", Type: "string"}}},
+	"Network.CachedResource": Param{Type: "object", Properties: []Param{Param{Name: "url", Type: "string"}, Param{Name: "type", Ref: "Network.ResourceType"}, Param{Name: "response", Ref: "Network.Response", Optional: true}, Param{Name: "bodySize", Type: "number"}}},
+	"Network.Initiator": Param{Type: "object", Properties: []Param{Param{Name: "type", Type: "string", Enum: []string{"parser", "script", "preload", "SignedExchange", "other"}}, Param{Name: "stack", Ref: "Runtime.StackTrace", Optional: true}, Param{Name: "url", Type: "string", Optional: true}, Param{Name: "lineNumber", Type: "number", Optional: true}}},
+	"Network.Cookie": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}, Param{Name: "domain", Type: "string"}, Param{Name: "path", Type: "string"}, Param{Name: "expires", Type: "number"}, Param{Name: "size", Type: "integer"}, Param{Name: "httpOnly", Type: "boolean"}, Param{Name: "secure", Type: "boolean"}, Param{Name: "session", Type: "boolean"}, Param{Name: "sameSite", Ref: "Network.CookieSameSite", Optional: true}}},
+	"Network.SetCookieBlockedReason": Param{Type: "string", Enum: []string{"SecureOnly", "SameSiteStrict", "SameSiteLax", "SameSiteExtended", "SameSiteUnspecifiedTreatedAsLax", "SameSiteNoneInsecure", "UserPreferences", "SyntaxError", "SchemeNotSupported", "OverwriteSecure", "InvalidDomain", "InvalidPrefix", "UnknownError"}},
+	"Network.CookieBlockedReason": Param{Type: "string", Enum: []string{"SecureOnly", "NotOnPath", "DomainMismatch", "SameSiteStrict", "SameSiteLax", "SameSiteExtended", "SameSiteUnspecifiedTreatedAsLax", "SameSiteNoneInsecure", "UserPreferences", "UnknownError"}},
+	"Network.BlockedSetCookieWithReason": Param{Type: "object", Properties: []Param{Param{Name: "blockedReasons", Type: "array", Items: &Param{Ref: "Network.SetCookieBlockedReason"}}, Param{Name: "cookieLine", Type: "string"}, Param{Name: "cookie", Ref: "Network.Cookie", Optional: true}}},
+	"Network.BlockedCookieWithReason": Param{Type: "object", Properties: []Param{Param{Name: "blockedReasons", Type: "array", Items: &Param{Ref: "Network.CookieBlockedReason"}}, Param{Name: "cookie", Ref: "Network.Cookie"}}},
+	"Network.CookieParam": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}, Param{Name: "url", Type: "string", Optional: true}, Param{Name: "domain", Type: "string", Optional: true}, Param{Name: "path", Type: "string", Optional: true}, Param{Name: "secure", Type: "boolean", Optional: true}, Param{Name: "httpOnly", Type: "boolean", Optional: true}, Param{Name: "sameSite", Ref: "Network.CookieSameSite", Optional: true}, Param{Name: "expires", Ref: "Network.TimeSinceEpoch", Optional: true}}},
+	"Network.AuthChallenge": Param{Type: "object", Properties: []Param{Param{Name: "source", Type: "string", Enum: []string{"Server", "Proxy"}, Optional: true}, Param{Name: "origin", Type: "string"}, Param{Name: "scheme", Type: "string"}, Param{Name: "realm", Type: "string"}}},
+	"Network.AuthChallengeResponse": Param{Type: "object", Properties: []Param{Param{Name: "response", Type: "string", Enum: []string{"Default", "CancelAuth", "ProvideCredentials"}}, Param{Name: "username", Type: "string", Optional: true}, Param{Name: "password", Type: "string", Optional: true}}},
+	"Network.InterceptionStage": Param{Type: "string", Enum: []string{"Request", "HeadersReceived"}},
+	"Network.RequestPattern": Param{Type: "object", Properties: []Param{Param{Name: "urlPattern", Type: "string", Optional: true}, Param{Name: "resourceType", Ref: "Network.ResourceType", Optional: true}, Param{Name: "interceptionStage", Ref: "Network.InterceptionStage", Optional: true}}},
+	"Network.SignedExchangeSignature": Param{Type: "object", Properties: []Param{Param{Name: "label", Type: "string"}, Param{Name: "signature", Type: "string"}, Param{Name: "integrity", Type: "string"}, Param{Name: "certUrl", Type: "string", Optional: true}, Param{Name: "certSha256", Type: "string", Optional: true}, Param{Name: "validityUrl", Type: "string"}, Param{Name: "date", Type: "integer"}, Param{Name: "expires", Type: "integer"}, Param{Name: "certificates", Type: "array", Optional: true, Items: &Param{Type: "string"}}}},
+	"Network.SignedExchangeHeader": Param{Type: "object", Properties: []Param{Param{Name: "requestUrl", Type: "string"}, Param{Name: "responseCode", Type: "integer"}, Param{Name: "responseHeaders", Ref: "Network.Headers"}, Param{Name: "signatures", Type: "array", Items: &Param{Ref: "Network.SignedExchangeSignature"}}, Param{Name: "headerIntegrity", Type: "string"}}},
+	"Network.SignedExchangeErrorField": Param{Type: "string", Enum: []string{"signatureSig", "signatureIntegrity", "signatureCertUrl", "signatureCertSha256", "signatureValidityUrl", "signatureTimestamps"}},
+	"Network.SignedExchangeError": Param{Type: "object", Properties: []Param{Param{Name: "message", Type: "string"}, Param{Name: "signatureIndex", Type: "integer", Optional: true}, Param{Name: "errorField", Ref: "Network.SignedExchangeErrorField", Optional: true}}},
+	"Network.SignedExchangeInfo": Param{Type: "object", Properties: []Param{Param{Name: "outerResponse", Ref: "Network.Response"}, Param{Name: "header", Ref: "Network.SignedExchangeHeader", Optional: true}, Param{Name: "securityDetails", Ref: "Network.SecurityDetails", Optional: true}, Param{Name: "errors", Type: "array", Optional: true, Items: &Param{Ref: "Network.SignedExchangeError"}}}},
+	"Overlay.HighlightConfig": Param{Type: "object", Properties: []Param{Param{Name: "showInfo", Type: "boolean", Optional: true}, Param{Name: "showStyles", Type: "boolean", Optional: true}, Param{Name: "showRulers", Type: "boolean", Optional: true}, Param{Name: "showExtensionLines", Type: "boolean", Optional: true}, Param{Name: "contentColor", Ref: "DOM.RGBA", Optional: true}, Param{Name: "paddingColor", Ref: "DOM.RGBA", Optional: true}, Param{Name: "borderColor", Ref: "DOM.RGBA", Optional: true}, Param{Name: "marginColor", Ref: "DOM.RGBA", Optional: true}, Param{Name: "eventTargetColor", Ref: "DOM.RGBA", Optional: true}, Param{Name: "shapeColor", Ref: "DOM.RGBA", Optional: true}, Param{Name: "shapeMarginColor", Ref: "DOM.RGBA", Optional: true}, Param{Name: "cssGridColor", Ref: "DOM.RGBA", Optional: true}}},
+	"Overlay.InspectMode": Param{Type: "string", Enum: []string{"searchForNode", "searchForUAShadowDOM", "captureAreaScreenshot", "showDistances", "none"}},
+	"Page.FrameId": Param{Type: "string"},
+	"Page.Frame": Param{Type: "object", Properties: []Param{Param{Name: "id", Ref: "Page.FrameId"}, Param{Name: "parentId", Type: "string", Optional: true}, Param{Name: "loaderId", Ref: "Network.LoaderId"}, Param{Name: "name", Type: "string", Optional: true}, Param{Name: "url", Type: "string"}, Param{Name: "urlFragment", Type: "string", Optional: true}, Param{Name: "securityOrigin", Type: "string"}, Param{Name: "mimeType", Type: "string"}, Param{Name: "unreachableUrl", Type: "string", Optional: true}}},
+	"Page.FrameResource": Param{Type: "object", Properties: []Param{Param{Name: "url", Type: "string"}, Param{Name: "type", Ref: "Network.ResourceType"}, Param{Name: "mimeType", Type: "string"}, Param{Name: "lastModified", Ref: "Network.TimeSinceEpoch", Optional: true}, Param{Name: "contentSize", Type: "number", Optional: true}, Param{Name: "failed", Type: "boolean", Optional: true}, Param{Name: "canceled", Type: "boolean", Optional: true}}},
+	"Page.FrameResourceTree": Param{Type: "object", Properties: []Param{Param{Name: "frame", Ref: "Page.Frame"}, Param{Name: "childFrames", Type: "array", Optional: true, Items: &Param{Ref: "Page.FrameResourceTree"}}, Param{Name: "resources", Type: "array", Items: &Param{Ref: "Page.FrameResource"}}}},
+	"Page.FrameTree": Param{Type: "object", Properties: []Param{Param{Name: "frame", Ref: "Page.Frame"}, Param{Name: "childFrames", Type: "array", Optional: true, Items: &Param{Ref: "Page.FrameTree"}}}},
+	"Page.ScriptIdentifier": Param{Type: "string"},
+	"Page.TransitionType": Param{Type: "string", Enum: []string{"link", "typed", "address_bar", "auto_bookmark", "auto_subframe", "manual_subframe", "generated", "auto_toplevel", "form_submit", "reload", "keyword", "keyword_generated", "other"}},
+	"Page.NavigationEntry": Param{Type: "object", Properties: []Param{Param{Name: "id", Type: "integer"}, Param{Name: "url", Type: "string"}, Param{Name: "userTypedURL", Type: "string"}, Param{Name: "title", Type: "string"}, Param{Name: "transitionType", Ref: "Page.TransitionType"}}},
+	"Page.ScreencastFrameMetadata": Param{Type: "object", Properties: []Param{Param{Name: "offsetTop", Type: "number"}, Param{Name: "pageScaleFactor", Type: "number"}, Param{Name: "deviceWidth", Type: "number"}, Param{Name: "deviceHeight", Type: "number"}, Param{Name: "scrollOffsetX", Type: "number"}, Param{Name: "scrollOffsetY", Type: "number"}, Param{Name: "timestamp", Ref: "Network.TimeSinceEpoch", Optional: true}}},
+	"Page.DialogType": Param{Type: "string", Enum: []string{"alert", "confirm", "prompt", "beforeunload"}},
+	"Page.AppManifestError": Param{Type: "object", Properties: []Param{Param{Name: "message", Type: "string"}, Param{Name: "critical", Type: "integer"}, Param{Name: "line", Type: "integer"}, Param{Name: "column", Type: "integer"}}},
+	"Page.LayoutViewport": Param{Type: "object", Properties: []Param{Param{Name: "pageX", Type: "integer"}, Param{Name: "pageY", Type: "integer"}, Param{Name: "clientWidth", Type: "integer"}, Param{Name: "clientHeight", Type: "integer"}}},
+	"Page.VisualViewport": Param{Type: "object", Properties: []Param{Param{Name: "offsetX", Type: "number"}, Param{Name: "offsetY", Type: "number"}, Param{Name: "pageX", Type: "number"}, Param{Name: "pageY", Type: "number"}, Param{Name: "clientWidth", Type: "number"}, Param{Name: "clientHeight", Type: "number"}, Param{Name: "scale", Type: "number"}, Param{Name: "zoom", Type: "number", Optional: true}}},
+	"Page.Viewport": Param{Type: "object", Properties: []Param{Param{Name: "x", Type: "number"}, Param{Name: "y", Type: "number"}, Param{Name: "width", Type: "number"}, Param{Name: "height", Type: "number"}, Param{Name: "scale", Type: "number"}}},
+	"Page.FontFamilies": Param{Type: "object", Properties: []Param{Param{Name: "standard", Type: "string", Optional: true}, Param{Name: "fixed", Type: "string", Optional: true}, Param{Name: "serif", Type: "string", Optional: true}, Param{Name: "sansSerif", Type: "string", Optional: true}, Param{Name: "cursive", Type: "string", Optional: true}, Param{Name: "fantasy", Type: "string", Optional: true}, Param{Name: "pictograph", Type: "string", Optional: true}}},
+	"Page.FontSizes": Param{Type: "object", Properties: []Param{Param{Name: "standard", Type: "integer", Optional: true}, Param{Name: "fixed", Type: "integer", Optional: true}}},
+	"Page.ClientNavigationReason": Param{Type: "string", Enum: []string{"formSubmissionGet", "formSubmissionPost", "httpHeaderRefresh", "scriptInitiated", "metaTagRefresh", "pageBlockInterstitial", "reload"}},
+	"Performance.Metric": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "number"}}},
+	"Security.CertificateId": Param{Type: "integer"},
+	"Security.MixedContentType": Param{Type: "string", Enum: []string{"blockable", "optionally-blockable", "none"}},
+	"Security.SecurityState": Param{Type: "string", Enum: []string{"unknown", "neutral", "insecure", "secure", "info"}},
+	"Security.CertificateSecurityState": Param{Type: "object", Properties: []Param{Param{Name: "protocol", Type: "string"}, Param{Name: "keyExchange", Type: "string"}, Param{Name: "keyExchangeGroup", Type: "string", Optional: true}, Param{Name: "cipher", Type: "string"}, Param{Name: "mac", Type: "string", Optional: true}, Param{Name: "certificate", Type: "array", Items: &Param{Type: "string"}}, Param{Name: "subjectName", Type: "string"}, Param{Name: "issuer", Type: "string"}, Param{Name: "validFrom", Ref: "Network.TimeSinceEpoch"}, Param{Name: "validTo", Ref: "Network.TimeSinceEpoch"}, Param{Name: "certifcateHasWeakSignature", Type: "boolean"}, Param{Name: "modernSSL", Type: "boolean"}, Param{Name: "obsoleteSslProtocol", Type: "boolean"}, Param{Name: "obsoleteSslKeyExchange", Type: "boolean"}, Param{Name: "obsoleteSslCipher", Type: "boolean"}, Param{Name: "obsoleteSslSignature", Type: "boolean"}}},
+	"Security.VisibleSecurityState": Param{Type: "object", Properties: []Param{Param{Name: "securityState", Ref: "Security.SecurityState"}, Param{Name: "certificateSecurityState", Ref: "Security.CertificateSecurityState", Optional: true}, Param{Name: "securityStateIssueIds", Type: "array", Items: &Param{Type: "string"}}}},
+	"Security.SecurityStateExplanation": Param{Type: "object", Properties: []Param{Param{Name: "securityState", Ref: "Security.SecurityState"}, Param{Name: "title", Type: "string"}, Param{Name: "summary", Type: "string"}, Param{Name: "description", Type: "string"}, Param{Name: "mixedContentType", Ref: "Security.MixedContentType"}, Param{Name: "certificate", Type: "array", Items: &Param{Type: "string"}}, Param{Name: "recommendations", Type: "array", Optional: true, Items: &Param{Type: "string"}}}},
+	"Security.InsecureContentStatus": Param{Type: "object", Properties: []Param{Param{Name: "ranMixedContent", Type: "boolean"}, Param{Name: "displayedMixedContent", Type: "boolean"}, Param{Name: "containedMixedForm", Type: "boolean"}, Param{Name: "ranContentWithCertErrors", Type: "boolean"}, Param{Name: "displayedContentWithCertErrors", Type: "boolean"}, Param{Name: "ranInsecureContentStyle", Ref: "Security.SecurityState"}, Param{Name: "displayedInsecureContentStyle", Ref: "Security.SecurityState"}}},
+	"Security.CertificateErrorAction": Param{Type: "string", Enum: []string{"continue", "cancel"}},
+	"ServiceWorker.RegistrationID": Param{Type: "string"},
+	"ServiceWorker.ServiceWorkerRegistration": Param{Type: "object", Properties: []Param{Param{Name: "registrationId", Ref: "ServiceWorker.RegistrationID"}, Param{Name: "scopeURL", Type: "string"}, Param{Name: "isDeleted", Type: "boolean"}}},
+	"ServiceWorker.ServiceWorkerVersionRunningStatus": Param{Type: "string", Enum: []string{"stopped", "starting", "running", "stopping"}},
+	"ServiceWorker.ServiceWorkerVersionStatus": Param{Type: "string", Enum: []string{"new", "installing", "installed", "activating", "activated", "redundant"}},
+	"ServiceWorker.ServiceWorkerVersion": Param{Type: "object", Properties: []Param{Param{Name: "versionId", Type: "string"}, Param{Name: "registrationId", Ref: "ServiceWorker.RegistrationID"}, Param{Name: "scriptURL", Type: "string"}, Param{Name: "runningStatus", Ref: "ServiceWorker.ServiceWorkerVersionRunningStatus"}, Param{Name: "status", Ref: "ServiceWorker.ServiceWorkerVersionStatus"}, Param{Name: "scriptLastModified", Type: "number", Optional: true}, Param{Name: "scriptResponseTime", Type: "number", Optional: true}, Param{Name: "controlledClients", Type: "array", Optional: true, Items: &Param{Ref: "Target.TargetID"}}, Param{Name: "targetId", Ref: "Target.TargetID", Optional: true}}},
+	"ServiceWorker.ServiceWorkerErrorMessage": Param{Type: "object", Properties: []Param{Param{Name: "errorMessage", Type: "string"}, Param{Name: "registrationId", Ref: "ServiceWorker.RegistrationID"}, Param{Name: "versionId", Type: "string"}, Param{Name: "sourceURL", Type: "string"}, Param{Name: "lineNumber", Type: "integer"}, Param{Name: "columnNumber", Type: "integer"}}},
+	"Storage.StorageType": Param{Type: "string", Enum: []string{"appcache", "cookies", "file_systems", "indexeddb", "local_storage", "shader_cache", "websql", "service_workers", "cache_storage", "all", "other"}},
+	"Storage.UsageForType": Param{Type: "object", Properties: []Param{Param{Name: "storageType", Ref: "Storage.StorageType"}, Param{Name: "usage", Type: "number"}}},
+	"SystemInfo.GPUDevice": Param{Type: "object", Properties: []Param{Param{Name: "vendorId", Type: "number"}, Param{Name: "deviceId", Type: "number"}, Param{Name: "subSysId", Type: "number", Optional: true}, Param{Name: "revision", Type: "number", Optional: true}, Param{Name: "vendorString", Type: "string"}, Param{Name: "deviceString", Type: "string"}, Param{Name: "driverVendor", Type: "string"}, Param{Name: "driverVersion", Type: "string"}}},
+	"SystemInfo.Size": Param{Type: "object", Properties: []Param{Param{Name: "width", Type: "integer"}, Param{Name: "height", Type: "integer"}}},
+	"SystemInfo.VideoDecodeAcceleratorCapability": Param{Type: "object", Properties: []Param{Param{Name: "profile", Type: "string"}, Param{Name: "maxResolution", Ref: "SystemInfo.Size"}, Param{Name: "minResolution", Ref: "SystemInfo.Size"}}},
+	"SystemInfo.VideoEncodeAcceleratorCapability": Param{Type: "object", Properties: []Param{Param{Name: "profile", Type: "string"}, Param{Name: "maxResolution", Ref: "SystemInfo.Size"}, Param{Name: "maxFramerateNumerator", Type: "integer"}, Param{Name: "maxFramerateDenominator", Type: "integer"}}},
+	"SystemInfo.SubsamplingFormat": Param{Type: "string", Enum: []string{"yuv420", "yuv422", "yuv444"}},
+	"SystemInfo.ImageType": Param{Type: "string", Enum: []string{"jpeg", "webp", "unknown"}},
+	"SystemInfo.ImageDecodeAcceleratorCapability": Param{Type: "object", Properties: []Param{Param{Name: "imageType", Ref: "SystemInfo.ImageType"}, Param{Name: "maxDimensions", Ref: "SystemInfo.Size"}, Param{Name: "minDimensions", Ref: "SystemInfo.Size"}, Param{Name: "subsamplings", Type: "array", Items: &Param{Ref: "SystemInfo.SubsamplingFormat"}}}},
+	"SystemInfo.GPUInfo": Param{Type: "object", Properties: []Param{Param{Name: "devices", Type: "array", Items: &Param{Ref: "SystemInfo.GPUDevice"}}, Param{Name: "auxAttributes", Type: "object", Optional: true}, Param{Name: "featureStatus", Type: "object", Optional: true}, Param{Name: "driverBugWorkarounds", Type: "array", Items: &Param{Type: "string"}}, Param{Name: "videoDecoding", Type: "array", Items: &Param{Ref: "SystemInfo.VideoDecodeAcceleratorCapability"}}, Param{Name: "videoEncoding", Type: "array", Items: &Param{Ref: "SystemInfo.VideoEncodeAcceleratorCapability"}}, Param{Name: "imageDecoding", Type: "array", Items: &Param{Ref: "SystemInfo.ImageDecodeAcceleratorCapability"}}}},
+	"SystemInfo.ProcessInfo": Param{Type: "object", Properties: []Param{Param{Name: "type", Type: "string"}, Param{Name: "id", Type: "integer"}, Param{Name: "cpuTime", Type: "number"}}},
+	"Target.TargetID": Param{Type: "string"},
+	"Target.SessionID": Param{Type: "string"},
+	"Target.BrowserContextID": Param{Type: "string"},
+	"Target.TargetInfo": Param{Type: "object", Properties: []Param{Param{Name: "targetId", Ref: "Target.TargetID"}, Param{Name: "type", Type: "string"}, Param{Name: "title", Type: "string"}, Param{Name: "url", Type: "string"}, Param{Name: "attached", Type: "boolean"}, Param{Name: "openerId", Ref: "Target.TargetID", Optional: true}, Param{Name: "browserContextId", Ref: "Target.BrowserContextID", Optional: true}}},
+	"Target.RemoteLocation": Param{Type: "object", Properties: []Param{Param{Name: "host", Type: "string"}, Param{Name: "port", Type: "integer"}}},
+	"Tracing.MemoryDumpConfig": Param{Type: "object"},
+	"Tracing.TraceConfig": Param{Type: "object", Properties: []Param{Param{Name: "recordMode", Type: "string", Enum: []string{"recordUntilFull", "recordContinuously", "recordAsMuchAsPossible", "echoToConsole"}, Optional: true}, Param{Name: "enableSampling", Type: "boolean", Optional: true}, Param{Name: "enableSystrace", Type: "boolean", Optional: true}, Param{Name: "enableArgumentFilter", Type: "boolean", Optional: true}, Param{Name: "includedCategories", Type: "array", Optional: true, Items: &Param{Type: "string"}}, Param{Name: "excludedCategories", Type: "array", Optional: true, Items: &Param{Type: "string"}}, Param{Name: "syntheticDelays", Type: "array", Optional: true, Items: &Param{Type: "string"}}, Param{Name: "memoryDumpConfig", Ref: "Tracing.MemoryDumpConfig", Optional: true}}},
+	"Tracing.StreamFormat": Param{Type: "string", Enum: []string{"json", "proto"}},
+	"Tracing.StreamCompression": Param{Type: "string", Enum: []string{"none", "gzip"}},
+	"Fetch.RequestId": Param{Type: "string"},
+	"Fetch.RequestStage": Param{Type: "string", Enum: []string{"Request", "Response"}},
+	"Fetch.RequestPattern": Param{Type: "object", Properties: []Param{Param{Name: "urlPattern", Type: "string", Optional: true}, Param{Name: "resourceType", Ref: "Network.ResourceType", Optional: true}, Param{Name: "requestStage", Ref: "Fetch.RequestStage", Optional: true}}},
+	"Fetch.HeaderEntry": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}}},
+	"Fetch.AuthChallenge": Param{Type: "object", Properties: []Param{Param{Name: "source", Type: "string", Enum: []string{"Server", "Proxy"}, Optional: true}, Param{Name: "origin", Type: "string"}, Param{Name: "scheme", Type: "string"}, Param{Name: "realm", Type: "string"}}},
+	"Fetch.AuthChallengeResponse": Param{Type: "object", Properties: []Param{Param{Name: "response", Type: "string", Enum: []string{"Default", "CancelAuth", "ProvideCredentials"}}, Param{Name: "username", Type: "string", Optional: true}, Param{Name: "password", Type: "string", Optional: true}}},
+	"WebAudio.GraphObjectId": Param{Type: "string"},
+	"WebAudio.ContextType": Param{Type: "string", Enum: []string{"realtime", "offline"}},
+	"WebAudio.ContextState": Param{Type: "string", Enum: []string{"suspended", "running", "closed"}},
+	"WebAudio.NodeType": Param{Type: "string"},
+	"WebAudio.ChannelCountMode": Param{Type: "string", Enum: []string{"clamped-max", "explicit", "max"}},
+	"WebAudio.ChannelInterpretation": Param{Type: "string", Enum: []string{"discrete", "speakers"}},
+	"WebAudio.ParamType": Param{Type: "string"},
+	"WebAudio.AutomationRate": Param{Type: "string", Enum: []string{"a-rate", "k-rate"}},
+	"WebAudio.ContextRealtimeData": Param{Type: "object", Properties: []Param{Param{Name: "currentTime", Type: "number"}, Param{Name: "renderCapacity", Type: "number"}, Param{Name: "callbackIntervalMean", Type: "number"}, Param{Name: "callbackIntervalVariance", Type: "number"}}},
+	"WebAudio.BaseAudioContext": Param{Type: "object", Properties: []Param{Param{Name: "contextId", Ref: "WebAudio.GraphObjectId"}, Param{Name: "contextType", Ref: "WebAudio.ContextType"}, Param{Name: "contextState", Ref: "WebAudio.ContextState"}, Param{Name: "realtimeData", Ref: "WebAudio.ContextRealtimeData", Optional: true}, Param{Name: "callbackBufferSize", Type: "number"}, Param{Name: "maxOutputChannelCount", Type: "number"}, Param{Name: "sampleRate", Type: "number"}}},
+	"WebAudio.AudioListener": Param{Type: "object", Properties: []Param{Param{Name: "listenerId", Ref: "WebAudio.GraphObjectId"}, Param{Name: "contextId", Ref: "WebAudio.GraphObjectId"}}},
+	"WebAudio.AudioNode": Param{Type: "object", Properties: []Param{Param{Name: "nodeId", Ref: "WebAudio.GraphObjectId"}, Param{Name: "contextId", Ref: "WebAudio.GraphObjectId"}, Param{Name: "nodeType", Ref: "WebAudio.NodeType"}, Param{Name: "numberOfInputs", Type: "number"}, Param{Name: "numberOfOutputs", Type: "number"}, Param{Name: "channelCount", Type: "number"}, Param{Name: "channelCountMode", Ref: "WebAudio.ChannelCountMode"}, Param{Name: "channelInterpretation", Ref: "WebAudio.ChannelInterpretation"}}},
+	"WebAudio.AudioParam": Param{Type: "object", Properties: []Param{Param{Name: "paramId", Ref: "WebAudio.GraphObjectId"}, Param{Name: "nodeId", Ref: "WebAudio.GraphObjectId"}, Param{Name: "contextId", Ref: "WebAudio.GraphObjectId"}, Param{Name: "paramType", Ref: "WebAudio.ParamType"}, Param{Name: "rate", Ref: "WebAudio.AutomationRate"}, Param{Name: "defaultValue", Type: "number"}, Param{Name: "minValue", Type: "number"}, Param{Name: "maxValue", Type: "number"}}},
+	"WebAuthn.AuthenticatorId": Param{Type: "string"},
+	"WebAuthn.AuthenticatorProtocol": Param{Type: "string", Enum: []string{"u2f", "ctap2"}},
+	"WebAuthn.AuthenticatorTransport": Param{Type: "string", Enum: []string{"usb", "nfc", "ble", "cable", "internal"}},
+	"WebAuthn.VirtualAuthenticatorOptions": Param{Type: "object", Properties: []Param{Param{Name: "protocol", Ref: "WebAuthn.AuthenticatorProtocol"}, Param{Name: "transport", Ref: "WebAuthn.AuthenticatorTransport"}, Param{Name: "hasResidentKey", Type: "boolean", Optional: true}, Param{Name: "hasUserVerification", Type: "boolean", Optional: true}, Param{Name: "automaticPresenceSimulation", Type: "boolean", Optional: true}, Param{Name: "isUserVerified", Type: "boolean", Optional: true}}},
+	"WebAuthn.Credential": Param{Type: "object", Properties: []Param{Param{Name: "credentialId", Type: "binary"}, Param{Name: "isResidentCredential", Type: "boolean"}, Param{Name: "rpId", Type: "string", Optional: true}, Param{Name: "privateKey", Type: "binary"}, Param{Name: "userHandle", Type: "binary", Optional: true}, Param{Name: "signCount", Type: "integer"}}},
+	"Media.PlayerId": Param{Type: "string"},
+	"Media.Timestamp": Param{Type: "number"},
+	"Media.PlayerProperty": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string", Optional: true}}},
+	"Media.PlayerEventType": Param{Type: "string", Enum: []string{"playbackEvent", "systemEvent", "messageEvent"}},
+	"Media.PlayerEvent": Param{Type: "object", Properties: []Param{Param{Name: "type", Ref: "Media.PlayerEventType"}, Param{Name: "timestamp", Ref: "Media.Timestamp"}, Param{Name: "name", Type: "string"}, Param{Name: "value", Type: "string"}}},
+	"Console.ConsoleMessage": Param{Type: "object", Properties: []Param{Param{Name: "source", Type: "string", Enum: []string{"xml", "javascript", "network", "console-api", "storage", "appcache", "rendering", "security", "other", "deprecation", "worker"}}, Param{Name: "level", Type: "string", Enum: []string{"log", "warning", "error", "debug", "info"}}, Param{Name: "text", Type: "string"}, Param{Name: "url", Type: "string", Optional: true}, Param{Name: "line", Type: "integer", Optional: true}, Param{Name: "column", Type: "integer", Optional: true}}},
+	"Debugger.BreakpointId": Param{Type: "string"},
+	"Debugger.CallFrameId": Param{Type: "string"},
+	"Debugger.Location": Param{Type: "object", Properties: []Param{Param{Name: "scriptId", Ref: "Runtime.ScriptId"}, Param{Name: "lineNumber", Type: "integer"}, Param{Name: "columnNumber", Type: "integer", Optional: true}}},
+	"Debugger.ScriptPosition": Param{Type: "object", Properties: []Param{Param{Name: "lineNumber", Type: "integer"}, Param{Name: "columnNumber", Type: "integer"}}},
+	"Debugger.CallFrame": Param{Type: "object", Properties: []Param{Param{Name: "callFrameId", Ref: "Debugger.CallFrameId"}, Param{Name: "functionName", Type: "string"}, Param{Name: "functionLocation", Ref: "Debugger.Location", Optional: true}, Param{Name: "location", Ref: "Debugger.Location"}, Param{Name: "url", Type: "string"}, Param{Name: "scopeChain", Type: "array", Items: &Param{Ref: "Debugger.Scope"}}, Param{Name: "this", Ref: "Runtime.RemoteObject"}, Param{Name: "returnValue", Ref: "Runtime.RemoteObject", Optional: true}}},
+	"Debugger.Scope": Param{Type: "object", Properties: []Param{Param{Name: "type", Type: "string", Enum: []string{"global", "local", "with", "closure", "catch", "block", "script", "eval", "module"}}, Param{Name: "object", Ref: "Runtime.RemoteObject"}, Param{Name: "name", Type: "string", Optional: true}, Param{Name: "startLocation", Ref: "Debugger.Location", Optional: true}, Param{Name: "endLocation", Ref: "Debugger.Location", Optional: true}}},
+	"Debugger.SearchMatch": Param{Type: "object", Properties: []Param{Param{Name: "lineNumber", Type: "number"}, Param{Name: "lineContent", Type: "string"}}},
+	"Debugger.BreakLocation": Param{Type: "object", Properties: []Param{Param{Name: "scriptId", Ref: "Runtime.ScriptId"}, Param{Name: "lineNumber", Type: "integer"}, Param{Name: "columnNumber", Type: "integer", Optional: true}, Param{Name: "type", Type: "string", Enum: []string{"debuggerStatement", "call", "return"}, Optional: true}}},
+	"HeapProfiler.HeapSnapshotObjectId": Param{Type: "string"},
+	"HeapProfiler.SamplingHeapProfileNode": Param{Type: "object", Properties: []Param{Param{Name: "callFrame", Ref: "Runtime.CallFrame"}, Param{Name: "selfSize", Type: "number"}, Param{Name: "id", Type: "integer"}, Param{Name: "children", Type: "array", Items: &Param{Ref: "HeapProfiler.SamplingHeapProfileNode"}}}},
+	"HeapProfiler.SamplingHeapProfileSample": Param{Type: "object", Properties: []Param{Param{Name: "size", Type: "number"}, Param{Name: "nodeId", Type: "integer"}, Param{Name: "ordinal", Type: "number"}}},
+	"HeapProfiler.SamplingHeapProfile": Param{Type: "object", Properties: []Param{Param{Name: "head", Ref: "HeapProfiler.SamplingHeapProfileNode"}, Param{Name: "samples", Type: "array", Items: &Param{Ref: "HeapProfiler.SamplingHeapProfileSample"}}}},
+	"Profiler.ProfileNode": Param{Type: "object", Properties: []Param{Param{Name: "id", Type: "integer"}, Param{Name: "callFrame", Ref: "Runtime.CallFrame"}, Param{Name: "hitCount", Type: "integer", Optional: true}, Param{Name: "children", Type: "array", Optional: true, Items: &Param{Type: "integer"}}, Param{Name: "deoptReason", Type: "string", Optional: true}, Param{Name: "positionTicks", Type: "array", Optional: true, Items: &Param{Ref: "Profiler.PositionTickInfo"}}}},
+	"Profiler.Profile": Param{Type: "object", Properties: []Param{Param{Name: "nodes", Type: "array", Items: &Param{Ref: "Profiler.ProfileNode"}}, Param{Name: "startTime", Type: "number"}, Param{Name: "endTime", Type: "number"}, Param{Name: "samples", Type: "array", Optional: true, Items: &Param{Type: "integer"}}, Param{Name: "timeDeltas", Type: "array", Optional: true, Items: &Param{Type: "integer"}}}},
+	"Profiler.PositionTickInfo": Param{Type: "object", Properties: []Param{Param{Name: "line", Type: "integer"}, Param{Name: "ticks", Type: "integer"}}},
+	"Profiler.CoverageRange": Param{Type: "object", Properties: []Param{Param{Name: "startOffset", Type: "integer"}, Param{Name: "endOffset", Type: "integer"}, Param{Name: "count", Type: "integer"}}},
+	"Profiler.FunctionCoverage": Param{Type: "object", Properties: []Param{Param{Name: "functionName", Type: "string"}, Param{Name: "ranges", Type: "array", Items: &Param{Ref: "Profiler.CoverageRange"}}, Param{Name: "isBlockCoverage", Type: "boolean"}}},
+	"Profiler.ScriptCoverage": Param{Type: "object", Properties: []Param{Param{Name: "scriptId", Ref: "Runtime.ScriptId"}, Param{Name: "url", Type: "string"}, Param{Name: "functions", Type: "array", Items: &Param{Ref: "Profiler.FunctionCoverage"}}}},
+	"Profiler.TypeObject": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}}},
+	"Profiler.TypeProfileEntry": Param{Type: "object", Properties: []Param{Param{Name: "offset", Type: "integer"}, Param{Name: "types", Type: "array", Items: &Param{Ref: "Profiler.TypeObject"}}}},
+	"Profiler.ScriptTypeProfile": Param{Type: "object", Properties: []Param{Param{Name: "scriptId", Ref: "Runtime.ScriptId"}, Param{Name: "url", Type: "string"}, Param{Name: "entries", Type: "array", Items: &Param{Ref: "Profiler.TypeProfileEntry"}}}},
+	"Runtime.ScriptId": Param{Type: "string"},
+	"Runtime.RemoteObjectId": Param{Type: "string"},
+	"Runtime.UnserializableValue": Param{Type: "string"},
+	"Runtime.RemoteObject": Param{Type: "object", Properties: []Param{Param{Name: "type", Type: "string", Enum: []string{"object", "function", "undefined", "string", "number", "boolean", "symbol", "bigint"}}, Param{Name: "subtype", Type: "string", Enum: []string{"array", "null", "node", "regexp", "date", "map", "set", "weakmap", "weakset", "iterator", "generator", "error", "proxy", "promise", "typedarray", "arraybuffer", "dataview"}, Optional: true}, Param{Name: "className", Type: "string", Optional: true}, Param{Name: "value", Type: "any", Optional: true}, Param{Name: "unserializableValue", Ref: "Runtime.UnserializableValue", Optional: true}, Param{Name: "description", Type: "string", Optional: true}, Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true}, Param{Name: "preview", Ref: "Runtime.ObjectPreview", Optional: true}, Param{Name: "customPreview", Ref: "Runtime.CustomPreview", Optional: true}}},
+	"Runtime.CustomPreview": Param{Type: "object", Properties: []Param{Param{Name: "header", Type: "string"}, Param{Name: "bodyGetterId", Ref: "Runtime.RemoteObjectId", Optional: true}}},
+	"Runtime.ObjectPreview": Param{Type: "object", Properties: []Param{Param{Name: "type", Type: "string", Enum: []string{"object", "function", "undefined", "string", "number", "boolean", "symbol", "bigint"}}, Param{Name: "subtype", Type: "string", Enum: []string{"array", "null", "node", "regexp", "date", "map", "set", "weakmap", "weakset", "iterator", "generator", "error"}, Optional: true}, Param{Name: "description", Type: "string", Optional: true}, Param{Name: "overflow", Type: "boolean"}, Param{Name: "properties", Type: "array", Items: &Param{Ref: "Runtime.PropertyPreview"}}, Param{Name: "entries", Type: "array", Optional: true, Items: &Param{Ref: "Runtime.EntryPreview"}}}},
+	"Runtime.PropertyPreview": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "type", Type: "string", Enum: []string{"object", "function", "undefined", "string", "number", "boolean", "symbol", "accessor", "bigint"}}, Param{Name: "value", Type: "string", Optional: true}, Param{Name: "valuePreview", Ref: "Runtime.ObjectPreview", Optional: true}, Param{Name: "subtype", Type: "string", Enum: []string{"array", "null", "node", "regexp", "date", "map", "set", "weakmap", "weakset", "iterator", "generator", "error"}, Optional: true}}},
+	"Runtime.EntryPreview": Param{Type: "object", Properties: []Param{Param{Name: "key", Ref: "Runtime.ObjectPreview", Optional: true}, Param{Name: "value", Ref: "Runtime.ObjectPreview"}}},
+	"Runtime.PropertyDescriptor": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Ref: "Runtime.RemoteObject", Optional: true}, Param{Name: "writable", Type: "boolean", Optional: true}, Param{Name: "get", Ref: "Runtime.RemoteObject", Optional: true}, Param{Name: "set", Ref: "Runtime.RemoteObject", Optional: true}, Param{Name: "configurable", Type: "boolean"}, Param{Name: "enumerable", Type: "boolean"}, Param{Name: "wasThrown", Type: "boolean", Optional: true}, Param{Name: "isOwn", Type: "boolean", Optional: true}, Param{Name: "symbol", Ref: "Runtime.RemoteObject", Optional: true}}},
+	"Runtime.InternalPropertyDescriptor": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Ref: "Runtime.RemoteObject", Optional: true}}},
+	"Runtime.PrivatePropertyDescriptor": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "value", Ref: "Runtime.RemoteObject"}}},
+	"Runtime.CallArgument": Param{Type: "object", Properties: []Param{Param{Name: "value", Type: "any", Optional: true}, Param{Name: "unserializableValue", Ref: "Runtime.UnserializableValue", Optional: true}, Param{Name: "objectId", Ref: "Runtime.RemoteObjectId", Optional: true}}},
+	"Runtime.ExecutionContextId": Param{Type: "integer"},
+	"Runtime.ExecutionContextDescription": Param{Type: "object", Properties: []Param{Param{Name: "id", Ref: "Runtime.ExecutionContextId"}, Param{Name: "origin", Type: "string"}, Param{Name: "name", Type: "string"}, Param{Name: "auxData", Type: "object", Optional: true}}},
+	"Runtime.ExceptionDetails": Param{Type: "object", Properties: []Param{Param{Name: "exceptionId", Type: "integer"}, Param{Name: "text", Type: "string"}, Param{Name: "lineNumber", Type: "integer"}, Param{Name: "columnNumber", Type: "integer"}, Param{Name: "scriptId", Ref: "Runtime.ScriptId", Optional: true}, Param{Name: "url", Type: "string", Optional: true}, Param{Name: "stackTrace", Ref: "Runtime.StackTrace", Optional: true}, Param{Name: "exception", Ref: "Runtime.RemoteObject", Optional: true}, Param{Name: "executionContextId", Ref: "Runtime.ExecutionContextId", Optional: true}}},
+	"Runtime.Timestamp": Param{Type: "number"},
+	"Runtime.TimeDelta": Param{Type: "number"},
+	"Runtime.CallFrame": Param{Type: "object", Properties: []Param{Param{Name: "functionName", Type: "string"}, Param{Name: "scriptId", Ref: "Runtime.ScriptId"}, Param{Name: "url", Type: "string"}, Param{Name: "lineNumber", Type: "integer"}, Param{Name: "columnNumber", Type: "integer"}}},
+	"Runtime.StackTrace": Param{Type: "object", Properties: []Param{Param{Name: "description", Type: "string", Optional: true}, Param{Name: "callFrames", Type: "array", Items: &Param{Ref: "Runtime.CallFrame"}}, Param{Name: "parent", Ref: "Runtime.StackTrace", Optional: true}, Param{Name: "parentId", Ref: "Runtime.StackTraceId", Optional: true}}},
+	"Runtime.UniqueDebuggerId": Param{Type: "string"},
+	"Runtime.StackTraceId": Param{Type: "object", Properties: []Param{Param{Name: "id", Type: "string"}, Param{Name: "debuggerId", Ref: "Runtime.UniqueDebuggerId", Optional: true}}},
+	"Schema.Domain": Param{Type: "object", Properties: []Param{Param{Name: "name", Type: "string"}, Param{Name: "version", Type: "string"}}},
+}
@@ -55,4 +55,10 @@ type Viewport  struct {
 type FrameId string
 
 type TimeSinceEpoch float64
+
+// Command describes a protocol method together with the types of its
+// params and result.
+type Command[P, R any] struct {
+	Method string
+}
 	
\ No newline at end of file
@@ -1,5 +1,10 @@
 package console
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Does nothing.
 const ClearMessages = "Console.clearMessages"
 
@@ -10,6 +15,8 @@ type ClearMessagesResult struct {
 
 }
 
+var ClearMessagesCommand = cdp.Command[ClearMessagesParams, ClearMessagesResult]{Method: ClearMessages}
+
 // Disables console domain, prevents further console messages from being reported to the client.
 const Disable = "Console.disable"
 
@@ -20,6 +27,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables console domain, sends the messages collected so far to the client by means of the
 // `messageAdded` notification.
 const Enable = "Console.enable"
@@ -29,4 +38,6 @@ type EnableParams struct {
 
 type EnableResult struct {
 
-}
\ No newline at end of file
+}
+
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
\ No newline at end of file
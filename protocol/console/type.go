@@ -1,3 +1,8 @@
+// Package console implements the Console domain of the DevTools protocol.
+//
+// This domain is deprecated - use Runtime or Log instead.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 package console
 // Console message.
 type ConsoleMessage  struct {
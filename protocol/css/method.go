@@ -28,6 +28,8 @@ type AddRuleResult struct {
 	Rule 	CSSRule	`json:"rule"`
 }
 
+var AddRuleCommand = cdp.Command[AddRuleParams, AddRuleResult]{Method: AddRule}
+
 // Returns all class names from specified stylesheet.
 const CollectClassNames = "CSS.collectClassNames"
 
@@ -43,6 +45,8 @@ type CollectClassNamesResult struct {
 	ClassNames 	[]string	`json:"classNames"`
 }
 
+var CollectClassNamesCommand = cdp.Command[CollectClassNamesParams, CollectClassNamesResult]{Method: CollectClassNames}
+
 // Creates a new special "via-inspector" stylesheet in the frame with given `frameId`.
 const CreateStyleSheet = "CSS.createStyleSheet"
 
@@ -58,6 +62,8 @@ type CreateStyleSheetResult struct {
 	StyleSheetId 	StyleSheetId	`json:"styleSheetId"`
 }
 
+var CreateStyleSheetCommand = cdp.Command[CreateStyleSheetParams, CreateStyleSheetResult]{Method: CreateStyleSheet}
+
 // Disables the CSS agent for the given page.
 const Disable = "CSS.disable"
 
@@ -68,6 +74,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables the CSS agent for the given page. Clients should not assume that the CSS agent has been
 // enabled until the result of this command is received.
 const Enable = "CSS.enable"
@@ -79,6 +87,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Ensures that the given node will have specified pseudo-classes whenever its style is computed by
 // the browser.
 const ForcePseudoState = "CSS.forcePseudoState"
@@ -96,6 +106,8 @@ type ForcePseudoStateResult struct {
 
 }
 
+var ForcePseudoStateCommand = cdp.Command[ForcePseudoStateParams, ForcePseudoStateResult]{Method: ForcePseudoState}
+
 // 
 const GetBackgroundColors = "CSS.getBackgroundColors"
 
@@ -120,6 +132,8 @@ type GetBackgroundColorsResult struct {
 	ComputedFontWeight 	string	`json:"computedFontWeight"`
 }
 
+var GetBackgroundColorsCommand = cdp.Command[GetBackgroundColorsParams, GetBackgroundColorsResult]{Method: GetBackgroundColors}
+
 // Returns the computed style for a DOM node identified by `nodeId`.
 const GetComputedStyleForNode = "CSS.getComputedStyleForNode"
 
@@ -135,6 +149,8 @@ type GetComputedStyleForNodeResult struct {
 	ComputedStyle 	[]*CSSComputedStyleProperty	`json:"computedStyle"`
 }
 
+var GetComputedStyleForNodeCommand = cdp.Command[GetComputedStyleForNodeParams, GetComputedStyleForNodeResult]{Method: GetComputedStyleForNode}
+
 // Returns the styles defined inline (explicitly in the "style" attribute and implicitly, using DOM
 // attributes) for a DOM node identified by `nodeId`.
 const GetInlineStylesForNode = "CSS.getInlineStylesForNode"
@@ -153,6 +169,8 @@ type GetInlineStylesForNodeResult struct {
 	AttributesStyle 	CSSStyle	`json:"attributesStyle"`
 }
 
+var GetInlineStylesForNodeCommand = cdp.Command[GetInlineStylesForNodeParams, GetInlineStylesForNodeResult]{Method: GetInlineStylesForNode}
+
 // Returns requested styles for a DOM node identified by `nodeId`.
 const GetMatchedStylesForNode = "CSS.getMatchedStylesForNode"
 
@@ -178,6 +196,8 @@ type GetMatchedStylesForNodeResult struct {
 	CssKeyframesRules 	[]*CSSKeyframesRule	`json:"cssKeyframesRules"`
 }
 
+var GetMatchedStylesForNodeCommand = cdp.Command[GetMatchedStylesForNodeParams, GetMatchedStylesForNodeResult]{Method: GetMatchedStylesForNode}
+
 // Returns all media queries parsed by the rendering engine.
 const GetMediaQueries = "CSS.getMediaQueries"
 
@@ -190,6 +210,8 @@ type GetMediaQueriesResult struct {
 	Medias 	[]*CSSMedia	`json:"medias"`
 }
 
+var GetMediaQueriesCommand = cdp.Command[GetMediaQueriesParams, GetMediaQueriesResult]{Method: GetMediaQueries}
+
 // Requests information about platform fonts which we used to render child TextNodes in the given
 // node.
 const GetPlatformFontsForNode = "CSS.getPlatformFontsForNode"
@@ -206,6 +228,8 @@ type GetPlatformFontsForNodeResult struct {
 	Fonts 	[]*PlatformFontUsage	`json:"fonts"`
 }
 
+var GetPlatformFontsForNodeCommand = cdp.Command[GetPlatformFontsForNodeParams, GetPlatformFontsForNodeResult]{Method: GetPlatformFontsForNode}
+
 // Returns the current textual content for a stylesheet.
 const GetStyleSheetText = "CSS.getStyleSheetText"
 
@@ -221,6 +245,8 @@ type GetStyleSheetTextResult struct {
 	Text 	string	`json:"text"`
 }
 
+var GetStyleSheetTextCommand = cdp.Command[GetStyleSheetTextParams, GetStyleSheetTextResult]{Method: GetStyleSheetText}
+
 // Find a rule with the given active property for the given node and set the new value for this
 // property
 const SetEffectivePropertyValueForNode = "CSS.setEffectivePropertyValueForNode"
@@ -241,6 +267,8 @@ type SetEffectivePropertyValueForNodeResult struct {
 
 }
 
+var SetEffectivePropertyValueForNodeCommand = cdp.Command[SetEffectivePropertyValueForNodeParams, SetEffectivePropertyValueForNodeResult]{Method: SetEffectivePropertyValueForNode}
+
 // Modifies the keyframe rule key text.
 const SetKeyframeKey = "CSS.setKeyframeKey"
 
@@ -262,6 +290,8 @@ type SetKeyframeKeyResult struct {
 	KeyText 	Value	`json:"keyText"`
 }
 
+var SetKeyframeKeyCommand = cdp.Command[SetKeyframeKeyParams, SetKeyframeKeyResult]{Method: SetKeyframeKey}
+
 // Modifies the rule selector.
 const SetMediaText = "CSS.setMediaText"
 
@@ -283,6 +313,8 @@ type SetMediaTextResult struct {
 	Media 	CSSMedia	`json:"media"`
 }
 
+var SetMediaTextCommand = cdp.Command[SetMediaTextParams, SetMediaTextResult]{Method: SetMediaText}
+
 // Modifies the rule selector.
 const SetRuleSelector = "CSS.setRuleSelector"
 
@@ -304,6 +336,8 @@ type SetRuleSelectorResult struct {
 	SelectorList 	SelectorList	`json:"selectorList"`
 }
 
+var SetRuleSelectorCommand = cdp.Command[SetRuleSelectorParams, SetRuleSelectorResult]{Method: SetRuleSelector}
+
 // Sets the new stylesheet text.
 const SetStyleSheetText = "CSS.setStyleSheetText"
 
@@ -322,6 +356,8 @@ type SetStyleSheetTextResult struct {
 	SourceMapURL 	string	`json:"sourceMapURL"`
 }
 
+var SetStyleSheetTextCommand = cdp.Command[SetStyleSheetTextParams, SetStyleSheetTextResult]{Method: SetStyleSheetText}
+
 // Applies specified style edits one after another in the given order.
 const SetStyleTexts = "CSS.setStyleTexts"
 
@@ -337,6 +373,8 @@ type SetStyleTextsResult struct {
 	Styles 	[]*CSSStyle	`json:"styles"`
 }
 
+var SetStyleTextsCommand = cdp.Command[SetStyleTextsParams, SetStyleTextsResult]{Method: SetStyleTexts}
+
 // Enables the selector recording.
 const StartRuleUsageTracking = "CSS.startRuleUsageTracking"
 
@@ -347,6 +385,8 @@ type StartRuleUsageTrackingResult struct {
 
 }
 
+var StartRuleUsageTrackingCommand = cdp.Command[StartRuleUsageTrackingParams, StartRuleUsageTrackingResult]{Method: StartRuleUsageTracking}
+
 // Stop tracking rule usage and return the list of rules that were used since last call to
 // `takeCoverageDelta` (or since start of coverage instrumentation)
 const StopRuleUsageTracking = "CSS.stopRuleUsageTracking"
@@ -360,6 +400,8 @@ type StopRuleUsageTrackingResult struct {
 	RuleUsage 	[]*RuleUsage	`json:"ruleUsage"`
 }
 
+var StopRuleUsageTrackingCommand = cdp.Command[StopRuleUsageTrackingParams, StopRuleUsageTrackingResult]{Method: StopRuleUsageTracking}
+
 // Obtain list of rules that became used since last call to this method (or since start of coverage
 // instrumentation)
 const TakeCoverageDelta = "CSS.takeCoverageDelta"
@@ -371,4 +413,6 @@ type TakeCoverageDeltaResult struct {
 
 	// 
 	Coverage 	[]*RuleUsage	`json:"coverage"`
-}
\ No newline at end of file
+}
+
+var TakeCoverageDeltaCommand = cdp.Command[TakeCoverageDeltaParams, TakeCoverageDeltaResult]{Method: TakeCoverageDelta}
\ No newline at end of file
@@ -1,8 +1,18 @@
+// Package css implements the CSS domain of the DevTools protocol.
+//
+// This domain exposes CSS read/write operations. All CSS objects (stylesheets, rules, and styles)
+// have an associated `id` used in subsequent operations on the related object. Each object type has
+// a specific `id` structure, and those are not interchangeable between objects of different kinds.
+// CSS objects can be loaded using the `get*ForNode()` calls (which accept a DOM node id). A client
+// can also keep track of stylesheets via the `styleSheetAdded`/`styleSheetRemoved` events and
+// subsequently load the required stylesheet contents using the `getStyleSheet[Text]()` methods.
+//
+// Experimental: may be changed or removed without notice.
 package css
 
 import (
-	"github.com/diiyw/cuto/protocol/dom"
 	"github.com/diiyw/cuto/protocol/cdp"
+	"github.com/diiyw/cuto/protocol/dom"
 )
 
 // 
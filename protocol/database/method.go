@@ -1,5 +1,10 @@
 package database
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Disables database tracking, prevents database events from being sent to the client.
 const Disable = "Database.disable"
 
@@ -10,6 +15,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables database tracking, database events will now be delivered to the client.
 const Enable = "Database.enable"
 
@@ -20,6 +27,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // 
 const ExecuteSQL = "Database.executeSQL"
 
@@ -42,6 +51,8 @@ type ExecuteSQLResult struct {
 	SqlError 	Error	`json:"sqlError"`
 }
 
+var ExecuteSQLCommand = cdp.Command[ExecuteSQLParams, ExecuteSQLResult]{Method: ExecuteSQL}
+
 // 
 const GetDatabaseTableNames = "Database.getDatabaseTableNames"
 
@@ -55,4 +66,6 @@ type GetDatabaseTableNamesResult struct {
 
 	// 
 	TableNames 	[]string	`json:"tableNames"`
-}
\ No newline at end of file
+}
+
+var GetDatabaseTableNamesCommand = cdp.Command[GetDatabaseTableNamesParams, GetDatabaseTableNamesResult]{Method: GetDatabaseTableNames}
\ No newline at end of file
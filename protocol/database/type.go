@@ -1,3 +1,6 @@
+// Package database implements the Database domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package database
 // Unique identifier of Database object.
 type DatabaseId string
@@ -31,8 +31,14 @@ type PausedParams struct {
 	// Async stack trace, if any.
 	AsyncStackTrace 	runtime.StackTrace
 	// Async stack trace, if any.
+	//
+	// Experimental: may be changed or removed without notice.
 	AsyncStackTraceId 	runtime.StackTraceId
 	// Never present, will be removed.
+	//
+	// Experimental: may be changed or removed without notice.
+	//
+	// Deprecated: marked as deprecated by the DevTools protocol.
 	AsyncCallStackTraceId 	runtime.StackTraceId}
 
 
@@ -75,6 +81,8 @@ type ScriptFailedToParseParams struct {
 	// This script length.
 	Length 	int
 	// JavaScript top stack frame of where the script parsed event was triggered if available.
+	//
+	// Experimental: may be changed or removed without notice.
 	StackTrace 	runtime.StackTrace}
 
 
@@ -103,6 +111,8 @@ type ScriptParsedParams struct {
 	// Embedder-specific auxiliary data.
 	ExecutionContextAuxData 	interface{}
 	// True, if this script is generated as a result of the live edit operation.
+	//
+	// Experimental: may be changed or removed without notice.
 	IsLiveEdit 	bool
 	// URL of source map associated with script (if any).
 	SourceMapURL 	string
@@ -113,5 +123,7 @@ type ScriptParsedParams struct {
 	// This script length.
 	Length 	int
 	// JavaScript top stack frame of where the script parsed event was triggered if available.
+	//
+	// Experimental: may be changed or removed without notice.
 	StackTrace 	runtime.StackTrace}
 
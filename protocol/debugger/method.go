@@ -1,6 +1,7 @@
 package debugger
 
 import (
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/runtime"
 )
 
@@ -21,6 +22,8 @@ type ContinueToLocationResult struct {
 
 }
 
+var ContinueToLocationCommand = cdp.Command[ContinueToLocationParams, ContinueToLocationResult]{Method: ContinueToLocation}
+
 // Disables debugger for given page.
 const Disable = "Debugger.disable"
 
@@ -31,6 +34,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables debugger for the given page. Clients should not assume that the debugging has been
 // enabled until the result for this command is received.
 const Enable = "Debugger.enable"
@@ -39,15 +44,21 @@ type EnableParams struct {
 
 	// The maximum size in bytes of collected scripts (not referenced by other heap objects)
 	// the debugger can hold. Puts no limit if paramter is omitted.
+	//
+	// Experimental: may be changed or removed without notice.
 	MaxScriptsCacheSize 	float64	`json:"maxScriptsCacheSize,omitempty"`
 }
 
 type EnableResult struct {
 
 	// Unique identifier of the debugger.
+	//
+	// Experimental: may be changed or removed without notice.
 	DebuggerId 	runtime.UniqueDebuggerId	`json:"debuggerId"`
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Evaluates expression on a given call frame.
 const EvaluateOnCallFrame = "Debugger.evaluateOnCallFrame"
 
@@ -75,12 +86,16 @@ type EvaluateOnCallFrameParams struct {
 	ReturnByValue 	bool	`json:"returnByValue,omitempty"`
 
 	// Whether preview should be generated for the result.
+	//
+	// Experimental: may be changed or removed without notice.
 	GeneratePreview 	bool	`json:"generatePreview,omitempty"`
 
 	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
 	ThrowOnSideEffect 	bool	`json:"throwOnSideEffect,omitempty"`
 
 	// Terminate execution after timing out (number of milliseconds).
+	//
+	// Experimental: may be changed or removed without notice.
 	Timeout 	runtime.TimeDelta	`json:"timeout,omitempty"`
 }
 
@@ -92,6 +107,8 @@ type EvaluateOnCallFrameResult struct {
 	ExceptionDetails 	runtime.ExceptionDetails	`json:"exceptionDetails"`
 }
 
+var EvaluateOnCallFrameCommand = cdp.Command[EvaluateOnCallFrameParams, EvaluateOnCallFrameResult]{Method: EvaluateOnCallFrame}
+
 // Returns possible locations for breakpoint. scriptId in start and end range locations should be
 // the same.
 const GetPossibleBreakpoints = "Debugger.getPossibleBreakpoints"
@@ -115,6 +132,8 @@ type GetPossibleBreakpointsResult struct {
 	Locations 	[]*BreakLocation	`json:"locations"`
 }
 
+var GetPossibleBreakpointsCommand = cdp.Command[GetPossibleBreakpointsParams, GetPossibleBreakpointsResult]{Method: GetPossibleBreakpoints}
+
 // Returns source for the script with given id.
 const GetScriptSource = "Debugger.getScriptSource"
 
@@ -130,6 +149,8 @@ type GetScriptSourceResult struct {
 	ScriptSource 	string	`json:"scriptSource"`
 }
 
+var GetScriptSourceCommand = cdp.Command[GetScriptSourceParams, GetScriptSourceResult]{Method: GetScriptSource}
+
 // Returns bytecode for the WebAssembly script with given id.
 const GetWasmBytecode = "Debugger.getWasmBytecode"
 
@@ -145,7 +166,11 @@ type GetWasmBytecodeResult struct {
 	Bytecode 	[]byte	`json:"bytecode"`
 }
 
+var GetWasmBytecodeCommand = cdp.Command[GetWasmBytecodeParams, GetWasmBytecodeResult]{Method: GetWasmBytecode}
+
 // Returns stack trace with given `stackTraceId`.
+//
+// Experimental: may be changed or removed without notice.
 const GetStackTrace = "Debugger.getStackTrace"
 
 type GetStackTraceParams struct {
@@ -160,6 +185,8 @@ type GetStackTraceResult struct {
 	StackTrace 	runtime.StackTrace	`json:"stackTrace"`
 }
 
+var GetStackTraceCommand = cdp.Command[GetStackTraceParams, GetStackTraceResult]{Method: GetStackTrace}
+
 // Stops on the next JavaScript statement.
 const Pause = "Debugger.pause"
 
@@ -170,7 +197,13 @@ type PauseResult struct {
 
 }
 
+var PauseCommand = cdp.Command[PauseParams, PauseResult]{Method: Pause}
+
 // 
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const PauseOnAsyncCall = "Debugger.pauseOnAsyncCall"
 
 type PauseOnAsyncCallParams struct {
@@ -183,6 +216,8 @@ type PauseOnAsyncCallResult struct {
 
 }
 
+var PauseOnAsyncCallCommand = cdp.Command[PauseOnAsyncCallParams, PauseOnAsyncCallResult]{Method: PauseOnAsyncCall}
+
 // Removes JavaScript breakpoint.
 const RemoveBreakpoint = "Debugger.removeBreakpoint"
 
@@ -196,6 +231,8 @@ type RemoveBreakpointResult struct {
 
 }
 
+var RemoveBreakpointCommand = cdp.Command[RemoveBreakpointParams, RemoveBreakpointResult]{Method: RemoveBreakpoint}
+
 // Restarts particular call frame from the beginning.
 const RestartFrame = "Debugger.restartFrame"
 
@@ -212,9 +249,13 @@ type RestartFrameResult struct {
 	// Async stack trace, if any.
 	AsyncStackTrace 	runtime.StackTrace	`json:"asyncStackTrace"`
 	// Async stack trace, if any.
+	//
+	// Experimental: may be changed or removed without notice.
 	AsyncStackTraceId 	runtime.StackTraceId	`json:"asyncStackTraceId"`
 }
 
+var RestartFrameCommand = cdp.Command[RestartFrameParams, RestartFrameResult]{Method: RestartFrame}
+
 // Resumes JavaScript execution.
 const Resume = "Debugger.resume"
 
@@ -225,6 +266,8 @@ type ResumeResult struct {
 
 }
 
+var ResumeCommand = cdp.Command[ResumeParams, ResumeResult]{Method: Resume}
+
 // Searches for given string in script content.
 const SearchInContent = "Debugger.searchInContent"
 
@@ -249,6 +292,8 @@ type SearchInContentResult struct {
 	Result 	[]*SearchMatch	`json:"result"`
 }
 
+var SearchInContentCommand = cdp.Command[SearchInContentParams, SearchInContentResult]{Method: SearchInContent}
+
 // Enables or disables async call stacks tracking.
 const SetAsyncCallStackDepth = "Debugger.setAsyncCallStackDepth"
 
@@ -263,9 +308,13 @@ type SetAsyncCallStackDepthResult struct {
 
 }
 
+var SetAsyncCallStackDepthCommand = cdp.Command[SetAsyncCallStackDepthParams, SetAsyncCallStackDepthResult]{Method: SetAsyncCallStackDepth}
+
 // Replace previous blackbox patterns with passed ones. Forces backend to skip stepping/pausing in
 // scripts with url matching one of the patterns. VM will try to leave blackboxed script by
 // performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+//
+// Experimental: may be changed or removed without notice.
 const SetBlackboxPatterns = "Debugger.setBlackboxPatterns"
 
 type SetBlackboxPatternsParams struct {
@@ -278,10 +327,14 @@ type SetBlackboxPatternsResult struct {
 
 }
 
+var SetBlackboxPatternsCommand = cdp.Command[SetBlackboxPatternsParams, SetBlackboxPatternsResult]{Method: SetBlackboxPatterns}
+
 // Makes backend skip steps in the script in blackboxed ranges. VM will try leave blacklisted
 // scripts by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
 // Positions array contains positions where blackbox state is changed. First interval isn't
 // blackboxed. Array should be sorted.
+//
+// Experimental: may be changed or removed without notice.
 const SetBlackboxedRanges = "Debugger.setBlackboxedRanges"
 
 type SetBlackboxedRangesParams struct {
@@ -297,6 +350,8 @@ type SetBlackboxedRangesResult struct {
 
 }
 
+var SetBlackboxedRangesCommand = cdp.Command[SetBlackboxedRangesParams, SetBlackboxedRangesResult]{Method: SetBlackboxedRanges}
+
 // Sets JavaScript breakpoint at a given location.
 const SetBreakpoint = "Debugger.setBreakpoint"
 
@@ -318,6 +373,8 @@ type SetBreakpointResult struct {
 	ActualLocation 	Location	`json:"actualLocation"`
 }
 
+var SetBreakpointCommand = cdp.Command[SetBreakpointParams, SetBreakpointResult]{Method: SetBreakpoint}
+
 // Sets instrumentation breakpoint.
 const SetInstrumentationBreakpoint = "Debugger.setInstrumentationBreakpoint"
 
@@ -333,6 +390,8 @@ type SetInstrumentationBreakpointResult struct {
 	BreakpointId 	BreakpointId	`json:"breakpointId"`
 }
 
+var SetInstrumentationBreakpointCommand = cdp.Command[SetInstrumentationBreakpointParams, SetInstrumentationBreakpointResult]{Method: SetInstrumentationBreakpoint}
+
 // Sets JavaScript breakpoint at given location specified either by URL or URL regex. Once this
 // command is issued, all existing parsed scripts will have breakpoints resolved and returned in
 // `locations` property. Further matching script parsing will result in subsequent
@@ -370,9 +429,13 @@ type SetBreakpointByUrlResult struct {
 	Locations 	[]*Location	`json:"locations"`
 }
 
+var SetBreakpointByUrlCommand = cdp.Command[SetBreakpointByUrlParams, SetBreakpointByUrlResult]{Method: SetBreakpointByUrl}
+
 // Sets JavaScript breakpoint before each call to the given function.
 // If another function was created from the same source as a given one,
 // calling it will also trigger the breakpoint.
+//
+// Experimental: may be changed or removed without notice.
 const SetBreakpointOnFunctionCall = "Debugger.setBreakpointOnFunctionCall"
 
 type SetBreakpointOnFunctionCallParams struct {
@@ -391,6 +454,8 @@ type SetBreakpointOnFunctionCallResult struct {
 	BreakpointId 	BreakpointId	`json:"breakpointId"`
 }
 
+var SetBreakpointOnFunctionCallCommand = cdp.Command[SetBreakpointOnFunctionCallParams, SetBreakpointOnFunctionCallResult]{Method: SetBreakpointOnFunctionCall}
+
 // Activates / deactivates all breakpoints on the page.
 const SetBreakpointsActive = "Debugger.setBreakpointsActive"
 
@@ -404,6 +469,8 @@ type SetBreakpointsActiveResult struct {
 
 }
 
+var SetBreakpointsActiveCommand = cdp.Command[SetBreakpointsActiveParams, SetBreakpointsActiveResult]{Method: SetBreakpointsActive}
+
 // Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions or
 // no exceptions. Initial pause on exceptions state is `none`.
 const SetPauseOnExceptions = "Debugger.setPauseOnExceptions"
@@ -418,7 +485,11 @@ type SetPauseOnExceptionsResult struct {
 
 }
 
+var SetPauseOnExceptionsCommand = cdp.Command[SetPauseOnExceptionsParams, SetPauseOnExceptionsResult]{Method: SetPauseOnExceptions}
+
 // Changes return value in top frame. Available only at return break position.
+//
+// Experimental: may be changed or removed without notice.
 const SetReturnValue = "Debugger.setReturnValue"
 
 type SetReturnValueParams struct {
@@ -431,6 +502,8 @@ type SetReturnValueResult struct {
 
 }
 
+var SetReturnValueCommand = cdp.Command[SetReturnValueParams, SetReturnValueResult]{Method: SetReturnValue}
+
 // Edits JavaScript source live.
 const SetScriptSource = "Debugger.setScriptSource"
 
@@ -456,11 +529,15 @@ type SetScriptSourceResult struct {
 	// Async stack trace, if any.
 	AsyncStackTrace 	runtime.StackTrace	`json:"asyncStackTrace"`
 	// Async stack trace, if any.
+	//
+	// Experimental: may be changed or removed without notice.
 	AsyncStackTraceId 	runtime.StackTraceId	`json:"asyncStackTraceId"`
 	// Exception details if any.
 	ExceptionDetails 	runtime.ExceptionDetails	`json:"exceptionDetails"`
 }
 
+var SetScriptSourceCommand = cdp.Command[SetScriptSourceParams, SetScriptSourceResult]{Method: SetScriptSource}
+
 // Makes page not interrupt on any pauses (breakpoint, exception, dom exception etc).
 const SetSkipAllPauses = "Debugger.setSkipAllPauses"
 
@@ -474,6 +551,8 @@ type SetSkipAllPausesResult struct {
 
 }
 
+var SetSkipAllPausesCommand = cdp.Command[SetSkipAllPausesParams, SetSkipAllPausesResult]{Method: SetSkipAllPauses}
+
 // Changes value of variable in a callframe. Object-based scopes are not supported and must be
 // mutated manually.
 const SetVariableValue = "Debugger.setVariableValue"
@@ -498,6 +577,8 @@ type SetVariableValueResult struct {
 
 }
 
+var SetVariableValueCommand = cdp.Command[SetVariableValueParams, SetVariableValueResult]{Method: SetVariableValue}
+
 // Steps into the function call.
 const StepInto = "Debugger.stepInto"
 
@@ -505,6 +586,8 @@ type StepIntoParams struct {
 
 	// Debugger will pause on the execution of the first async task which was scheduled
 	// before next pause.
+	//
+	// Experimental: may be changed or removed without notice.
 	BreakOnAsyncCall 	bool	`json:"breakOnAsyncCall,omitempty"`
 }
 
@@ -512,6 +595,8 @@ type StepIntoResult struct {
 
 }
 
+var StepIntoCommand = cdp.Command[StepIntoParams, StepIntoResult]{Method: StepInto}
+
 // Steps out of the function call.
 const StepOut = "Debugger.stepOut"
 
@@ -522,6 +607,8 @@ type StepOutResult struct {
 
 }
 
+var StepOutCommand = cdp.Command[StepOutParams, StepOutResult]{Method: StepOut}
+
 // Steps over the statement.
 const StepOver = "Debugger.stepOver"
 
@@ -530,4 +617,6 @@ type StepOverParams struct {
 
 type StepOverResult struct {
 
-}
\ No newline at end of file
+}
+
+var StepOverCommand = cdp.Command[StepOverParams, StepOverResult]{Method: StepOver}
\ No newline at end of file
@@ -1,3 +1,7 @@
+// Package debugger implements the Debugger domain of the DevTools protocol.
+//
+// Debugger domain exposes JavaScript debugging capabilities. It allows setting and removing
+// breakpoints, stepping through execution, exploring stack traces, etc.
 package debugger
 
 import (
@@ -24,6 +28,8 @@ type Location  struct {
 }
 
 // Location in the source code.
+//
+// Experimental: may be changed or removed without notice.
 type ScriptPosition  struct {
 
 	// 
@@ -1,5 +1,10 @@
 package deviceorientation
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Clears the overridden Device Orientation.
 const ClearDeviceOrientationOverride = "DeviceOrientation.clearDeviceOrientationOverride"
 
@@ -10,6 +15,8 @@ type ClearDeviceOrientationOverrideResult struct {
 
 }
 
+var ClearDeviceOrientationOverrideCommand = cdp.Command[ClearDeviceOrientationOverrideParams, ClearDeviceOrientationOverrideResult]{Method: ClearDeviceOrientationOverride}
+
 // Overrides the Device Orientation.
 const SetDeviceOrientationOverride = "DeviceOrientation.setDeviceOrientationOverride"
 
@@ -27,4 +34,6 @@ type SetDeviceOrientationOverrideParams struct {
 
 type SetDeviceOrientationOverrideResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetDeviceOrientationOverrideCommand = cdp.Command[SetDeviceOrientationOverrideParams, SetDeviceOrientationOverrideResult]{Method: SetDeviceOrientationOverride}
\ No newline at end of file
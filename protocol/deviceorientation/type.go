@@ -1 +1,4 @@
+// Package deviceorientation implements the DeviceOrientation domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package deviceorientation
\ No newline at end of file
@@ -71,6 +71,8 @@ type ChildNodeRemovedParams struct {
 
 
 // Called when distrubution is changed.
+//
+// Experimental: may be changed or removed without notice.
 const DistributedNodesUpdatedEvent = "DOM.distributedNodesUpdated"
 type DistributedNodesUpdatedParams struct {
 
@@ -89,6 +91,8 @@ type DocumentUpdatedParams struct {
 
 
 // Fired when `Element`'s inline style is modified via a CSS property modification.
+//
+// Experimental: may be changed or removed without notice.
 const InlineStyleInvalidatedEvent = "DOM.inlineStyleInvalidated"
 type InlineStyleInvalidatedParams struct {
 
@@ -98,6 +102,8 @@ type InlineStyleInvalidatedParams struct {
 
 
 // Called when a pseudo element is added to an element.
+//
+// Experimental: may be changed or removed without notice.
 const PseudoElementAddedEvent = "DOM.pseudoElementAdded"
 type PseudoElementAddedParams struct {
 
@@ -109,6 +115,8 @@ type PseudoElementAddedParams struct {
 
 
 // Called when a pseudo element is removed from an element.
+//
+// Experimental: may be changed or removed without notice.
 const PseudoElementRemovedEvent = "DOM.pseudoElementRemoved"
 type PseudoElementRemovedParams struct {
 
@@ -132,6 +140,8 @@ type SetChildNodesParams struct {
 
 
 // Called when shadow root is popped from the element.
+//
+// Experimental: may be changed or removed without notice.
 const ShadowRootPoppedEvent = "DOM.shadowRootPopped"
 type ShadowRootPoppedParams struct {
 
@@ -143,6 +153,8 @@ type ShadowRootPoppedParams struct {
 
 
 // Called when shadow root is pushed into the element.
+//
+// Experimental: may be changed or removed without notice.
 const ShadowRootPushedEvent = "DOM.shadowRootPushed"
 type ShadowRootPushedParams struct {
 
@@ -1,12 +1,14 @@
 package dom
 
 import (
-	"github.com/diiyw/cuto/protocol/runtime"
 	"github.com/diiyw/cuto/protocol/cdp"
+	"github.com/diiyw/cuto/protocol/runtime"
 )
 
 
 // Collects class names for the node with given id and all of it's child nodes.
+//
+// Experimental: may be changed or removed without notice.
 const CollectClassNamesFromSubtree = "DOM.collectClassNamesFromSubtree"
 
 type CollectClassNamesFromSubtreeParams struct {
@@ -21,8 +23,12 @@ type CollectClassNamesFromSubtreeResult struct {
 	ClassNames 	[]string	`json:"classNames"`
 }
 
+var CollectClassNamesFromSubtreeCommand = cdp.Command[CollectClassNamesFromSubtreeParams, CollectClassNamesFromSubtreeResult]{Method: CollectClassNamesFromSubtree}
+
 // Creates a deep copy of the specified node and places it into the target container before the
 // given anchor.
+//
+// Experimental: may be changed or removed without notice.
 const CopyTo = "DOM.copyTo"
 
 type CopyToParams struct {
@@ -44,6 +50,8 @@ type CopyToResult struct {
 	NodeId 	NodeId	`json:"nodeId"`
 }
 
+var CopyToCommand = cdp.Command[CopyToParams, CopyToResult]{Method: CopyTo}
+
 // Describes node given its id, does not require domain to be enabled. Does not start tracking any
 // objects, can be used for automation.
 const DescribeNode = "DOM.describeNode"
@@ -74,6 +82,8 @@ type DescribeNodeResult struct {
 	Node 	Node	`json:"node"`
 }
 
+var DescribeNodeCommand = cdp.Command[DescribeNodeParams, DescribeNodeResult]{Method: DescribeNode}
+
 // Disables DOM agent for the given page.
 const Disable = "DOM.disable"
 
@@ -84,8 +94,12 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Discards search results from the session with the given id. `getSearchResults` should no longer
 // be called for that search.
+//
+// Experimental: may be changed or removed without notice.
 const DiscardSearchResults = "DOM.discardSearchResults"
 
 type DiscardSearchResultsParams struct {
@@ -98,6 +112,8 @@ type DiscardSearchResultsResult struct {
 
 }
 
+var DiscardSearchResultsCommand = cdp.Command[DiscardSearchResultsParams, DiscardSearchResultsResult]{Method: DiscardSearchResults}
+
 // Enables DOM agent for the given page.
 const Enable = "DOM.enable"
 
@@ -108,6 +124,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Focuses the given element.
 const Focus = "DOM.focus"
 
@@ -127,6 +145,8 @@ type FocusResult struct {
 
 }
 
+var FocusCommand = cdp.Command[FocusParams, FocusResult]{Method: Focus}
+
 // Returns attributes for the specified node.
 const GetAttributes = "DOM.getAttributes"
 
@@ -142,6 +162,8 @@ type GetAttributesResult struct {
 	Attributes 	[]string	`json:"attributes"`
 }
 
+var GetAttributesCommand = cdp.Command[GetAttributesParams, GetAttributesResult]{Method: GetAttributes}
+
 // Returns boxes for the given node.
 const GetBoxModel = "DOM.getBoxModel"
 
@@ -163,8 +185,12 @@ type GetBoxModelResult struct {
 	Model 	BoxModel	`json:"model"`
 }
 
+var GetBoxModelCommand = cdp.Command[GetBoxModelParams, GetBoxModelResult]{Method: GetBoxModel}
+
 // Returns quads that describe node position on the page. This method
 // might return multiple quads for inline nodes.
+//
+// Experimental: may be changed or removed without notice.
 const GetContentQuads = "DOM.getContentQuads"
 
 type GetContentQuadsParams struct {
@@ -185,6 +211,8 @@ type GetContentQuadsResult struct {
 	Quads 	[]*Quad	`json:"quads"`
 }
 
+var GetContentQuadsCommand = cdp.Command[GetContentQuadsParams, GetContentQuadsResult]{Method: GetContentQuads}
+
 // Returns the root DOM node (and optionally the subtree) to the caller.
 const GetDocument = "DOM.getDocument"
 
@@ -205,6 +233,8 @@ type GetDocumentResult struct {
 	Root 	Node	`json:"root"`
 }
 
+var GetDocumentCommand = cdp.Command[GetDocumentParams, GetDocumentResult]{Method: GetDocument}
+
 // Returns the root DOM node (and optionally the subtree) to the caller.
 const GetFlattenedDocument = "DOM.getFlattenedDocument"
 
@@ -225,6 +255,8 @@ type GetFlattenedDocumentResult struct {
 	Nodes 	[]*Node	`json:"nodes"`
 }
 
+var GetFlattenedDocumentCommand = cdp.Command[GetFlattenedDocumentParams, GetFlattenedDocumentResult]{Method: GetFlattenedDocument}
+
 // Returns node id at given location. Depending on whether DOM domain is enabled, nodeId is
 // either returned or not.
 const GetNodeForLocation = "DOM.getNodeForLocation"
@@ -254,6 +286,8 @@ type GetNodeForLocationResult struct {
 	NodeId 	NodeId	`json:"nodeId"`
 }
 
+var GetNodeForLocationCommand = cdp.Command[GetNodeForLocationParams, GetNodeForLocationResult]{Method: GetNodeForLocation}
+
 // Returns node's HTML markup.
 const GetOuterHTML = "DOM.getOuterHTML"
 
@@ -275,7 +309,11 @@ type GetOuterHTMLResult struct {
 	OuterHTML 	string	`json:"outerHTML"`
 }
 
+var GetOuterHTMLCommand = cdp.Command[GetOuterHTMLParams, GetOuterHTMLResult]{Method: GetOuterHTML}
+
 // Returns the id of the nearest ancestor that is a relayout boundary.
+//
+// Experimental: may be changed or removed without notice.
 const GetRelayoutBoundary = "DOM.getRelayoutBoundary"
 
 type GetRelayoutBoundaryParams struct {
@@ -290,8 +328,12 @@ type GetRelayoutBoundaryResult struct {
 	NodeId 	NodeId	`json:"nodeId"`
 }
 
+var GetRelayoutBoundaryCommand = cdp.Command[GetRelayoutBoundaryParams, GetRelayoutBoundaryResult]{Method: GetRelayoutBoundary}
+
 // Returns search results from given `fromIndex` to given `toIndex` from the search with the given
 // identifier.
+//
+// Experimental: may be changed or removed without notice.
 const GetSearchResults = "DOM.getSearchResults"
 
 type GetSearchResultsParams struct {
@@ -312,6 +354,8 @@ type GetSearchResultsResult struct {
 	NodeIds 	[]*NodeId	`json:"nodeIds"`
 }
 
+var GetSearchResultsCommand = cdp.Command[GetSearchResultsParams, GetSearchResultsResult]{Method: GetSearchResults}
+
 // Hides any highlight.
 const HideHighlight = "DOM.hideHighlight"
 
@@ -322,6 +366,8 @@ type HideHighlightResult struct {
 
 }
 
+var HideHighlightCommand = cdp.Command[HideHighlightParams, HideHighlightResult]{Method: HideHighlight}
+
 // Highlights DOM node.
 const HighlightNode = "DOM.highlightNode"
 
@@ -332,6 +378,8 @@ type HighlightNodeResult struct {
 
 }
 
+var HighlightNodeCommand = cdp.Command[HighlightNodeParams, HighlightNodeResult]{Method: HighlightNode}
+
 // Highlights given rectangle.
 const HighlightRect = "DOM.highlightRect"
 
@@ -342,7 +390,11 @@ type HighlightRectResult struct {
 
 }
 
+var HighlightRectCommand = cdp.Command[HighlightRectParams, HighlightRectResult]{Method: HighlightRect}
+
 // Marks last undoable state.
+//
+// Experimental: may be changed or removed without notice.
 const MarkUndoableState = "DOM.markUndoableState"
 
 type MarkUndoableStateParams struct {
@@ -352,6 +404,8 @@ type MarkUndoableStateResult struct {
 
 }
 
+var MarkUndoableStateCommand = cdp.Command[MarkUndoableStateParams, MarkUndoableStateResult]{Method: MarkUndoableState}
+
 // Moves node into the new container, places it before the given anchor.
 const MoveTo = "DOM.moveTo"
 
@@ -374,8 +428,12 @@ type MoveToResult struct {
 	NodeId 	NodeId	`json:"nodeId"`
 }
 
+var MoveToCommand = cdp.Command[MoveToParams, MoveToResult]{Method: MoveTo}
+
 // Searches for a given string in the DOM tree. Use `getSearchResults` to access search results or
 // `cancelSearch` to end this search session.
+//
+// Experimental: may be changed or removed without notice.
 const PerformSearch = "DOM.performSearch"
 
 type PerformSearchParams struct {
@@ -395,7 +453,11 @@ type PerformSearchResult struct {
 	ResultCount 	int	`json:"resultCount"`
 }
 
+var PerformSearchCommand = cdp.Command[PerformSearchParams, PerformSearchResult]{Method: PerformSearch}
+
 // Requests that the node is sent to the caller given its path. // FIXME, use XPath
+//
+// Experimental: may be changed or removed without notice.
 const PushNodeByPathToFrontend = "DOM.pushNodeByPathToFrontend"
 
 type PushNodeByPathToFrontendParams struct {
@@ -410,7 +472,11 @@ type PushNodeByPathToFrontendResult struct {
 	NodeId 	NodeId	`json:"nodeId"`
 }
 
+var PushNodeByPathToFrontendCommand = cdp.Command[PushNodeByPathToFrontendParams, PushNodeByPathToFrontendResult]{Method: PushNodeByPathToFrontend}
+
 // Requests that a batch of nodes is sent to the caller given their backend node ids.
+//
+// Experimental: may be changed or removed without notice.
 const PushNodesByBackendIdsToFrontend = "DOM.pushNodesByBackendIdsToFrontend"
 
 type PushNodesByBackendIdsToFrontendParams struct {
@@ -426,6 +492,8 @@ type PushNodesByBackendIdsToFrontendResult struct {
 	NodeIds 	[]*NodeId	`json:"nodeIds"`
 }
 
+var PushNodesByBackendIdsToFrontendCommand = cdp.Command[PushNodesByBackendIdsToFrontendParams, PushNodesByBackendIdsToFrontendResult]{Method: PushNodesByBackendIdsToFrontend}
+
 // Executes `querySelector` on a given node.
 const QuerySelector = "DOM.querySelector"
 
@@ -444,6 +512,8 @@ type QuerySelectorResult struct {
 	NodeId 	NodeId	`json:"nodeId"`
 }
 
+var QuerySelectorCommand = cdp.Command[QuerySelectorParams, QuerySelectorResult]{Method: QuerySelector}
+
 // Executes `querySelectorAll` on a given node.
 const QuerySelectorAll = "DOM.querySelectorAll"
 
@@ -462,7 +532,11 @@ type QuerySelectorAllResult struct {
 	NodeIds 	[]*NodeId	`json:"nodeIds"`
 }
 
+var QuerySelectorAllCommand = cdp.Command[QuerySelectorAllParams, QuerySelectorAllResult]{Method: QuerySelectorAll}
+
 // Re-does the last undone action.
+//
+// Experimental: may be changed or removed without notice.
 const Redo = "DOM.redo"
 
 type RedoParams struct {
@@ -472,6 +546,8 @@ type RedoResult struct {
 
 }
 
+var RedoCommand = cdp.Command[RedoParams, RedoResult]{Method: Redo}
+
 // Removes attribute with given name from an element with given id.
 const RemoveAttribute = "DOM.removeAttribute"
 
@@ -488,6 +564,8 @@ type RemoveAttributeResult struct {
 
 }
 
+var RemoveAttributeCommand = cdp.Command[RemoveAttributeParams, RemoveAttributeResult]{Method: RemoveAttribute}
+
 // Removes node with given id.
 const RemoveNode = "DOM.removeNode"
 
@@ -501,6 +579,8 @@ type RemoveNodeResult struct {
 
 }
 
+var RemoveNodeCommand = cdp.Command[RemoveNodeParams, RemoveNodeResult]{Method: RemoveNode}
+
 // Requests that children of the node with given id are returned to the caller in form of
 // `setChildNodes` events where not only immediate children are retrieved, but all children down to
 // the specified depth.
@@ -524,6 +604,8 @@ type RequestChildNodesResult struct {
 
 }
 
+var RequestChildNodesCommand = cdp.Command[RequestChildNodesParams, RequestChildNodesResult]{Method: RequestChildNodes}
+
 // Requests that the node is sent to the caller given the JavaScript node object reference. All
 // nodes that form the path from the node to the root are also sent to the client as a series of
 // `setChildNodes` notifications.
@@ -541,6 +623,8 @@ type RequestNodeResult struct {
 	NodeId 	NodeId	`json:"nodeId"`
 }
 
+var RequestNodeCommand = cdp.Command[RequestNodeParams, RequestNodeResult]{Method: RequestNode}
+
 // Resolves the JavaScript node object for a given NodeId or BackendNodeId.
 const ResolveNode = "DOM.resolveNode"
 
@@ -565,6 +649,8 @@ type ResolveNodeResult struct {
 	Object 	runtime.RemoteObject	`json:"object"`
 }
 
+var ResolveNodeCommand = cdp.Command[ResolveNodeParams, ResolveNodeResult]{Method: ResolveNode}
+
 // Sets attribute for an element with given id.
 const SetAttributeValue = "DOM.setAttributeValue"
 
@@ -584,6 +670,8 @@ type SetAttributeValueResult struct {
 
 }
 
+var SetAttributeValueCommand = cdp.Command[SetAttributeValueParams, SetAttributeValueResult]{Method: SetAttributeValue}
+
 // Sets attributes on element with given id. This method is useful when user edits some existing
 // attribute value and types in several attribute name/value pairs.
 const SetAttributesAsText = "DOM.setAttributesAsText"
@@ -605,6 +693,8 @@ type SetAttributesAsTextResult struct {
 
 }
 
+var SetAttributesAsTextCommand = cdp.Command[SetAttributesAsTextParams, SetAttributesAsTextResult]{Method: SetAttributesAsText}
+
 // Sets files for the given file input element.
 const SetFileInputFiles = "DOM.setFileInputFiles"
 
@@ -627,7 +717,11 @@ type SetFileInputFilesResult struct {
 
 }
 
+var SetFileInputFilesCommand = cdp.Command[SetFileInputFilesParams, SetFileInputFilesResult]{Method: SetFileInputFiles}
+
 // Sets if stack traces should be captured for Nodes. See `Node.getNodeStackTraces`. Default is disabled.
+//
+// Experimental: may be changed or removed without notice.
 const SetNodeStackTracesEnabled = "DOM.setNodeStackTracesEnabled"
 
 type SetNodeStackTracesEnabledParams struct {
@@ -640,7 +734,11 @@ type SetNodeStackTracesEnabledResult struct {
 
 }
 
+var SetNodeStackTracesEnabledCommand = cdp.Command[SetNodeStackTracesEnabledParams, SetNodeStackTracesEnabledResult]{Method: SetNodeStackTracesEnabled}
+
 // Gets stack traces associated with a Node. As of now, only provides stack trace for Node creation.
+//
+// Experimental: may be changed or removed without notice.
 const GetNodeStackTraces = "DOM.getNodeStackTraces"
 
 type GetNodeStackTracesParams struct {
@@ -655,8 +753,12 @@ type GetNodeStackTracesResult struct {
 	Creation 	runtime.StackTrace	`json:"creation"`
 }
 
+var GetNodeStackTracesCommand = cdp.Command[GetNodeStackTracesParams, GetNodeStackTracesResult]{Method: GetNodeStackTraces}
+
 // Returns file information for the given
 // File wrapper.
+//
+// Experimental: may be changed or removed without notice.
 const GetFileInfo = "DOM.getFileInfo"
 
 type GetFileInfoParams struct {
@@ -671,8 +773,12 @@ type GetFileInfoResult struct {
 	Path 	string	`json:"path"`
 }
 
+var GetFileInfoCommand = cdp.Command[GetFileInfoParams, GetFileInfoResult]{Method: GetFileInfo}
+
 // Enables console to refer to the node with given id via $x (see Command Line API for more details
 // $x functions).
+//
+// Experimental: may be changed or removed without notice.
 const SetInspectedNode = "DOM.setInspectedNode"
 
 type SetInspectedNodeParams struct {
@@ -685,6 +791,8 @@ type SetInspectedNodeResult struct {
 
 }
 
+var SetInspectedNodeCommand = cdp.Command[SetInspectedNodeParams, SetInspectedNodeResult]{Method: SetInspectedNode}
+
 // Sets node name for a node with given id.
 const SetNodeName = "DOM.setNodeName"
 
@@ -703,6 +811,8 @@ type SetNodeNameResult struct {
 	NodeId 	NodeId	`json:"nodeId"`
 }
 
+var SetNodeNameCommand = cdp.Command[SetNodeNameParams, SetNodeNameResult]{Method: SetNodeName}
+
 // Sets node value for a node with given id.
 const SetNodeValue = "DOM.setNodeValue"
 
@@ -719,6 +829,8 @@ type SetNodeValueResult struct {
 
 }
 
+var SetNodeValueCommand = cdp.Command[SetNodeValueParams, SetNodeValueResult]{Method: SetNodeValue}
+
 // Sets node HTML markup, returns new node id.
 const SetOuterHTML = "DOM.setOuterHTML"
 
@@ -735,7 +847,11 @@ type SetOuterHTMLResult struct {
 
 }
 
+var SetOuterHTMLCommand = cdp.Command[SetOuterHTMLParams, SetOuterHTMLResult]{Method: SetOuterHTML}
+
 // Undoes the last performed action.
+//
+// Experimental: may be changed or removed without notice.
 const Undo = "DOM.undo"
 
 type UndoParams struct {
@@ -745,7 +861,11 @@ type UndoResult struct {
 
 }
 
+var UndoCommand = cdp.Command[UndoParams, UndoResult]{Method: Undo}
+
 // Returns iframe node that owns iframe with the given domain.
+//
+// Experimental: may be changed or removed without notice.
 const GetFrameOwner = "DOM.getFrameOwner"
 
 type GetFrameOwnerParams struct {
@@ -760,4 +880,6 @@ type GetFrameOwnerResult struct {
 	BackendNodeId 	BackendNodeId	`json:"backendNodeId"`
 	// Id of the node at given coordinates, only when enabled and requested document.
 	NodeId 	NodeId	`json:"nodeId"`
-}
\ No newline at end of file
+}
+
+var GetFrameOwnerCommand = cdp.Command[GetFrameOwnerParams, GetFrameOwnerResult]{Method: GetFrameOwner}
\ No newline at end of file
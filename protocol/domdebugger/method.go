@@ -1,8 +1,9 @@
 package domdebugger
 
 import (
-	"github.com/diiyw/cuto/protocol/runtime"
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/runtime"
 )
 
 
@@ -29,6 +30,8 @@ type GetEventListenersResult struct {
 	Listeners 	[]*EventListener	`json:"listeners"`
 }
 
+var GetEventListenersCommand = cdp.Command[GetEventListenersParams, GetEventListenersResult]{Method: GetEventListeners}
+
 // Removes DOM breakpoint that was set using `setDOMBreakpoint`.
 const RemoveDOMBreakpoint = "DOMDebugger.removeDOMBreakpoint"
 
@@ -45,6 +48,8 @@ type RemoveDOMBreakpointResult struct {
 
 }
 
+var RemoveDOMBreakpointCommand = cdp.Command[RemoveDOMBreakpointParams, RemoveDOMBreakpointResult]{Method: RemoveDOMBreakpoint}
+
 // Removes breakpoint on particular DOM event.
 const RemoveEventListenerBreakpoint = "DOMDebugger.removeEventListenerBreakpoint"
 
@@ -54,6 +59,8 @@ type RemoveEventListenerBreakpointParams struct {
 	EventName 	string	`json:"eventName"`
 
 	// EventTarget interface name.
+	//
+	// Experimental: may be changed or removed without notice.
 	TargetName 	string	`json:"targetName,omitempty"`
 }
 
@@ -61,7 +68,11 @@ type RemoveEventListenerBreakpointResult struct {
 
 }
 
+var RemoveEventListenerBreakpointCommand = cdp.Command[RemoveEventListenerBreakpointParams, RemoveEventListenerBreakpointResult]{Method: RemoveEventListenerBreakpoint}
+
 // Removes breakpoint on particular native event.
+//
+// Experimental: may be changed or removed without notice.
 const RemoveInstrumentationBreakpoint = "DOMDebugger.removeInstrumentationBreakpoint"
 
 type RemoveInstrumentationBreakpointParams struct {
@@ -74,6 +85,8 @@ type RemoveInstrumentationBreakpointResult struct {
 
 }
 
+var RemoveInstrumentationBreakpointCommand = cdp.Command[RemoveInstrumentationBreakpointParams, RemoveInstrumentationBreakpointResult]{Method: RemoveInstrumentationBreakpoint}
+
 // Removes breakpoint from XMLHttpRequest.
 const RemoveXHRBreakpoint = "DOMDebugger.removeXHRBreakpoint"
 
@@ -87,6 +100,8 @@ type RemoveXHRBreakpointResult struct {
 
 }
 
+var RemoveXHRBreakpointCommand = cdp.Command[RemoveXHRBreakpointParams, RemoveXHRBreakpointResult]{Method: RemoveXHRBreakpoint}
+
 // Sets breakpoint on particular operation with DOM.
 const SetDOMBreakpoint = "DOMDebugger.setDOMBreakpoint"
 
@@ -103,6 +118,8 @@ type SetDOMBreakpointResult struct {
 
 }
 
+var SetDOMBreakpointCommand = cdp.Command[SetDOMBreakpointParams, SetDOMBreakpointResult]{Method: SetDOMBreakpoint}
+
 // Sets breakpoint on particular DOM event.
 const SetEventListenerBreakpoint = "DOMDebugger.setEventListenerBreakpoint"
 
@@ -113,6 +130,8 @@ type SetEventListenerBreakpointParams struct {
 
 	// EventTarget interface name to stop on. If equal to `"*"` or not provided, will stop on any
 	// EventTarget.
+	//
+	// Experimental: may be changed or removed without notice.
 	TargetName 	string	`json:"targetName,omitempty"`
 }
 
@@ -120,7 +139,11 @@ type SetEventListenerBreakpointResult struct {
 
 }
 
+var SetEventListenerBreakpointCommand = cdp.Command[SetEventListenerBreakpointParams, SetEventListenerBreakpointResult]{Method: SetEventListenerBreakpoint}
+
 // Sets breakpoint on particular native event.
+//
+// Experimental: may be changed or removed without notice.
 const SetInstrumentationBreakpoint = "DOMDebugger.setInstrumentationBreakpoint"
 
 type SetInstrumentationBreakpointParams struct {
@@ -133,6 +156,8 @@ type SetInstrumentationBreakpointResult struct {
 
 }
 
+var SetInstrumentationBreakpointCommand = cdp.Command[SetInstrumentationBreakpointParams, SetInstrumentationBreakpointResult]{Method: SetInstrumentationBreakpoint}
+
 // Sets breakpoint on XMLHttpRequest.
 const SetXHRBreakpoint = "DOMDebugger.setXHRBreakpoint"
 
@@ -144,4 +169,6 @@ type SetXHRBreakpointParams struct {
 
 type SetXHRBreakpointResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetXHRBreakpointCommand = cdp.Command[SetXHRBreakpointParams, SetXHRBreakpointResult]{Method: SetXHRBreakpoint}
\ No newline at end of file
@@ -1,8 +1,12 @@
+// Package domdebugger implements the DOMDebugger domain of the DevTools protocol.
+//
+// DOM debugging allows setting breakpoints on particular DOM operations and events. JavaScript
+// execution will stop on these operations as if there was a regular breakpoint set.
 package domdebugger
 
 import (
-	"github.com/diiyw/cuto/protocol/runtime"
 	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/runtime"
 )
 
 // DOM breakpoint type.
@@ -1,5 +1,10 @@
 package domsnapshot
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Disables DOM snapshot agent for the given page.
 const Disable = "DOMSnapshot.disable"
 
@@ -10,6 +15,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables DOM snapshot agent for the given page.
 const Enable = "DOMSnapshot.enable"
 
@@ -20,10 +27,14 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Returns a document snapshot, including the full DOM tree of the root node (including iframes,
 // template contents, and imported documents) in a flattened array, as well as layout and
 // white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is
 // flattened.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const GetSnapshot = "DOMSnapshot.getSnapshot"
 
 type GetSnapshotParams struct {
@@ -51,6 +62,8 @@ type GetSnapshotResult struct {
 	ComputedStyles 	[]*ComputedStyle	`json:"computedStyles"`
 }
 
+var GetSnapshotCommand = cdp.Command[GetSnapshotParams, GetSnapshotResult]{Method: GetSnapshot}
+
 // Returns a document snapshot, including the full DOM tree of the root node (including iframes,
 // template contents, and imported documents) in a flattened array, as well as layout and
 // white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is
@@ -75,4 +88,6 @@ type CaptureSnapshotResult struct {
 	Documents 	[]*DocumentSnapshot	`json:"documents"`
 	// Shared string table that all string properties refer to with indexes.
 	Strings 	[]string	`json:"strings"`
-}
\ No newline at end of file
+}
+
+var CaptureSnapshotCommand = cdp.Command[CaptureSnapshotParams, CaptureSnapshotResult]{Method: CaptureSnapshot}
\ No newline at end of file
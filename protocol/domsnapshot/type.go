@@ -1,8 +1,13 @@
+// Package domsnapshot implements the DOMSnapshot domain of the DevTools protocol.
+//
+// This domain facilitates obtaining document snapshots with DOM, layout, and style information.
+//
+// Experimental: may be changed or removed without notice.
 package domsnapshot
 
 import (
-	"github.com/diiyw/cuto/protocol/dom"
 	"github.com/diiyw/cuto/protocol/cdp"
+	"github.com/diiyw/cuto/protocol/dom"
 	"github.com/diiyw/cuto/protocol/domdebugger"
 )
 
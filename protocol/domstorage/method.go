@@ -1,5 +1,10 @@
 package domstorage
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // 
 const Clear = "DOMStorage.clear"
 
@@ -13,6 +18,8 @@ type ClearResult struct {
 
 }
 
+var ClearCommand = cdp.Command[ClearParams, ClearResult]{Method: Clear}
+
 // Disables storage tracking, prevents storage events from being sent to the client.
 const Disable = "DOMStorage.disable"
 
@@ -23,6 +30,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables storage tracking, storage events will now be delivered to the client.
 const Enable = "DOMStorage.enable"
 
@@ -33,6 +42,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // 
 const GetDOMStorageItems = "DOMStorage.getDOMStorageItems"
 
@@ -48,6 +59,8 @@ type GetDOMStorageItemsResult struct {
 	Entries 	[]*Item	`json:"entries"`
 }
 
+var GetDOMStorageItemsCommand = cdp.Command[GetDOMStorageItemsParams, GetDOMStorageItemsResult]{Method: GetDOMStorageItems}
+
 // 
 const RemoveDOMStorageItem = "DOMStorage.removeDOMStorageItem"
 
@@ -64,6 +77,8 @@ type RemoveDOMStorageItemResult struct {
 
 }
 
+var RemoveDOMStorageItemCommand = cdp.Command[RemoveDOMStorageItemParams, RemoveDOMStorageItemResult]{Method: RemoveDOMStorageItem}
+
 // 
 const SetDOMStorageItem = "DOMStorage.setDOMStorageItem"
 
@@ -81,4 +96,6 @@ type SetDOMStorageItemParams struct {
 
 type SetDOMStorageItemResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetDOMStorageItemCommand = cdp.Command[SetDOMStorageItemParams, SetDOMStorageItemResult]{Method: SetDOMStorageItem}
\ No newline at end of file
@@ -1,3 +1,8 @@
+// Package domstorage implements the DOMStorage domain of the DevTools protocol.
+//
+// Query and modify DOM storage.
+//
+// Experimental: may be changed or removed without notice.
 package domstorage
 // DOM Storage identifier.
 type StorageId  struct {
@@ -1,6 +1,8 @@
 package emulation
 
 // Notification sent after the virtual time budget for the current VirtualTimePolicy has run out.
+//
+// Experimental: may be changed or removed without notice.
 const VirtualTimeBudgetExpiredEvent = "Emulation.virtualTimeBudgetExpired"
 type VirtualTimeBudgetExpiredParams struct {
 }
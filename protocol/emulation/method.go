@@ -17,6 +17,8 @@ type CanEmulateResult struct {
 	Result 	bool	`json:"result"`
 }
 
+var CanEmulateCommand = cdp.Command[CanEmulateParams, CanEmulateResult]{Method: CanEmulate}
+
 // Clears the overriden device metrics.
 const ClearDeviceMetricsOverride = "Emulation.clearDeviceMetricsOverride"
 
@@ -27,6 +29,8 @@ type ClearDeviceMetricsOverrideResult struct {
 
 }
 
+var ClearDeviceMetricsOverrideCommand = cdp.Command[ClearDeviceMetricsOverrideParams, ClearDeviceMetricsOverrideResult]{Method: ClearDeviceMetricsOverride}
+
 // Clears the overriden Geolocation Position and Error.
 const ClearGeolocationOverride = "Emulation.clearGeolocationOverride"
 
@@ -37,7 +41,11 @@ type ClearGeolocationOverrideResult struct {
 
 }
 
+var ClearGeolocationOverrideCommand = cdp.Command[ClearGeolocationOverrideParams, ClearGeolocationOverrideResult]{Method: ClearGeolocationOverride}
+
 // Requests that page scale factor is reset to initial values.
+//
+// Experimental: may be changed or removed without notice.
 const ResetPageScaleFactor = "Emulation.resetPageScaleFactor"
 
 type ResetPageScaleFactorParams struct {
@@ -47,7 +55,11 @@ type ResetPageScaleFactorResult struct {
 
 }
 
+var ResetPageScaleFactorCommand = cdp.Command[ResetPageScaleFactorParams, ResetPageScaleFactorResult]{Method: ResetPageScaleFactor}
+
 // Enables or disables simulating a focused and active page.
+//
+// Experimental: may be changed or removed without notice.
 const SetFocusEmulationEnabled = "Emulation.setFocusEmulationEnabled"
 
 type SetFocusEmulationEnabledParams struct {
@@ -60,7 +72,11 @@ type SetFocusEmulationEnabledResult struct {
 
 }
 
+var SetFocusEmulationEnabledCommand = cdp.Command[SetFocusEmulationEnabledParams, SetFocusEmulationEnabledResult]{Method: SetFocusEmulationEnabled}
+
 // Enables CPU throttling to emulate slow CPUs.
+//
+// Experimental: may be changed or removed without notice.
 const SetCPUThrottlingRate = "Emulation.setCPUThrottlingRate"
 
 type SetCPUThrottlingRateParams struct {
@@ -73,6 +89,8 @@ type SetCPUThrottlingRateResult struct {
 
 }
 
+var SetCPUThrottlingRateCommand = cdp.Command[SetCPUThrottlingRateParams, SetCPUThrottlingRateResult]{Method: SetCPUThrottlingRate}
+
 // Sets or clears an override of the default background color of the frame. This override is used
 // if the content does not specify one.
 const SetDefaultBackgroundColorOverride = "Emulation.setDefaultBackgroundColorOverride"
@@ -88,6 +106,8 @@ type SetDefaultBackgroundColorOverrideResult struct {
 
 }
 
+var SetDefaultBackgroundColorOverrideCommand = cdp.Command[SetDefaultBackgroundColorOverrideParams, SetDefaultBackgroundColorOverrideResult]{Method: SetDefaultBackgroundColorOverride}
+
 // Overrides the values of device screen dimensions (window.screen.width, window.screen.height,
 // window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media
 // query results).
@@ -109,21 +129,33 @@ type SetDeviceMetricsOverrideParams struct {
 	Mobile 	bool	`json:"mobile"`
 
 	// Scale to apply to resulting view image.
+	//
+	// Experimental: may be changed or removed without notice.
 	Scale 	float64	`json:"scale,omitempty"`
 
 	// Overriding screen width value in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental: may be changed or removed without notice.
 	ScreenWidth 	int	`json:"screenWidth,omitempty"`
 
 	// Overriding screen height value in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental: may be changed or removed without notice.
 	ScreenHeight 	int	`json:"screenHeight,omitempty"`
 
 	// Overriding view X position on screen in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental: may be changed or removed without notice.
 	PositionX 	int	`json:"positionX,omitempty"`
 
 	// Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental: may be changed or removed without notice.
 	PositionY 	int	`json:"positionY,omitempty"`
 
 	// Do not set visible view size, rely upon explicit setVisibleSize call.
+	//
+	// Experimental: may be changed or removed without notice.
 	DontSetVisibleSize 	bool	`json:"dontSetVisibleSize,omitempty"`
 
 	// Screen orientation override.
@@ -131,6 +163,8 @@ type SetDeviceMetricsOverrideParams struct {
 
 	// If set, the visible area of the page will be overridden to this viewport. This viewport
 	// change is not observed by the page, e.g. viewport-relative elements do not change positions.
+	//
+	// Experimental: may be changed or removed without notice.
 	Viewport 	cdp.Viewport	`json:"viewport,omitempty"`
 }
 
@@ -138,7 +172,11 @@ type SetDeviceMetricsOverrideResult struct {
 
 }
 
+var SetDeviceMetricsOverrideCommand = cdp.Command[SetDeviceMetricsOverrideParams, SetDeviceMetricsOverrideResult]{Method: SetDeviceMetricsOverride}
+
 // 
+//
+// Experimental: may be changed or removed without notice.
 const SetScrollbarsHidden = "Emulation.setScrollbarsHidden"
 
 type SetScrollbarsHiddenParams struct {
@@ -151,7 +189,11 @@ type SetScrollbarsHiddenResult struct {
 
 }
 
+var SetScrollbarsHiddenCommand = cdp.Command[SetScrollbarsHiddenParams, SetScrollbarsHiddenResult]{Method: SetScrollbarsHidden}
+
 // 
+//
+// Experimental: may be changed or removed without notice.
 const SetDocumentCookieDisabled = "Emulation.setDocumentCookieDisabled"
 
 type SetDocumentCookieDisabledParams struct {
@@ -164,7 +206,11 @@ type SetDocumentCookieDisabledResult struct {
 
 }
 
+var SetDocumentCookieDisabledCommand = cdp.Command[SetDocumentCookieDisabledParams, SetDocumentCookieDisabledResult]{Method: SetDocumentCookieDisabled}
+
 // 
+//
+// Experimental: may be changed or removed without notice.
 const SetEmitTouchEventsForMouse = "Emulation.setEmitTouchEventsForMouse"
 
 type SetEmitTouchEventsForMouseParams struct {
@@ -180,6 +226,8 @@ type SetEmitTouchEventsForMouseResult struct {
 
 }
 
+var SetEmitTouchEventsForMouseCommand = cdp.Command[SetEmitTouchEventsForMouseParams, SetEmitTouchEventsForMouseResult]{Method: SetEmitTouchEventsForMouse}
+
 // Emulates the given media type or media feature for CSS media queries.
 const SetEmulatedMedia = "Emulation.setEmulatedMedia"
 
@@ -196,6 +244,8 @@ type SetEmulatedMediaResult struct {
 
 }
 
+var SetEmulatedMediaCommand = cdp.Command[SetEmulatedMediaParams, SetEmulatedMediaResult]{Method: SetEmulatedMedia}
+
 // Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position
 // unavailable.
 const SetGeolocationOverride = "Emulation.setGeolocationOverride"
@@ -216,7 +266,13 @@ type SetGeolocationOverrideResult struct {
 
 }
 
+var SetGeolocationOverrideCommand = cdp.Command[SetGeolocationOverrideParams, SetGeolocationOverrideResult]{Method: SetGeolocationOverride}
+
 // Overrides value returned by the javascript navigator object.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const SetNavigatorOverrides = "Emulation.setNavigatorOverrides"
 
 type SetNavigatorOverridesParams struct {
@@ -229,7 +285,11 @@ type SetNavigatorOverridesResult struct {
 
 }
 
+var SetNavigatorOverridesCommand = cdp.Command[SetNavigatorOverridesParams, SetNavigatorOverridesResult]{Method: SetNavigatorOverrides}
+
 // Sets a specified page scale factor.
+//
+// Experimental: may be changed or removed without notice.
 const SetPageScaleFactor = "Emulation.setPageScaleFactor"
 
 type SetPageScaleFactorParams struct {
@@ -242,6 +302,8 @@ type SetPageScaleFactorResult struct {
 
 }
 
+var SetPageScaleFactorCommand = cdp.Command[SetPageScaleFactorParams, SetPageScaleFactorResult]{Method: SetPageScaleFactor}
+
 // Switches script execution in the page.
 const SetScriptExecutionDisabled = "Emulation.setScriptExecutionDisabled"
 
@@ -255,6 +317,8 @@ type SetScriptExecutionDisabledResult struct {
 
 }
 
+var SetScriptExecutionDisabledCommand = cdp.Command[SetScriptExecutionDisabledParams, SetScriptExecutionDisabledResult]{Method: SetScriptExecutionDisabled}
+
 // Enables touch on platforms which do not support them.
 const SetTouchEmulationEnabled = "Emulation.setTouchEmulationEnabled"
 
@@ -271,8 +335,12 @@ type SetTouchEmulationEnabledResult struct {
 
 }
 
+var SetTouchEmulationEnabledCommand = cdp.Command[SetTouchEmulationEnabledParams, SetTouchEmulationEnabledResult]{Method: SetTouchEmulationEnabled}
+
 // Turns on virtual time for all frames (replacing real-time with a synthetic time source) and sets
 // the current virtual time policy.  Note this supersedes any previous time budget.
+//
+// Experimental: may be changed or removed without notice.
 const SetVirtualTimePolicy = "Emulation.setVirtualTimePolicy"
 
 type SetVirtualTimePolicyParams struct {
@@ -302,7 +370,11 @@ type SetVirtualTimePolicyResult struct {
 	VirtualTimeTicksBase 	float64	`json:"virtualTimeTicksBase"`
 }
 
+var SetVirtualTimePolicyCommand = cdp.Command[SetVirtualTimePolicyParams, SetVirtualTimePolicyResult]{Method: SetVirtualTimePolicy}
+
 // Overrides default host system timezone with the specified one.
+//
+// Experimental: may be changed or removed without notice.
 const SetTimezoneOverride = "Emulation.setTimezoneOverride"
 
 type SetTimezoneOverrideParams struct {
@@ -316,9 +388,15 @@ type SetTimezoneOverrideResult struct {
 
 }
 
+var SetTimezoneOverrideCommand = cdp.Command[SetTimezoneOverrideParams, SetTimezoneOverrideResult]{Method: SetTimezoneOverride}
+
 // Resizes the frame/viewport of the page. Note that this does not affect the frame's container
 // (e.g. browser window). Can be used to produce screenshots of the specified size. Not supported
 // on Android.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const SetVisibleSize = "Emulation.setVisibleSize"
 
 type SetVisibleSizeParams struct {
@@ -334,6 +412,8 @@ type SetVisibleSizeResult struct {
 
 }
 
+var SetVisibleSizeCommand = cdp.Command[SetVisibleSizeParams, SetVisibleSizeResult]{Method: SetVisibleSize}
+
 // Allows overriding user agent with the given string.
 const SetUserAgentOverride = "Emulation.setUserAgentOverride"
 
@@ -351,4 +431,6 @@ type SetUserAgentOverrideParams struct {
 
 type SetUserAgentOverrideResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetUserAgentOverrideCommand = cdp.Command[SetUserAgentOverrideParams, SetUserAgentOverrideResult]{Method: SetUserAgentOverride}
\ No newline at end of file
@@ -1,3 +1,6 @@
+// Package emulation implements the Emulation domain of the DevTools protocol.
+//
+// This domain emulates different environments for the page.
 package emulation
 // Screen orientation.
 type ScreenOrientation  struct {
@@ -23,4 +26,6 @@ type MediaFeature  struct {
 	// allow the next delayed task (if any) to run; pause: The virtual time base may not advance;
 	// pauseIfNetworkFetchesPending: The virtual time base may not advance if there are any pending
 	// resource fetches.
+//
+// Experimental: may be changed or removed without notice.
 type VirtualTimePolicy string
@@ -1,8 +1,8 @@
 package fetch
 
 import (
-	"github.com/diiyw/cuto/protocol/network"
 	"github.com/diiyw/cuto/protocol/cdp"
+	"github.com/diiyw/cuto/protocol/network"
 )
 
 
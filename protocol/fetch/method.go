@@ -1,8 +1,9 @@
 package fetch
 
 import (
-	"github.com/diiyw/cuto/protocol/network"
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/io"
+	"github.com/diiyw/cuto/protocol/network"
 )
 
 
@@ -16,6 +17,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables issuing of requestPaused events. A request will be paused until client
 // calls one of failRequest, fulfillRequest or continueRequest/continueWithAuth.
 const Enable = "Fetch.enable"
@@ -36,6 +39,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Causes the request to fail with specified reason.
 const FailRequest = "Fetch.failRequest"
 
@@ -52,6 +57,8 @@ type FailRequestResult struct {
 
 }
 
+var FailRequestCommand = cdp.Command[FailRequestParams, FailRequestResult]{Method: FailRequest}
+
 // Provides response to the request.
 const FulfillRequest = "Fetch.fulfillRequest"
 
@@ -84,6 +91,8 @@ type FulfillRequestResult struct {
 
 }
 
+var FulfillRequestCommand = cdp.Command[FulfillRequestParams, FulfillRequestResult]{Method: FulfillRequest}
+
 // Continues the request, optionally modifying some of its parameters.
 const ContinueRequest = "Fetch.continueRequest"
 
@@ -109,6 +118,8 @@ type ContinueRequestResult struct {
 
 }
 
+var ContinueRequestCommand = cdp.Command[ContinueRequestParams, ContinueRequestResult]{Method: ContinueRequest}
+
 // Continues a request supplying authChallengeResponse following authRequired event.
 const ContinueWithAuth = "Fetch.continueWithAuth"
 
@@ -125,6 +136,8 @@ type ContinueWithAuthResult struct {
 
 }
 
+var ContinueWithAuthCommand = cdp.Command[ContinueWithAuthParams, ContinueWithAuthResult]{Method: ContinueWithAuth}
+
 // Causes the body of the response to be received from the server and
 // returned as a single string. May only be issued for a request that
 // is paused in the Response stage and is mutually exclusive with
@@ -147,6 +160,8 @@ type GetResponseBodyResult struct {
 	Base64Encoded 	bool	`json:"base64Encoded"`
 }
 
+var GetResponseBodyCommand = cdp.Command[GetResponseBodyParams, GetResponseBodyResult]{Method: GetResponseBody}
+
 // Returns a handle to the stream representing the response body.
 // The request must be paused in the HeadersReceived stage.
 // Note that after this command the request can't be continued
@@ -169,4 +184,6 @@ type TakeResponseBodyAsStreamResult struct {
 
 	// 
 	Stream 	io.StreamHandle	`json:"stream"`
-}
\ No newline at end of file
+}
+
+var TakeResponseBodyAsStreamCommand = cdp.Command[TakeResponseBodyAsStreamParams, TakeResponseBodyAsStreamResult]{Method: TakeResponseBodyAsStream}
\ No newline at end of file
@@ -1,3 +1,8 @@
+// Package fetch implements the Fetch domain of the DevTools protocol.
+//
+// A domain for letting clients substitute browser's network layer with client code.
+//
+// Experimental: may be changed or removed without notice.
 package fetch
 
 import (
@@ -10,9 +15,13 @@ type RequestId string
 // Stages of the request to handle. Request will intercept before the request is
 	// sent. Response will intercept after the response is received (but before response
 	// body is received.
+//
+// Experimental: may be changed or removed without notice.
 type RequestStage string
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type RequestPattern  struct {
 
 	// Wildcards ('*' -> zero or more, '?' -> exactly one) are allowed. Escape character is
@@ -37,6 +46,8 @@ type HeaderEntry  struct {
 }
 
 // Authorization challenge for HTTP status code 401 or 407.
+//
+// Experimental: may be changed or removed without notice.
 type AuthChallenge  struct {
 
 	// Source of the authentication challenge.
@@ -53,6 +64,8 @@ type AuthChallenge  struct {
 }
 
 // Response to an AuthChallenge.
+//
+// Experimental: may be changed or removed without notice.
 type AuthChallengeResponse  struct {
 
 	// The decision on what to do in response to the authorization challenge.  Default means
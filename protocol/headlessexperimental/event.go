@@ -3,6 +3,8 @@ package headlessexperimental
 // Issued when the target starts or stops needing BeginFrames.
 // Deprecated. Issue beginFrame unconditionally instead and use result from
 // beginFrame to detect whether the frames were suppressed.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const NeedsBeginFramesChangedEvent = "HeadlessExperimental.needsBeginFramesChanged"
 type NeedsBeginFramesChangedParams struct {
 
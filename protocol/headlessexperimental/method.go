@@ -1,5 +1,10 @@
 package headlessexperimental
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Sends a BeginFrame to the target and returns when the frame was completed. Optionally captures a
 // screenshot from the resulting frame. Requires that the target was created with enabled
 // BeginFrameControl. Designed for use with --run-all-compositor-stages-before-draw, see also
@@ -36,6 +41,8 @@ type BeginFrameResult struct {
 	ScreenshotData 	[]byte	`json:"screenshotData"`
 }
 
+var BeginFrameCommand = cdp.Command[BeginFrameParams, BeginFrameResult]{Method: BeginFrame}
+
 // Disables headless events for the target.
 const Disable = "HeadlessExperimental.disable"
 
@@ -46,6 +53,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables headless events for the target.
 const Enable = "HeadlessExperimental.enable"
 
@@ -54,4 +63,6 @@ type EnableParams struct {
 
 type EnableResult struct {
 
-}
\ No newline at end of file
+}
+
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
\ No newline at end of file
@@ -1,3 +1,8 @@
+// Package headlessexperimental implements the HeadlessExperimental domain of the DevTools protocol.
+//
+// This domain provides experimental commands only supported in headless mode.
+//
+// Experimental: may be changed or removed without notice.
 package headlessexperimental
 // Encoding options for a screenshot.
 type ScreenshotParams  struct {
@@ -1,6 +1,7 @@
 package heapprofiler
 
 import (
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/runtime"
 )
 
@@ -19,6 +20,8 @@ type AddInspectedHeapObjectResult struct {
 
 }
 
+var AddInspectedHeapObjectCommand = cdp.Command[AddInspectedHeapObjectParams, AddInspectedHeapObjectResult]{Method: AddInspectedHeapObject}
+
 // 
 const CollectGarbage = "HeapProfiler.collectGarbage"
 
@@ -29,6 +32,8 @@ type CollectGarbageResult struct {
 
 }
 
+var CollectGarbageCommand = cdp.Command[CollectGarbageParams, CollectGarbageResult]{Method: CollectGarbage}
+
 // 
 const Disable = "HeapProfiler.disable"
 
@@ -39,6 +44,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // 
 const Enable = "HeapProfiler.enable"
 
@@ -49,6 +56,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // 
 const GetHeapObjectId = "HeapProfiler.getHeapObjectId"
 
@@ -64,6 +73,8 @@ type GetHeapObjectIdResult struct {
 	HeapSnapshotObjectId 	HeapSnapshotObjectId	`json:"heapSnapshotObjectId"`
 }
 
+var GetHeapObjectIdCommand = cdp.Command[GetHeapObjectIdParams, GetHeapObjectIdResult]{Method: GetHeapObjectId}
+
 // 
 const GetObjectByHeapObjectId = "HeapProfiler.getObjectByHeapObjectId"
 
@@ -82,6 +93,8 @@ type GetObjectByHeapObjectIdResult struct {
 	Result 	runtime.RemoteObject	`json:"result"`
 }
 
+var GetObjectByHeapObjectIdCommand = cdp.Command[GetObjectByHeapObjectIdParams, GetObjectByHeapObjectIdResult]{Method: GetObjectByHeapObjectId}
+
 // 
 const GetSamplingProfile = "HeapProfiler.getSamplingProfile"
 
@@ -94,6 +107,8 @@ type GetSamplingProfileResult struct {
 	Profile 	SamplingHeapProfile	`json:"profile"`
 }
 
+var GetSamplingProfileCommand = cdp.Command[GetSamplingProfileParams, GetSamplingProfileResult]{Method: GetSamplingProfile}
+
 // 
 const StartSampling = "HeapProfiler.startSampling"
 
@@ -108,6 +123,8 @@ type StartSamplingResult struct {
 
 }
 
+var StartSamplingCommand = cdp.Command[StartSamplingParams, StartSamplingResult]{Method: StartSampling}
+
 // 
 const StartTrackingHeapObjects = "HeapProfiler.startTrackingHeapObjects"
 
@@ -121,6 +138,8 @@ type StartTrackingHeapObjectsResult struct {
 
 }
 
+var StartTrackingHeapObjectsCommand = cdp.Command[StartTrackingHeapObjectsParams, StartTrackingHeapObjectsResult]{Method: StartTrackingHeapObjects}
+
 // 
 const StopSampling = "HeapProfiler.stopSampling"
 
@@ -133,6 +152,8 @@ type StopSamplingResult struct {
 	Profile 	SamplingHeapProfile	`json:"profile"`
 }
 
+var StopSamplingCommand = cdp.Command[StopSamplingParams, StopSamplingResult]{Method: StopSampling}
+
 // 
 const StopTrackingHeapObjects = "HeapProfiler.stopTrackingHeapObjects"
 
@@ -147,6 +168,8 @@ type StopTrackingHeapObjectsResult struct {
 
 }
 
+var StopTrackingHeapObjectsCommand = cdp.Command[StopTrackingHeapObjectsParams, StopTrackingHeapObjectsResult]{Method: StopTrackingHeapObjects}
+
 // 
 const TakeHeapSnapshot = "HeapProfiler.takeHeapSnapshot"
 
@@ -158,4 +181,6 @@ type TakeHeapSnapshotParams struct {
 
 type TakeHeapSnapshotResult struct {
 
-}
\ No newline at end of file
+}
+
+var TakeHeapSnapshotCommand = cdp.Command[TakeHeapSnapshotParams, TakeHeapSnapshotResult]{Method: TakeHeapSnapshot}
\ No newline at end of file
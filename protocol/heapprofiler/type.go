@@ -1,3 +1,6 @@
+// Package heapprofiler implements the HeapProfiler domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package heapprofiler
 
 import (
@@ -1,5 +1,10 @@
 package indexeddb
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Clears all entries from an object store.
 const ClearObjectStore = "IndexedDB.clearObjectStore"
 
@@ -19,6 +24,8 @@ type ClearObjectStoreResult struct {
 
 }
 
+var ClearObjectStoreCommand = cdp.Command[ClearObjectStoreParams, ClearObjectStoreResult]{Method: ClearObjectStore}
+
 // Deletes a database.
 const DeleteDatabase = "IndexedDB.deleteDatabase"
 
@@ -35,6 +42,8 @@ type DeleteDatabaseResult struct {
 
 }
 
+var DeleteDatabaseCommand = cdp.Command[DeleteDatabaseParams, DeleteDatabaseResult]{Method: DeleteDatabase}
+
 // Delete a range of entries from an object store
 const DeleteObjectStoreEntries = "IndexedDB.deleteObjectStoreEntries"
 
@@ -57,6 +66,8 @@ type DeleteObjectStoreEntriesResult struct {
 
 }
 
+var DeleteObjectStoreEntriesCommand = cdp.Command[DeleteObjectStoreEntriesParams, DeleteObjectStoreEntriesResult]{Method: DeleteObjectStoreEntries}
+
 // Disables events from backend.
 const Disable = "IndexedDB.disable"
 
@@ -67,6 +78,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables events from backend.
 const Enable = "IndexedDB.enable"
 
@@ -77,6 +90,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Requests data from object store or index.
 const RequestData = "IndexedDB.requestData"
 
@@ -112,6 +127,8 @@ type RequestDataResult struct {
 	HasMore 	bool	`json:"hasMore"`
 }
 
+var RequestDataCommand = cdp.Command[RequestDataParams, RequestDataResult]{Method: RequestData}
+
 // Gets metadata of an object store
 const GetMetadata = "IndexedDB.getMetadata"
 
@@ -137,6 +154,8 @@ type GetMetadataResult struct {
 	KeyGeneratorValue 	float64	`json:"keyGeneratorValue"`
 }
 
+var GetMetadataCommand = cdp.Command[GetMetadataParams, GetMetadataResult]{Method: GetMetadata}
+
 // Requests database with given name in given frame.
 const RequestDatabase = "IndexedDB.requestDatabase"
 
@@ -155,6 +174,8 @@ type RequestDatabaseResult struct {
 	DatabaseWithObjectStores 	DatabaseWithObjectStores	`json:"databaseWithObjectStores"`
 }
 
+var RequestDatabaseCommand = cdp.Command[RequestDatabaseParams, RequestDatabaseResult]{Method: RequestDatabase}
+
 // Requests database names for given security origin.
 const RequestDatabaseNames = "IndexedDB.requestDatabaseNames"
 
@@ -168,4 +189,6 @@ type RequestDatabaseNamesResult struct {
 
 	// Database names for origin.
 	DatabaseNames 	[]string	`json:"databaseNames"`
-}
\ No newline at end of file
+}
+
+var RequestDatabaseNamesCommand = cdp.Command[RequestDatabaseNamesParams, RequestDatabaseNamesResult]{Method: RequestDatabaseNames}
\ No newline at end of file
@@ -1,3 +1,6 @@
+// Package indexeddb implements the IndexedDB domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package indexeddb
 
 import (
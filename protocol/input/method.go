@@ -1,5 +1,10 @@
 package input
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Dispatches a key event to the page.
 const DispatchKeyEvent = "Input.dispatchKeyEvent"
 
@@ -57,8 +62,12 @@ type DispatchKeyEventResult struct {
 
 }
 
+var DispatchKeyEventCommand = cdp.Command[DispatchKeyEventParams, DispatchKeyEventResult]{Method: DispatchKeyEvent}
+
 // This method emulates inserting text that doesn't come from a key press,
 // for example an emoji keyboard or an IME.
+//
+// Experimental: may be changed or removed without notice.
 const InsertText = "Input.insertText"
 
 type InsertTextParams struct {
@@ -71,6 +80,8 @@ type InsertTextResult struct {
 
 }
 
+var InsertTextCommand = cdp.Command[InsertTextParams, InsertTextResult]{Method: InsertText}
+
 // Dispatches a mouse event to the page.
 const DispatchMouseEvent = "Input.dispatchMouseEvent"
 
@@ -117,6 +128,8 @@ type DispatchMouseEventResult struct {
 
 }
 
+var DispatchMouseEventCommand = cdp.Command[DispatchMouseEventParams, DispatchMouseEventResult]{Method: DispatchMouseEvent}
+
 // Dispatches a touch event to the page.
 const DispatchTouchEvent = "Input.dispatchTouchEvent"
 
@@ -143,7 +156,11 @@ type DispatchTouchEventResult struct {
 
 }
 
+var DispatchTouchEventCommand = cdp.Command[DispatchTouchEventParams, DispatchTouchEventResult]{Method: DispatchTouchEvent}
+
 // Emulates touch event from the mouse event parameters.
+//
+// Experimental: may be changed or removed without notice.
 const EmulateTouchFromMouseEvent = "Input.emulateTouchFromMouseEvent"
 
 type EmulateTouchFromMouseEventParams struct {
@@ -181,6 +198,8 @@ type EmulateTouchFromMouseEventResult struct {
 
 }
 
+var EmulateTouchFromMouseEventCommand = cdp.Command[EmulateTouchFromMouseEventParams, EmulateTouchFromMouseEventResult]{Method: EmulateTouchFromMouseEvent}
+
 // Ignores input events (useful while auditing page).
 const SetIgnoreInputEvents = "Input.setIgnoreInputEvents"
 
@@ -194,7 +213,11 @@ type SetIgnoreInputEventsResult struct {
 
 }
 
+var SetIgnoreInputEventsCommand = cdp.Command[SetIgnoreInputEventsParams, SetIgnoreInputEventsResult]{Method: SetIgnoreInputEvents}
+
 // Synthesizes a pinch gesture over a time period by issuing appropriate touch events.
+//
+// Experimental: may be changed or removed without notice.
 const SynthesizePinchGesture = "Input.synthesizePinchGesture"
 
 type SynthesizePinchGestureParams struct {
@@ -220,7 +243,11 @@ type SynthesizePinchGestureResult struct {
 
 }
 
+var SynthesizePinchGestureCommand = cdp.Command[SynthesizePinchGestureParams, SynthesizePinchGestureResult]{Method: SynthesizePinchGesture}
+
 // Synthesizes a scroll gesture over a time period by issuing appropriate touch events.
+//
+// Experimental: may be changed or removed without notice.
 const SynthesizeScrollGesture = "Input.synthesizeScrollGesture"
 
 type SynthesizeScrollGestureParams struct {
@@ -269,7 +296,11 @@ type SynthesizeScrollGestureResult struct {
 
 }
 
+var SynthesizeScrollGestureCommand = cdp.Command[SynthesizeScrollGestureParams, SynthesizeScrollGestureResult]{Method: SynthesizeScrollGesture}
+
 // Synthesizes a tap gesture over a time period by issuing appropriate touch events.
+//
+// Experimental: may be changed or removed without notice.
 const SynthesizeTapGesture = "Input.synthesizeTapGesture"
 
 type SynthesizeTapGestureParams struct {
@@ -293,4 +324,6 @@ type SynthesizeTapGestureParams struct {
 
 type SynthesizeTapGestureResult struct {
 
-}
\ No newline at end of file
+}
+
+var SynthesizeTapGestureCommand = cdp.Command[SynthesizeTapGestureParams, SynthesizeTapGestureResult]{Method: SynthesizeTapGesture}
\ No newline at end of file
@@ -1,3 +1,4 @@
+// Package input implements the Input domain of the DevTools protocol.
 package input
 // 
 type TouchPoint  struct {
@@ -26,6 +27,8 @@ type TouchPoint  struct {
 }
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type GestureSourceType string
 
 // UTC time in seconds, counted from January 1, 1970.
@@ -1,5 +1,10 @@
 package inspector
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Disables inspector domain notifications.
 const Disable = "Inspector.disable"
 
@@ -10,6 +15,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables inspector domain notifications.
 const Enable = "Inspector.enable"
 
@@ -18,4 +25,6 @@ type EnableParams struct {
 
 type EnableResult struct {
 
-}
\ No newline at end of file
+}
+
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
\ No newline at end of file
@@ -1 +1,4 @@
+// Package inspector implements the Inspector domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package inspector
\ No newline at end of file
@@ -1,6 +1,7 @@
 package io
 
 import (
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/runtime"
 )
 
@@ -18,6 +19,8 @@ type CloseResult struct {
 
 }
 
+var CloseCommand = cdp.Command[CloseParams, CloseResult]{Method: Close}
+
 // Read a chunk of the stream
 const Read = "IO.read"
 
@@ -44,6 +47,8 @@ type ReadResult struct {
 	Eof 	bool	`json:"eof"`
 }
 
+var ReadCommand = cdp.Command[ReadParams, ReadResult]{Method: Read}
+
 // Return UUID of Blob object specified by a remote object id.
 const ResolveBlob = "IO.resolveBlob"
 
@@ -57,4 +62,6 @@ type ResolveBlobResult struct {
 
 	// UUID of the specified Blob.
 	Uuid 	string	`json:"uuid"`
-}
\ No newline at end of file
+}
+
+var ResolveBlobCommand = cdp.Command[ResolveBlobParams, ResolveBlobResult]{Method: ResolveBlob}
\ No newline at end of file
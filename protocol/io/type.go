@@ -1,3 +1,6 @@
+// Package io implements the IO domain of the DevTools protocol.
+//
+// Input/Output operations for streams produced by DevTools.
 package io
 // This is either obtained from another method or specifed as `blob:&lt;uuid&gt;` where
 	// `&lt;uuid&gt` is an UUID of a Blob.
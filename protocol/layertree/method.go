@@ -20,6 +20,8 @@ type CompositingReasonsResult struct {
 	CompositingReasons 	[]string	`json:"compositingReasons"`
 }
 
+var CompositingReasonsCommand = cdp.Command[CompositingReasonsParams, CompositingReasonsResult]{Method: CompositingReasons}
+
 // Disables compositing tree inspection.
 const Disable = "LayerTree.disable"
 
@@ -30,6 +32,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables compositing tree inspection.
 const Enable = "LayerTree.enable"
 
@@ -40,6 +44,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Returns the snapshot identifier.
 const LoadSnapshot = "LayerTree.loadSnapshot"
 
@@ -55,6 +61,8 @@ type LoadSnapshotResult struct {
 	SnapshotId 	SnapshotId	`json:"snapshotId"`
 }
 
+var LoadSnapshotCommand = cdp.Command[LoadSnapshotParams, LoadSnapshotResult]{Method: LoadSnapshot}
+
 // Returns the layer snapshot identifier.
 const MakeSnapshot = "LayerTree.makeSnapshot"
 
@@ -70,6 +78,8 @@ type MakeSnapshotResult struct {
 	SnapshotId 	SnapshotId	`json:"snapshotId"`
 }
 
+var MakeSnapshotCommand = cdp.Command[MakeSnapshotParams, MakeSnapshotResult]{Method: MakeSnapshot}
+
 // 
 const ProfileSnapshot = "LayerTree.profileSnapshot"
 
@@ -94,6 +104,8 @@ type ProfileSnapshotResult struct {
 	Timings 	[]*PaintProfile	`json:"timings"`
 }
 
+var ProfileSnapshotCommand = cdp.Command[ProfileSnapshotParams, ProfileSnapshotResult]{Method: ProfileSnapshot}
+
 // Releases layer snapshot captured by the back-end.
 const ReleaseSnapshot = "LayerTree.releaseSnapshot"
 
@@ -107,6 +119,8 @@ type ReleaseSnapshotResult struct {
 
 }
 
+var ReleaseSnapshotCommand = cdp.Command[ReleaseSnapshotParams, ReleaseSnapshotResult]{Method: ReleaseSnapshot}
+
 // Replays the layer snapshot and returns the resulting bitmap.
 const ReplaySnapshot = "LayerTree.replaySnapshot"
 
@@ -131,6 +145,8 @@ type ReplaySnapshotResult struct {
 	DataURL 	string	`json:"dataURL"`
 }
 
+var ReplaySnapshotCommand = cdp.Command[ReplaySnapshotParams, ReplaySnapshotResult]{Method: ReplaySnapshot}
+
 // Replays the layer snapshot and returns canvas log.
 const SnapshotCommandLog = "LayerTree.snapshotCommandLog"
 
@@ -144,4 +160,6 @@ type SnapshotCommandLogResult struct {
 
 	// The array of canvas function calls.
 	CommandLog 	[]interface{}	`json:"commandLog"`
-}
\ No newline at end of file
+}
+
+var SnapshotCommandLogCommand = cdp.Command[SnapshotCommandLogParams, SnapshotCommandLogResult]{Method: SnapshotCommandLog}
\ No newline at end of file
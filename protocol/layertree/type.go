@@ -1,3 +1,6 @@
+// Package layertree implements the LayerTree domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package layertree
 
 import (
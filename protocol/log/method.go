@@ -1,5 +1,10 @@
 package log
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Clears the log.
 const Clear = "Log.clear"
 
@@ -10,6 +15,8 @@ type ClearResult struct {
 
 }
 
+var ClearCommand = cdp.Command[ClearParams, ClearResult]{Method: Clear}
+
 // Disables log domain, prevents further log entries from being reported to the client.
 const Disable = "Log.disable"
 
@@ -20,6 +27,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables log domain, sends the entries collected so far to the client by means of the
 // `entryAdded` notification.
 const Enable = "Log.enable"
@@ -31,6 +40,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // start violation reporting.
 const StartViolationsReport = "Log.startViolationsReport"
 
@@ -44,6 +55,8 @@ type StartViolationsReportResult struct {
 
 }
 
+var StartViolationsReportCommand = cdp.Command[StartViolationsReportParams, StartViolationsReportResult]{Method: StartViolationsReport}
+
 // Stop violation reporting.
 const StopViolationsReport = "Log.stopViolationsReport"
 
@@ -52,4 +65,6 @@ type StopViolationsReportParams struct {
 
 type StopViolationsReportResult struct {
 
-}
\ No newline at end of file
+}
+
+var StopViolationsReportCommand = cdp.Command[StopViolationsReportParams, StopViolationsReportResult]{Method: StopViolationsReport}
\ No newline at end of file
@@ -1,8 +1,11 @@
+// Package log implements the Log domain of the DevTools protocol.
+//
+// Provides access to log entries.
 package log
 
 import (
-	"github.com/diiyw/cuto/protocol/runtime"
 	"github.com/diiyw/cuto/protocol/network"
+	"github.com/diiyw/cuto/protocol/runtime"
 )
 
 // Log entry.
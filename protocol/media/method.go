@@ -1,5 +1,10 @@
 package media
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Enables the Media domain
 const Enable = "Media.enable"
 
@@ -10,6 +15,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Disables the Media domain.
 const Disable = "Media.disable"
 
@@ -18,4 +25,6 @@ type DisableParams struct {
 
 type DisableResult struct {
 
-}
\ No newline at end of file
+}
+
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
\ No newline at end of file
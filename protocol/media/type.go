@@ -1,3 +1,8 @@
+// Package media implements the Media domain of the DevTools protocol.
+//
+// This domain allows detailed inspection of media elements
+//
+// Experimental: may be changed or removed without notice.
 package media
 // Players will get an ID that is unique within the agent context.
 type PlayerId string
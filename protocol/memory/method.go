@@ -1,5 +1,10 @@
 package memory
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // 
 const GetDOMCounters = "Memory.getDOMCounters"
 
@@ -16,6 +21,8 @@ type GetDOMCountersResult struct {
 	JsEventListeners 	int	`json:"jsEventListeners"`
 }
 
+var GetDOMCountersCommand = cdp.Command[GetDOMCountersParams, GetDOMCountersResult]{Method: GetDOMCounters}
+
 // 
 const PrepareForLeakDetection = "Memory.prepareForLeakDetection"
 
@@ -26,6 +33,8 @@ type PrepareForLeakDetectionResult struct {
 
 }
 
+var PrepareForLeakDetectionCommand = cdp.Command[PrepareForLeakDetectionParams, PrepareForLeakDetectionResult]{Method: PrepareForLeakDetection}
+
 // Simulate OomIntervention by purging V8 memory.
 const ForciblyPurgeJavaScriptMemory = "Memory.forciblyPurgeJavaScriptMemory"
 
@@ -36,6 +45,8 @@ type ForciblyPurgeJavaScriptMemoryResult struct {
 
 }
 
+var ForciblyPurgeJavaScriptMemoryCommand = cdp.Command[ForciblyPurgeJavaScriptMemoryParams, ForciblyPurgeJavaScriptMemoryResult]{Method: ForciblyPurgeJavaScriptMemory}
+
 // Enable/disable suppressing memory pressure notifications in all processes.
 const SetPressureNotificationsSuppressed = "Memory.setPressureNotificationsSuppressed"
 
@@ -49,6 +60,8 @@ type SetPressureNotificationsSuppressedResult struct {
 
 }
 
+var SetPressureNotificationsSuppressedCommand = cdp.Command[SetPressureNotificationsSuppressedParams, SetPressureNotificationsSuppressedResult]{Method: SetPressureNotificationsSuppressed}
+
 // Simulate a memory pressure notification in all processes.
 const SimulatePressureNotification = "Memory.simulatePressureNotification"
 
@@ -62,6 +75,8 @@ type SimulatePressureNotificationResult struct {
 
 }
 
+var SimulatePressureNotificationCommand = cdp.Command[SimulatePressureNotificationParams, SimulatePressureNotificationResult]{Method: SimulatePressureNotification}
+
 // Start collecting native memory profile.
 const StartSampling = "Memory.startSampling"
 
@@ -78,6 +93,8 @@ type StartSamplingResult struct {
 
 }
 
+var StartSamplingCommand = cdp.Command[StartSamplingParams, StartSamplingResult]{Method: StartSampling}
+
 // Stop collecting native memory profile.
 const StopSampling = "Memory.stopSampling"
 
@@ -88,6 +105,8 @@ type StopSamplingResult struct {
 
 }
 
+var StopSamplingCommand = cdp.Command[StopSamplingParams, StopSamplingResult]{Method: StopSampling}
+
 // Retrieve native memory allocations profile
 // collected since renderer process startup.
 const GetAllTimeSamplingProfile = "Memory.getAllTimeSamplingProfile"
@@ -101,6 +120,8 @@ type GetAllTimeSamplingProfileResult struct {
 	Profile 	SamplingProfile	`json:"profile"`
 }
 
+var GetAllTimeSamplingProfileCommand = cdp.Command[GetAllTimeSamplingProfileParams, GetAllTimeSamplingProfileResult]{Method: GetAllTimeSamplingProfile}
+
 // Retrieve native memory allocations profile
 // collected since browser process startup.
 const GetBrowserSamplingProfile = "Memory.getBrowserSamplingProfile"
@@ -114,6 +135,8 @@ type GetBrowserSamplingProfileResult struct {
 	Profile 	SamplingProfile	`json:"profile"`
 }
 
+var GetBrowserSamplingProfileCommand = cdp.Command[GetBrowserSamplingProfileParams, GetBrowserSamplingProfileResult]{Method: GetBrowserSamplingProfile}
+
 // Retrieve native memory allocations profile collected since last
 // `startSampling` call.
 const GetSamplingProfile = "Memory.getSamplingProfile"
@@ -125,4 +148,6 @@ type GetSamplingProfileResult struct {
 
 	// 
 	Profile 	SamplingProfile	`json:"profile"`
-}
\ No newline at end of file
+}
+
+var GetSamplingProfileCommand = cdp.Command[GetSamplingProfileParams, GetSamplingProfileResult]{Method: GetSamplingProfile}
\ No newline at end of file
@@ -1,3 +1,6 @@
+// Package memory implements the Memory domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package memory
 // Memory pressure level.
 type PressureLevel string
@@ -75,6 +75,10 @@ type LoadingFinishedParams struct {
 // Details of an intercepted HTTP request, which must be either allowed, blocked, modified or
 // mocked.
 // Deprecated, use Fetch.requestPaused instead.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const RequestInterceptedEvent = "Network.requestIntercepted"
 type RequestInterceptedParams struct {
 
@@ -152,6 +156,8 @@ type RequestWillBeSentParams struct {
 
 
 // Fired when resource loading priority is changed
+//
+// Experimental: may be changed or removed without notice.
 const ResourceChangedPriorityEvent = "Network.resourceChangedPriority"
 type ResourceChangedPriorityParams struct {
 
@@ -165,6 +171,8 @@ type ResourceChangedPriorityParams struct {
 
 
 // Fired when a signed exchange was received over the network
+//
+// Experimental: may be changed or removed without notice.
 const SignedExchangeReceivedEvent = "Network.signedExchangeReceived"
 type SignedExchangeReceivedParams struct {
 
@@ -289,6 +297,8 @@ type WebSocketWillSendHandshakeRequestParams struct {
 // network stack. Not every requestWillBeSent event will have an additional
 // requestWillBeSentExtraInfo fired for it, and there is no guarantee whether requestWillBeSent
 // or requestWillBeSentExtraInfo will be fired first for the same request.
+//
+// Experimental: may be changed or removed without notice.
 const RequestWillBeSentExtraInfoEvent = "Network.requestWillBeSentExtraInfo"
 type RequestWillBeSentExtraInfoParams struct {
 
@@ -305,6 +315,8 @@ type RequestWillBeSentExtraInfoParams struct {
 // Fired when additional information about a responseReceived event is available from the network
 // stack. Not every responseReceived event will have an additional responseReceivedExtraInfo for
 // it, and responseReceivedExtraInfo may be fired before or after responseReceived.
+//
+// Experimental: may be changed or removed without notice.
 const ResponseReceivedExtraInfoEvent = "Network.responseReceivedExtraInfo"
 type ResponseReceivedExtraInfoParams struct {
 
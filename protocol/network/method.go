@@ -1,12 +1,15 @@
 package network
 
 import (
-	"github.com/diiyw/cuto/protocol/io"
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/debugger"
+	"github.com/diiyw/cuto/protocol/io"
 )
 
 
 // Tells whether clearing browser cache is supported.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const CanClearBrowserCache = "Network.canClearBrowserCache"
 
 type CanClearBrowserCacheParams struct {
@@ -18,7 +21,11 @@ type CanClearBrowserCacheResult struct {
 	Result 	bool	`json:"result"`
 }
 
+var CanClearBrowserCacheCommand = cdp.Command[CanClearBrowserCacheParams, CanClearBrowserCacheResult]{Method: CanClearBrowserCache}
+
 // Tells whether clearing browser cookies is supported.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const CanClearBrowserCookies = "Network.canClearBrowserCookies"
 
 type CanClearBrowserCookiesParams struct {
@@ -30,7 +37,11 @@ type CanClearBrowserCookiesResult struct {
 	Result 	bool	`json:"result"`
 }
 
+var CanClearBrowserCookiesCommand = cdp.Command[CanClearBrowserCookiesParams, CanClearBrowserCookiesResult]{Method: CanClearBrowserCookies}
+
 // Tells whether emulation of network conditions is supported.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const CanEmulateNetworkConditions = "Network.canEmulateNetworkConditions"
 
 type CanEmulateNetworkConditionsParams struct {
@@ -42,6 +53,8 @@ type CanEmulateNetworkConditionsResult struct {
 	Result 	bool	`json:"result"`
 }
 
+var CanEmulateNetworkConditionsCommand = cdp.Command[CanEmulateNetworkConditionsParams, CanEmulateNetworkConditionsResult]{Method: CanEmulateNetworkConditions}
+
 // Clears browser cache.
 const ClearBrowserCache = "Network.clearBrowserCache"
 
@@ -52,6 +65,8 @@ type ClearBrowserCacheResult struct {
 
 }
 
+var ClearBrowserCacheCommand = cdp.Command[ClearBrowserCacheParams, ClearBrowserCacheResult]{Method: ClearBrowserCache}
+
 // Clears browser cookies.
 const ClearBrowserCookies = "Network.clearBrowserCookies"
 
@@ -62,11 +77,17 @@ type ClearBrowserCookiesResult struct {
 
 }
 
+var ClearBrowserCookiesCommand = cdp.Command[ClearBrowserCookiesParams, ClearBrowserCookiesResult]{Method: ClearBrowserCookies}
+
 // Response to Network.requestIntercepted which either modifies the request to continue with any
 // modifications, or blocks it, or completes it with the provided response bytes. If a network
 // fetch occurs as a result which encounters a redirect an additional Network.requestIntercepted
 // event will be sent with the same InterceptionId.
 // Deprecated, use Fetch.continueRequest, Fetch.fulfillRequest and Fetch.failRequest instead.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const ContinueInterceptedRequest = "Network.continueInterceptedRequest"
 
 type ContinueInterceptedRequestParams struct {
@@ -106,6 +127,8 @@ type ContinueInterceptedRequestResult struct {
 
 }
 
+var ContinueInterceptedRequestCommand = cdp.Command[ContinueInterceptedRequestParams, ContinueInterceptedRequestResult]{Method: ContinueInterceptedRequest}
+
 // Deletes browser cookies with matching name and url or domain/path pair.
 const DeleteCookies = "Network.deleteCookies"
 
@@ -129,6 +152,8 @@ type DeleteCookiesResult struct {
 
 }
 
+var DeleteCookiesCommand = cdp.Command[DeleteCookiesParams, DeleteCookiesResult]{Method: DeleteCookies}
+
 // Disables network tracking, prevents network events from being sent to the client.
 const Disable = "Network.disable"
 
@@ -139,6 +164,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Activates emulation of network conditions.
 const EmulateNetworkConditions = "Network.emulateNetworkConditions"
 
@@ -164,15 +191,21 @@ type EmulateNetworkConditionsResult struct {
 
 }
 
+var EmulateNetworkConditionsCommand = cdp.Command[EmulateNetworkConditionsParams, EmulateNetworkConditionsResult]{Method: EmulateNetworkConditions}
+
 // Enables network tracking, network events will now be delivered to the client.
 const Enable = "Network.enable"
 
 type EnableParams struct {
 
 	// Buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	//
+	// Experimental: may be changed or removed without notice.
 	MaxTotalBufferSize 	int	`json:"maxTotalBufferSize,omitempty"`
 
 	// Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	//
+	// Experimental: may be changed or removed without notice.
 	MaxResourceBufferSize 	int	`json:"maxResourceBufferSize,omitempty"`
 
 	// Longest post body size (in bytes) that would be included in requestWillBeSent notification
@@ -183,6 +216,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Returns all browser cookies. Depending on the backend support, will return detailed cookie
 // information in the `cookies` field.
 const GetAllCookies = "Network.getAllCookies"
@@ -196,7 +231,11 @@ type GetAllCookiesResult struct {
 	Cookies 	[]*Cookie	`json:"cookies"`
 }
 
+var GetAllCookiesCommand = cdp.Command[GetAllCookiesParams, GetAllCookiesResult]{Method: GetAllCookies}
+
 // Returns the DER-encoded certificate.
+//
+// Experimental: may be changed or removed without notice.
 const GetCertificate = "Network.getCertificate"
 
 type GetCertificateParams struct {
@@ -211,6 +250,8 @@ type GetCertificateResult struct {
 	TableNames 	[]string	`json:"tableNames"`
 }
 
+var GetCertificateCommand = cdp.Command[GetCertificateParams, GetCertificateResult]{Method: GetCertificate}
+
 // Returns all browser cookies for the current URL. Depending on the backend support, will return
 // detailed cookie information in the `cookies` field.
 const GetCookies = "Network.getCookies"
@@ -227,6 +268,8 @@ type GetCookiesResult struct {
 	Cookies 	[]*Cookie	`json:"cookies"`
 }
 
+var GetCookiesCommand = cdp.Command[GetCookiesParams, GetCookiesResult]{Method: GetCookies}
+
 // Returns content served for the given request.
 const GetResponseBody = "Network.getResponseBody"
 
@@ -244,6 +287,8 @@ type GetResponseBodyResult struct {
 	Base64Encoded 	bool	`json:"base64Encoded"`
 }
 
+var GetResponseBodyCommand = cdp.Command[GetResponseBodyParams, GetResponseBodyResult]{Method: GetResponseBody}
+
 // Returns post data sent with the request. Returns an error when no data was sent with the request.
 const GetRequestPostData = "Network.getRequestPostData"
 
@@ -259,7 +304,11 @@ type GetRequestPostDataResult struct {
 	PostData 	string	`json:"postData"`
 }
 
+var GetRequestPostDataCommand = cdp.Command[GetRequestPostDataParams, GetRequestPostDataResult]{Method: GetRequestPostData}
+
 // Returns content served for the given currently intercepted request.
+//
+// Experimental: may be changed or removed without notice.
 const GetResponseBodyForInterception = "Network.getResponseBodyForInterception"
 
 type GetResponseBodyForInterceptionParams struct {
@@ -276,10 +325,14 @@ type GetResponseBodyForInterceptionResult struct {
 	Base64Encoded 	bool	`json:"base64Encoded"`
 }
 
+var GetResponseBodyForInterceptionCommand = cdp.Command[GetResponseBodyForInterceptionParams, GetResponseBodyForInterceptionResult]{Method: GetResponseBodyForInterception}
+
 // Returns a handle to the stream representing the response body. Note that after this command,
 // the intercepted request can't be continued as is -- you either need to cancel it or to provide
 // the response body. The stream only supports sequential read, IO.read will fail if the position
 // is specified.
+//
+// Experimental: may be changed or removed without notice.
 const TakeResponseBodyForInterceptionAsStream = "Network.takeResponseBodyForInterceptionAsStream"
 
 type TakeResponseBodyForInterceptionAsStreamParams struct {
@@ -294,9 +347,13 @@ type TakeResponseBodyForInterceptionAsStreamResult struct {
 	Stream 	io.StreamHandle	`json:"stream"`
 }
 
+var TakeResponseBodyForInterceptionAsStreamCommand = cdp.Command[TakeResponseBodyForInterceptionAsStreamParams, TakeResponseBodyForInterceptionAsStreamResult]{Method: TakeResponseBodyForInterceptionAsStream}
+
 // This method sends a new XMLHttpRequest which is identical to the original one. The following
 // parameters should be identical: method, url, async, request body, extra headers, withCredentials
 // attribute, user, password.
+//
+// Experimental: may be changed or removed without notice.
 const ReplayXHR = "Network.replayXHR"
 
 type ReplayXHRParams struct {
@@ -309,7 +366,11 @@ type ReplayXHRResult struct {
 
 }
 
+var ReplayXHRCommand = cdp.Command[ReplayXHRParams, ReplayXHRResult]{Method: ReplayXHR}
+
 // Searches for given string in response content.
+//
+// Experimental: may be changed or removed without notice.
 const SearchInResponseBody = "Network.searchInResponseBody"
 
 type SearchInResponseBodyParams struct {
@@ -333,7 +394,11 @@ type SearchInResponseBodyResult struct {
 	Result 	[]*debugger.SearchMatch	`json:"result"`
 }
 
+var SearchInResponseBodyCommand = cdp.Command[SearchInResponseBodyParams, SearchInResponseBodyResult]{Method: SearchInResponseBody}
+
 // Blocks URLs from loading.
+//
+// Experimental: may be changed or removed without notice.
 const SetBlockedURLs = "Network.setBlockedURLs"
 
 type SetBlockedURLsParams struct {
@@ -346,7 +411,11 @@ type SetBlockedURLsResult struct {
 
 }
 
+var SetBlockedURLsCommand = cdp.Command[SetBlockedURLsParams, SetBlockedURLsResult]{Method: SetBlockedURLs}
+
 // Toggles ignoring of service worker for each request.
+//
+// Experimental: may be changed or removed without notice.
 const SetBypassServiceWorker = "Network.setBypassServiceWorker"
 
 type SetBypassServiceWorkerParams struct {
@@ -359,6 +428,8 @@ type SetBypassServiceWorkerResult struct {
 
 }
 
+var SetBypassServiceWorkerCommand = cdp.Command[SetBypassServiceWorkerParams, SetBypassServiceWorkerResult]{Method: SetBypassServiceWorker}
+
 // Toggles ignoring cache for each request. If `true`, cache will not be used.
 const SetCacheDisabled = "Network.setCacheDisabled"
 
@@ -372,6 +443,8 @@ type SetCacheDisabledResult struct {
 
 }
 
+var SetCacheDisabledCommand = cdp.Command[SetCacheDisabledParams, SetCacheDisabledResult]{Method: SetCacheDisabled}
+
 // Sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist.
 const SetCookie = "Network.setCookie"
 
@@ -412,6 +485,8 @@ type SetCookieResult struct {
 	Success 	bool	`json:"success"`
 }
 
+var SetCookieCommand = cdp.Command[SetCookieParams, SetCookieResult]{Method: SetCookie}
+
 // Sets given cookies.
 const SetCookies = "Network.setCookies"
 
@@ -425,7 +500,11 @@ type SetCookiesResult struct {
 
 }
 
+var SetCookiesCommand = cdp.Command[SetCookiesParams, SetCookiesResult]{Method: SetCookies}
+
 // For testing.
+//
+// Experimental: may be changed or removed without notice.
 const SetDataSizeLimitsForTest = "Network.setDataSizeLimitsForTest"
 
 type SetDataSizeLimitsForTestParams struct {
@@ -441,6 +520,8 @@ type SetDataSizeLimitsForTestResult struct {
 
 }
 
+var SetDataSizeLimitsForTestCommand = cdp.Command[SetDataSizeLimitsForTestParams, SetDataSizeLimitsForTestResult]{Method: SetDataSizeLimitsForTest}
+
 // Specifies whether to always send extra HTTP headers with the requests from this page.
 const SetExtraHTTPHeaders = "Network.setExtraHTTPHeaders"
 
@@ -454,8 +535,14 @@ type SetExtraHTTPHeadersResult struct {
 
 }
 
+var SetExtraHTTPHeadersCommand = cdp.Command[SetExtraHTTPHeadersParams, SetExtraHTTPHeadersResult]{Method: SetExtraHTTPHeaders}
+
 // Sets the requests to intercept that match the provided patterns and optionally resource types.
 // Deprecated, please use Fetch.enable instead.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const SetRequestInterception = "Network.setRequestInterception"
 
 type SetRequestInterceptionParams struct {
@@ -469,6 +556,8 @@ type SetRequestInterceptionResult struct {
 
 }
 
+var SetRequestInterceptionCommand = cdp.Command[SetRequestInterceptionParams, SetRequestInterceptionResult]{Method: SetRequestInterception}
+
 // Allows overriding user agent with the given string.
 const SetUserAgentOverride = "Network.setUserAgentOverride"
 
@@ -486,4 +575,6 @@ type SetUserAgentOverrideParams struct {
 
 type SetUserAgentOverrideResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetUserAgentOverrideCommand = cdp.Command[SetUserAgentOverrideParams, SetUserAgentOverrideResult]{Method: SetUserAgentOverride}
\ No newline at end of file
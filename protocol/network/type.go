@@ -1,3 +1,7 @@
+// Package network implements the Network domain of the DevTools protocol.
+//
+// Network domain allows tracking network activities of the page. It exposes information about http,
+// file, data and other requests and responses, their headers, bodies, timing, etc.
 package network
 
 import (
@@ -68,9 +72,13 @@ type ResourceTiming  struct {
 	SslEnd	float64	`json:"sslEnd"`
 
 	// Started running ServiceWorker.
+	//
+	// Experimental: may be changed or removed without notice.
 	WorkerStart	float64	`json:"workerStart"`
 
 	// Finished Starting ServiceWorker.
+	//
+	// Experimental: may be changed or removed without notice.
 	WorkerReady	float64	`json:"workerReady"`
 
 	// Started sending request.
@@ -80,9 +88,13 @@ type ResourceTiming  struct {
 	SendEnd	float64	`json:"sendEnd"`
 
 	// Time the server started pushing request.
+	//
+	// Experimental: may be changed or removed without notice.
 	PushStart	float64	`json:"pushStart"`
 
 	// Time the server finished pushing request.
+	//
+	// Experimental: may be changed or removed without notice.
 	PushEnd	float64	`json:"pushEnd"`
 
 	// Finished receiving response headers.
@@ -379,12 +391,18 @@ type Cookie  struct {
 }
 
 // Types of reasons why a cookie may not be stored from a response.
+//
+// Experimental: may be changed or removed without notice.
 type SetCookieBlockedReason string
 
 // Types of reasons why a cookie may not be sent with a request.
+//
+// Experimental: may be changed or removed without notice.
 type CookieBlockedReason string
 
 // A cookie which was not stored from a response with the corresponding reason.
+//
+// Experimental: may be changed or removed without notice.
 type BlockedSetCookieWithReason  struct {
 
 	// The reason(s) this cookie was blocked.
@@ -401,6 +419,8 @@ type BlockedSetCookieWithReason  struct {
 }
 
 // A cookie with was not sent with a request with the corresponding reason.
+//
+// Experimental: may be changed or removed without notice.
 type BlockedCookieWithReason  struct {
 
 	// The reason(s) the cookie was blocked.
@@ -443,6 +463,8 @@ type CookieParam  struct {
 }
 
 // Authorization challenge for HTTP status code 401 or 407.
+//
+// Experimental: may be changed or removed without notice.
 type AuthChallenge  struct {
 
 	// Source of the authentication challenge.
@@ -459,6 +481,8 @@ type AuthChallenge  struct {
 }
 
 // Response to an AuthChallenge.
+//
+// Experimental: may be changed or removed without notice.
 type AuthChallengeResponse  struct {
 
 	// The decision on what to do in response to the authorization challenge.  Default means
@@ -477,9 +501,13 @@ type AuthChallengeResponse  struct {
 
 // Stages of the interception to begin intercepting. Request will intercept before the request is
 	// sent. Response will intercept after the response is received.
+//
+// Experimental: may be changed or removed without notice.
 type InterceptionStage string
 
 // Request pattern for interception.
+//
+// Experimental: may be changed or removed without notice.
 type RequestPattern  struct {
 
 	// Wildcards ('*' -> zero or more, '?' -> exactly one) are allowed. Escape character is
@@ -495,6 +523,8 @@ type RequestPattern  struct {
 
 // Information about a signed exchange signature.
 	// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1
+//
+// Experimental: may be changed or removed without notice.
 type SignedExchangeSignature  struct {
 
 	// Signed exchange signature label.
@@ -527,6 +557,8 @@ type SignedExchangeSignature  struct {
 
 // Information about a signed exchange header.
 	// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation
+//
+// Experimental: may be changed or removed without notice.
 type SignedExchangeHeader  struct {
 
 	// Signed exchange request URL.
@@ -546,9 +578,13 @@ type SignedExchangeHeader  struct {
 }
 
 // Field type for a signed exchange related error.
+//
+// Experimental: may be changed or removed without notice.
 type SignedExchangeErrorField string
 
 // Information about a signed exchange response.
+//
+// Experimental: may be changed or removed without notice.
 type SignedExchangeError  struct {
 
 	// Error message.
@@ -562,6 +598,8 @@ type SignedExchangeError  struct {
 }
 
 // Information about a signed exchange response.
+//
+// Experimental: may be changed or removed without notice.
 type SignedExchangeInfo  struct {
 
 	// The outer response of signed HTTP exchange which was received from network.
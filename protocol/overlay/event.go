@@ -1,8 +1,8 @@
 package overlay
 
 import (
-	"github.com/diiyw/cuto/protocol/dom"
 	"github.com/diiyw/cuto/protocol/cdp"
+	"github.com/diiyw/cuto/protocol/dom"
 )
 
 
@@ -1,9 +1,9 @@
 package overlay
 
 import (
-	"github.com/diiyw/cuto/protocol/runtime"
-	"github.com/diiyw/cuto/protocol/dom"
 	"github.com/diiyw/cuto/protocol/cdp"
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/runtime"
 )
 
 
@@ -17,6 +17,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables domain notifications.
 const Enable = "Overlay.enable"
 
@@ -27,6 +29,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // For testing.
 const GetHighlightObjectForTest = "Overlay.getHighlightObjectForTest"
 
@@ -48,6 +52,8 @@ type GetHighlightObjectForTestResult struct {
 	Highlight 	interface{}	`json:"highlight"`
 }
 
+var GetHighlightObjectForTestCommand = cdp.Command[GetHighlightObjectForTestParams, GetHighlightObjectForTestResult]{Method: GetHighlightObjectForTest}
+
 // Hides any highlight.
 const HideHighlight = "Overlay.hideHighlight"
 
@@ -58,6 +64,8 @@ type HideHighlightResult struct {
 
 }
 
+var HideHighlightCommand = cdp.Command[HideHighlightParams, HideHighlightResult]{Method: HideHighlight}
+
 // Highlights owner element of the frame with given id.
 const HighlightFrame = "Overlay.highlightFrame"
 
@@ -77,6 +85,8 @@ type HighlightFrameResult struct {
 
 }
 
+var HighlightFrameCommand = cdp.Command[HighlightFrameParams, HighlightFrameResult]{Method: HighlightFrame}
+
 // Highlights DOM node with given id or with the given JavaScript object wrapper. Either nodeId or
 // objectId must be specified.
 const HighlightNode = "Overlay.highlightNode"
@@ -103,6 +113,8 @@ type HighlightNodeResult struct {
 
 }
 
+var HighlightNodeCommand = cdp.Command[HighlightNodeParams, HighlightNodeResult]{Method: HighlightNode}
+
 // Highlights given quad. Coordinates are absolute with respect to the main frame viewport.
 const HighlightQuad = "Overlay.highlightQuad"
 
@@ -122,6 +134,8 @@ type HighlightQuadResult struct {
 
 }
 
+var HighlightQuadCommand = cdp.Command[HighlightQuadParams, HighlightQuadResult]{Method: HighlightQuad}
+
 // Highlights given rectangle. Coordinates are absolute with respect to the main frame viewport.
 const HighlightRect = "Overlay.highlightRect"
 
@@ -150,6 +164,8 @@ type HighlightRectResult struct {
 
 }
 
+var HighlightRectCommand = cdp.Command[HighlightRectParams, HighlightRectResult]{Method: HighlightRect}
+
 // Enters the 'inspect' mode. In this mode, elements that user is hovering over are highlighted.
 // Backend then generates 'inspectNodeRequested' event upon element selection.
 const SetInspectMode = "Overlay.setInspectMode"
@@ -168,6 +184,8 @@ type SetInspectModeResult struct {
 
 }
 
+var SetInspectModeCommand = cdp.Command[SetInspectModeParams, SetInspectModeResult]{Method: SetInspectMode}
+
 // Highlights owner element of all frames detected to be ads.
 const SetShowAdHighlights = "Overlay.setShowAdHighlights"
 
@@ -181,6 +199,8 @@ type SetShowAdHighlightsResult struct {
 
 }
 
+var SetShowAdHighlightsCommand = cdp.Command[SetShowAdHighlightsParams, SetShowAdHighlightsResult]{Method: SetShowAdHighlights}
+
 // 
 const SetPausedInDebuggerMessage = "Overlay.setPausedInDebuggerMessage"
 
@@ -194,6 +214,8 @@ type SetPausedInDebuggerMessageResult struct {
 
 }
 
+var SetPausedInDebuggerMessageCommand = cdp.Command[SetPausedInDebuggerMessageParams, SetPausedInDebuggerMessageResult]{Method: SetPausedInDebuggerMessage}
+
 // Requests that backend shows debug borders on layers
 const SetShowDebugBorders = "Overlay.setShowDebugBorders"
 
@@ -207,6 +229,8 @@ type SetShowDebugBordersResult struct {
 
 }
 
+var SetShowDebugBordersCommand = cdp.Command[SetShowDebugBordersParams, SetShowDebugBordersResult]{Method: SetShowDebugBorders}
+
 // Requests that backend shows the FPS counter
 const SetShowFPSCounter = "Overlay.setShowFPSCounter"
 
@@ -220,6 +244,8 @@ type SetShowFPSCounterResult struct {
 
 }
 
+var SetShowFPSCounterCommand = cdp.Command[SetShowFPSCounterParams, SetShowFPSCounterResult]{Method: SetShowFPSCounter}
+
 // Requests that backend shows paint rectangles
 const SetShowPaintRects = "Overlay.setShowPaintRects"
 
@@ -233,6 +259,8 @@ type SetShowPaintRectsResult struct {
 
 }
 
+var SetShowPaintRectsCommand = cdp.Command[SetShowPaintRectsParams, SetShowPaintRectsResult]{Method: SetShowPaintRects}
+
 // Requests that backend shows layout shift regions
 const SetShowLayoutShiftRegions = "Overlay.setShowLayoutShiftRegions"
 
@@ -246,6 +274,8 @@ type SetShowLayoutShiftRegionsResult struct {
 
 }
 
+var SetShowLayoutShiftRegionsCommand = cdp.Command[SetShowLayoutShiftRegionsParams, SetShowLayoutShiftRegionsResult]{Method: SetShowLayoutShiftRegions}
+
 // Requests that backend shows scroll bottleneck rects
 const SetShowScrollBottleneckRects = "Overlay.setShowScrollBottleneckRects"
 
@@ -259,6 +289,8 @@ type SetShowScrollBottleneckRectsResult struct {
 
 }
 
+var SetShowScrollBottleneckRectsCommand = cdp.Command[SetShowScrollBottleneckRectsParams, SetShowScrollBottleneckRectsResult]{Method: SetShowScrollBottleneckRects}
+
 // Requests that backend shows hit-test borders on layers
 const SetShowHitTestBorders = "Overlay.setShowHitTestBorders"
 
@@ -272,6 +304,8 @@ type SetShowHitTestBordersResult struct {
 
 }
 
+var SetShowHitTestBordersCommand = cdp.Command[SetShowHitTestBordersParams, SetShowHitTestBordersResult]{Method: SetShowHitTestBorders}
+
 // Paints viewport size upon main frame resize.
 const SetShowViewportSizeOnResize = "Overlay.setShowViewportSizeOnResize"
 
@@ -283,4 +317,6 @@ type SetShowViewportSizeOnResizeParams struct {
 
 type SetShowViewportSizeOnResizeResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetShowViewportSizeOnResizeCommand = cdp.Command[SetShowViewportSizeOnResizeParams, SetShowViewportSizeOnResizeResult]{Method: SetShowViewportSizeOnResize}
\ No newline at end of file
@@ -1,3 +1,8 @@
+// Package overlay implements the Overlay domain of the DevTools protocol.
+//
+// This domain provides various functionality related to drawing atop the inspected page.
+//
+// Experimental: may be changed or removed without notice.
 package overlay
 
 import (
@@ -38,6 +38,8 @@ type FrameAttachedParams struct {
 
 
 // Fired when frame no longer has a scheduled navigation.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const FrameClearedScheduledNavigationEvent = "Page.frameClearedScheduledNavigation"
 type FrameClearedScheduledNavigationParams struct {
 
@@ -65,6 +67,8 @@ type FrameNavigatedParams struct {
 
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 const FrameResizedEvent = "Page.frameResized"
 type FrameResizedParams struct {
 }
@@ -73,6 +77,8 @@ type FrameResizedParams struct {
 
 // Fired when a renderer-initiated navigation is requested.
 // Navigation may still be cancelled after the event is issued.
+//
+// Experimental: may be changed or removed without notice.
 const FrameRequestedNavigationEvent = "Page.frameRequestedNavigation"
 type FrameRequestedNavigationParams struct {
 
@@ -86,6 +92,8 @@ type FrameRequestedNavigationParams struct {
 
 
 // Fired when frame schedules a potential navigation.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const FrameScheduledNavigationEvent = "Page.frameScheduledNavigation"
 type FrameScheduledNavigationParams struct {
 
@@ -102,6 +110,8 @@ type FrameScheduledNavigationParams struct {
 
 
 // Fired when frame has started loading.
+//
+// Experimental: may be changed or removed without notice.
 const FrameStartedLoadingEvent = "Page.frameStartedLoading"
 type FrameStartedLoadingParams struct {
 
@@ -111,6 +121,8 @@ type FrameStartedLoadingParams struct {
 
 
 // Fired when frame has stopped loading.
+//
+// Experimental: may be changed or removed without notice.
 const FrameStoppedLoadingEvent = "Page.frameStoppedLoading"
 type FrameStoppedLoadingParams struct {
 
@@ -120,6 +132,8 @@ type FrameStoppedLoadingParams struct {
 
 
 // Fired when page is about to start a download.
+//
+// Experimental: may be changed or removed without notice.
 const DownloadWillBeginEvent = "Page.downloadWillBegin"
 type DownloadWillBeginParams struct {
 
@@ -201,6 +215,8 @@ type LoadEventFiredParams struct {
 
 
 // Fired when same-document navigation happens, e.g. due to history API usage or anchor navigation.
+//
+// Experimental: may be changed or removed without notice.
 const NavigatedWithinDocumentEvent = "Page.navigatedWithinDocument"
 type NavigatedWithinDocumentParams struct {
 
@@ -212,6 +228,8 @@ type NavigatedWithinDocumentParams struct {
 
 
 // Compressed image data requested by the `startScreencast`.
+//
+// Experimental: may be changed or removed without notice.
 const ScreencastFrameEvent = "Page.screencastFrame"
 type ScreencastFrameParams struct {
 
@@ -225,6 +243,8 @@ type ScreencastFrameParams struct {
 
 
 // Fired when the page with currently enabled screencast was shown or hidden `.
+//
+// Experimental: may be changed or removed without notice.
 const ScreencastVisibilityChangedEvent = "Page.screencastVisibilityChanged"
 type ScreencastVisibilityChangedParams struct {
 
@@ -251,6 +271,8 @@ type WindowOpenParams struct {
 
 // Issued for every compilation cache generated. Is only available
 // if Page.setGenerateCompilationCache is enabled.
+//
+// Experimental: may be changed or removed without notice.
 const CompilationCacheProducedEvent = "Page.compilationCacheProduced"
 type CompilationCacheProducedParams struct {
 
@@ -1,16 +1,20 @@
 package page
 
 import (
-	"github.com/diiyw/cuto/protocol/io"
+	"github.com/diiyw/cuto/protocol/cdp"
 	"github.com/diiyw/cuto/protocol/debugger"
 	"github.com/diiyw/cuto/protocol/emulation"
-	"github.com/diiyw/cuto/protocol/runtime"
+	"github.com/diiyw/cuto/protocol/io"
 	"github.com/diiyw/cuto/protocol/network"
-	"github.com/diiyw/cuto/protocol/cdp"
+	"github.com/diiyw/cuto/protocol/runtime"
 )
 
 
 // Deprecated, please use addScriptToEvaluateOnNewDocument instead.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const AddScriptToEvaluateOnLoad = "Page.addScriptToEvaluateOnLoad"
 
 type AddScriptToEvaluateOnLoadParams struct {
@@ -25,6 +29,8 @@ type AddScriptToEvaluateOnLoadResult struct {
 	Identifier 	ScriptIdentifier	`json:"identifier"`
 }
 
+var AddScriptToEvaluateOnLoadCommand = cdp.Command[AddScriptToEvaluateOnLoadParams, AddScriptToEvaluateOnLoadResult]{Method: AddScriptToEvaluateOnLoad}
+
 // Evaluates given script in every frame upon creation (before loading frame's scripts).
 const AddScriptToEvaluateOnNewDocument = "Page.addScriptToEvaluateOnNewDocument"
 
@@ -36,6 +42,8 @@ type AddScriptToEvaluateOnNewDocumentParams struct {
 	// If specified, creates an isolated world with the given name and evaluates given script in it.
 	// This world name will be used as the ExecutionContextDescription::name when the corresponding
 	// event is emitted.
+	//
+	// Experimental: may be changed or removed without notice.
 	WorldName 	string	`json:"worldName,omitempty"`
 }
 
@@ -45,6 +53,8 @@ type AddScriptToEvaluateOnNewDocumentResult struct {
 	Identifier 	ScriptIdentifier	`json:"identifier"`
 }
 
+var AddScriptToEvaluateOnNewDocumentCommand = cdp.Command[AddScriptToEvaluateOnNewDocumentParams, AddScriptToEvaluateOnNewDocumentResult]{Method: AddScriptToEvaluateOnNewDocument}
+
 // Brings page to front (activates tab).
 const BringToFront = "Page.bringToFront"
 
@@ -55,6 +65,8 @@ type BringToFrontResult struct {
 
 }
 
+var BringToFrontCommand = cdp.Command[BringToFrontParams, BringToFrontResult]{Method: BringToFront}
+
 // Capture page screenshot.
 const CaptureScreenshot = "Page.captureScreenshot"
 
@@ -70,6 +82,8 @@ type CaptureScreenshotParams struct {
 	Clip 	Viewport	`json:"clip,omitempty"`
 
 	// Capture the screenshot from the surface, rather than the view. Defaults to true.
+	//
+	// Experimental: may be changed or removed without notice.
 	FromSurface 	bool	`json:"fromSurface,omitempty"`
 }
 
@@ -79,8 +93,12 @@ type CaptureScreenshotResult struct {
 	Data 	[]byte	`json:"data"`
 }
 
+var CaptureScreenshotCommand = cdp.Command[CaptureScreenshotParams, CaptureScreenshotResult]{Method: CaptureScreenshot}
+
 // Returns a snapshot of the page as a string. For MHTML format, the serialization includes
 // iframes, shadow DOM, external resources, and element-inline styles.
+//
+// Experimental: may be changed or removed without notice.
 const CaptureSnapshot = "Page.captureSnapshot"
 
 type CaptureSnapshotParams struct {
@@ -95,7 +113,13 @@ type CaptureSnapshotResult struct {
 	Data 	string	`json:"data"`
 }
 
+var CaptureSnapshotCommand = cdp.Command[CaptureSnapshotParams, CaptureSnapshotResult]{Method: CaptureSnapshot}
+
 // Clears the overriden device metrics.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const ClearDeviceMetricsOverride = "Page.clearDeviceMetricsOverride"
 
 type ClearDeviceMetricsOverrideParams struct {
@@ -105,7 +129,13 @@ type ClearDeviceMetricsOverrideResult struct {
 
 }
 
+var ClearDeviceMetricsOverrideCommand = cdp.Command[ClearDeviceMetricsOverrideParams, ClearDeviceMetricsOverrideResult]{Method: ClearDeviceMetricsOverride}
+
 // Clears the overridden Device Orientation.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const ClearDeviceOrientationOverride = "Page.clearDeviceOrientationOverride"
 
 type ClearDeviceOrientationOverrideParams struct {
@@ -115,7 +145,11 @@ type ClearDeviceOrientationOverrideResult struct {
 
 }
 
+var ClearDeviceOrientationOverrideCommand = cdp.Command[ClearDeviceOrientationOverrideParams, ClearDeviceOrientationOverrideResult]{Method: ClearDeviceOrientationOverride}
+
 // Clears the overriden Geolocation Position and Error.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const ClearGeolocationOverride = "Page.clearGeolocationOverride"
 
 type ClearGeolocationOverrideParams struct {
@@ -125,6 +159,8 @@ type ClearGeolocationOverrideResult struct {
 
 }
 
+var ClearGeolocationOverrideCommand = cdp.Command[ClearGeolocationOverrideParams, ClearGeolocationOverrideResult]{Method: ClearGeolocationOverride}
+
 // Creates an isolated world for the given frame.
 const CreateIsolatedWorld = "Page.createIsolatedWorld"
 
@@ -147,7 +183,13 @@ type CreateIsolatedWorldResult struct {
 	ExecutionContextId 	runtime.ExecutionContextId	`json:"executionContextId"`
 }
 
+var CreateIsolatedWorldCommand = cdp.Command[CreateIsolatedWorldParams, CreateIsolatedWorldResult]{Method: CreateIsolatedWorld}
+
 // Deletes browser cookie with given name, domain and path.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const DeleteCookie = "Page.deleteCookie"
 
 type DeleteCookieParams struct {
@@ -163,6 +205,8 @@ type DeleteCookieResult struct {
 
 }
 
+var DeleteCookieCommand = cdp.Command[DeleteCookieParams, DeleteCookieResult]{Method: DeleteCookie}
+
 // Disables page domain notifications.
 const Disable = "Page.disable"
 
@@ -173,6 +217,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables page domain notifications.
 const Enable = "Page.enable"
 
@@ -183,6 +229,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // 
 const GetAppManifest = "Page.getAppManifest"
 
@@ -199,7 +247,11 @@ type GetAppManifestResult struct {
 	Data 	string	`json:"data"`
 }
 
+var GetAppManifestCommand = cdp.Command[GetAppManifestParams, GetAppManifestResult]{Method: GetAppManifest}
+
 // 
+//
+// Experimental: may be changed or removed without notice.
 const GetInstallabilityErrors = "Page.getInstallabilityErrors"
 
 type GetInstallabilityErrorsParams struct {
@@ -211,8 +263,14 @@ type GetInstallabilityErrorsResult struct {
 	Errors 	[]string	`json:"errors"`
 }
 
+var GetInstallabilityErrorsCommand = cdp.Command[GetInstallabilityErrorsParams, GetInstallabilityErrorsResult]{Method: GetInstallabilityErrors}
+
 // Returns all browser cookies. Depending on the backend support, will return detailed cookie
 // information in the `cookies` field.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const GetCookies = "Page.getCookies"
 
 type GetCookiesParams struct {
@@ -224,6 +282,8 @@ type GetCookiesResult struct {
 	Cookies 	[]*network.Cookie	`json:"cookies"`
 }
 
+var GetCookiesCommand = cdp.Command[GetCookiesParams, GetCookiesResult]{Method: GetCookies}
+
 // Returns present frame tree structure.
 const GetFrameTree = "Page.getFrameTree"
 
@@ -236,6 +296,8 @@ type GetFrameTreeResult struct {
 	FrameTree 	FrameTree	`json:"frameTree"`
 }
 
+var GetFrameTreeCommand = cdp.Command[GetFrameTreeParams, GetFrameTreeResult]{Method: GetFrameTree}
+
 // Returns metrics relating to the layouting of the page, such as viewport bounds/scale.
 const GetLayoutMetrics = "Page.getLayoutMetrics"
 
@@ -252,6 +314,8 @@ type GetLayoutMetricsResult struct {
 	ContentSize 	cdp.Rect	`json:"contentSize"`
 }
 
+var GetLayoutMetricsCommand = cdp.Command[GetLayoutMetricsParams, GetLayoutMetricsResult]{Method: GetLayoutMetrics}
+
 // Returns navigation history for the current page.
 const GetNavigationHistory = "Page.getNavigationHistory"
 
@@ -266,6 +330,8 @@ type GetNavigationHistoryResult struct {
 	Entries 	[]*NavigationEntry	`json:"entries"`
 }
 
+var GetNavigationHistoryCommand = cdp.Command[GetNavigationHistoryParams, GetNavigationHistoryResult]{Method: GetNavigationHistory}
+
 // Resets navigation history for the current page.
 const ResetNavigationHistory = "Page.resetNavigationHistory"
 
@@ -276,7 +342,11 @@ type ResetNavigationHistoryResult struct {
 
 }
 
+var ResetNavigationHistoryCommand = cdp.Command[ResetNavigationHistoryParams, ResetNavigationHistoryResult]{Method: ResetNavigationHistory}
+
 // Returns content of the given resource.
+//
+// Experimental: may be changed or removed without notice.
 const GetResourceContent = "Page.getResourceContent"
 
 type GetResourceContentParams struct {
@@ -296,7 +366,11 @@ type GetResourceContentResult struct {
 	Base64Encoded 	bool	`json:"base64Encoded"`
 }
 
+var GetResourceContentCommand = cdp.Command[GetResourceContentParams, GetResourceContentResult]{Method: GetResourceContent}
+
 // Returns present frame / resource tree structure.
+//
+// Experimental: may be changed or removed without notice.
 const GetResourceTree = "Page.getResourceTree"
 
 type GetResourceTreeParams struct {
@@ -308,6 +382,8 @@ type GetResourceTreeResult struct {
 	FrameTree 	FrameResourceTree	`json:"frameTree"`
 }
 
+var GetResourceTreeCommand = cdp.Command[GetResourceTreeParams, GetResourceTreeResult]{Method: GetResourceTree}
+
 // Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload).
 const HandleJavaScriptDialog = "Page.handleJavaScriptDialog"
 
@@ -325,6 +401,8 @@ type HandleJavaScriptDialogResult struct {
 
 }
 
+var HandleJavaScriptDialogCommand = cdp.Command[HandleJavaScriptDialogParams, HandleJavaScriptDialogResult]{Method: HandleJavaScriptDialog}
+
 // Navigates current page to the given URL.
 const Navigate = "Page.navigate"
 
@@ -353,6 +431,8 @@ type NavigateResult struct {
 	ErrorText 	string	`json:"errorText"`
 }
 
+var NavigateCommand = cdp.Command[NavigateParams, NavigateResult]{Method: Navigate}
+
 // Navigates current page to the given history entry.
 const NavigateToHistoryEntry = "Page.navigateToHistoryEntry"
 
@@ -366,6 +446,8 @@ type NavigateToHistoryEntryResult struct {
 
 }
 
+var NavigateToHistoryEntryCommand = cdp.Command[NavigateToHistoryEntryParams, NavigateToHistoryEntryResult]{Method: NavigateToHistoryEntry}
+
 // Print page as PDF.
 const PrintToPDF = "Page.printToPDF"
 
@@ -428,6 +510,8 @@ type PrintToPDFParams struct {
 	PreferCSSPageSize 	bool	`json:"preferCSSPageSize,omitempty"`
 
 	// return as stream
+	//
+	// Experimental: may be changed or removed without notice.
 	TransferMode 	string	`json:"transferMode,omitempty"`
 }
 
@@ -436,9 +520,13 @@ type PrintToPDFResult struct {
 	// Base64-encoded pdf data. Empty if |returnAsStream| is specified.
 	Data 	[]byte	`json:"data"`
 	// A handle of the stream that holds resulting PDF data.
+	//
+	// Experimental: may be changed or removed without notice.
 	Stream 	io.StreamHandle	`json:"stream"`
 }
 
+var PrintToPDFCommand = cdp.Command[PrintToPDFParams, PrintToPDFResult]{Method: PrintToPDF}
+
 // Reloads given page optionally ignoring the cache.
 const Reload = "Page.reload"
 
@@ -456,7 +544,13 @@ type ReloadResult struct {
 
 }
 
+var ReloadCommand = cdp.Command[ReloadParams, ReloadResult]{Method: Reload}
+
 // Deprecated, please use removeScriptToEvaluateOnNewDocument instead.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const RemoveScriptToEvaluateOnLoad = "Page.removeScriptToEvaluateOnLoad"
 
 type RemoveScriptToEvaluateOnLoadParams struct {
@@ -469,6 +563,8 @@ type RemoveScriptToEvaluateOnLoadResult struct {
 
 }
 
+var RemoveScriptToEvaluateOnLoadCommand = cdp.Command[RemoveScriptToEvaluateOnLoadParams, RemoveScriptToEvaluateOnLoadResult]{Method: RemoveScriptToEvaluateOnLoad}
+
 // Removes given script from the list.
 const RemoveScriptToEvaluateOnNewDocument = "Page.removeScriptToEvaluateOnNewDocument"
 
@@ -482,7 +578,11 @@ type RemoveScriptToEvaluateOnNewDocumentResult struct {
 
 }
 
+var RemoveScriptToEvaluateOnNewDocumentCommand = cdp.Command[RemoveScriptToEvaluateOnNewDocumentParams, RemoveScriptToEvaluateOnNewDocumentResult]{Method: RemoveScriptToEvaluateOnNewDocument}
+
 // Acknowledges that a screencast frame has been received by the frontend.
+//
+// Experimental: may be changed or removed without notice.
 const ScreencastFrameAck = "Page.screencastFrameAck"
 
 type ScreencastFrameAckParams struct {
@@ -495,7 +595,11 @@ type ScreencastFrameAckResult struct {
 
 }
 
+var ScreencastFrameAckCommand = cdp.Command[ScreencastFrameAckParams, ScreencastFrameAckResult]{Method: ScreencastFrameAck}
+
 // Searches for given string in resource content.
+//
+// Experimental: may be changed or removed without notice.
 const SearchInResource = "Page.searchInResource"
 
 type SearchInResourceParams struct {
@@ -522,7 +626,11 @@ type SearchInResourceResult struct {
 	Result 	[]*debugger.SearchMatch	`json:"result"`
 }
 
+var SearchInResourceCommand = cdp.Command[SearchInResourceParams, SearchInResourceResult]{Method: SearchInResource}
+
 // Enable Chrome's experimental ad filter on all sites.
+//
+// Experimental: may be changed or removed without notice.
 const SetAdBlockingEnabled = "Page.setAdBlockingEnabled"
 
 type SetAdBlockingEnabledParams struct {
@@ -535,7 +643,11 @@ type SetAdBlockingEnabledResult struct {
 
 }
 
+var SetAdBlockingEnabledCommand = cdp.Command[SetAdBlockingEnabledParams, SetAdBlockingEnabledResult]{Method: SetAdBlockingEnabled}
+
 // Enable page Content Security Policy by-passing.
+//
+// Experimental: may be changed or removed without notice.
 const SetBypassCSP = "Page.setBypassCSP"
 
 type SetBypassCSPParams struct {
@@ -548,9 +660,15 @@ type SetBypassCSPResult struct {
 
 }
 
+var SetBypassCSPCommand = cdp.Command[SetBypassCSPParams, SetBypassCSPResult]{Method: SetBypassCSP}
+
 // Overrides the values of device screen dimensions (window.screen.width, window.screen.height,
 // window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media
 // query results).
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const SetDeviceMetricsOverride = "Page.setDeviceMetricsOverride"
 
 type SetDeviceMetricsOverrideParams struct {
@@ -597,7 +715,13 @@ type SetDeviceMetricsOverrideResult struct {
 
 }
 
+var SetDeviceMetricsOverrideCommand = cdp.Command[SetDeviceMetricsOverrideParams, SetDeviceMetricsOverrideResult]{Method: SetDeviceMetricsOverride}
+
 // Overrides the Device Orientation.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const SetDeviceOrientationOverride = "Page.setDeviceOrientationOverride"
 
 type SetDeviceOrientationOverrideParams struct {
@@ -616,7 +740,11 @@ type SetDeviceOrientationOverrideResult struct {
 
 }
 
+var SetDeviceOrientationOverrideCommand = cdp.Command[SetDeviceOrientationOverrideParams, SetDeviceOrientationOverrideResult]{Method: SetDeviceOrientationOverride}
+
 // Set generic font families.
+//
+// Experimental: may be changed or removed without notice.
 const SetFontFamilies = "Page.setFontFamilies"
 
 type SetFontFamiliesParams struct {
@@ -629,7 +757,11 @@ type SetFontFamiliesResult struct {
 
 }
 
+var SetFontFamiliesCommand = cdp.Command[SetFontFamiliesParams, SetFontFamiliesResult]{Method: SetFontFamilies}
+
 // Set default font sizes.
+//
+// Experimental: may be changed or removed without notice.
 const SetFontSizes = "Page.setFontSizes"
 
 type SetFontSizesParams struct {
@@ -642,6 +774,8 @@ type SetFontSizesResult struct {
 
 }
 
+var SetFontSizesCommand = cdp.Command[SetFontSizesParams, SetFontSizesResult]{Method: SetFontSizes}
+
 // Sets given markup as the document's HTML.
 const SetDocumentContent = "Page.setDocumentContent"
 
@@ -658,7 +792,11 @@ type SetDocumentContentResult struct {
 
 }
 
+var SetDocumentContentCommand = cdp.Command[SetDocumentContentParams, SetDocumentContentResult]{Method: SetDocumentContent}
+
 // Set the behavior when downloading a file.
+//
+// Experimental: may be changed or removed without notice.
 const SetDownloadBehavior = "Page.setDownloadBehavior"
 
 type SetDownloadBehaviorParams struct {
@@ -675,8 +813,12 @@ type SetDownloadBehaviorResult struct {
 
 }
 
+var SetDownloadBehaviorCommand = cdp.Command[SetDownloadBehaviorParams, SetDownloadBehaviorResult]{Method: SetDownloadBehavior}
+
 // Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position
 // unavailable.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const SetGeolocationOverride = "Page.setGeolocationOverride"
 
 type SetGeolocationOverrideParams struct {
@@ -695,7 +837,11 @@ type SetGeolocationOverrideResult struct {
 
 }
 
+var SetGeolocationOverrideCommand = cdp.Command[SetGeolocationOverrideParams, SetGeolocationOverrideResult]{Method: SetGeolocationOverride}
+
 // Controls whether page will emit lifecycle events.
+//
+// Experimental: may be changed or removed without notice.
 const SetLifecycleEventsEnabled = "Page.setLifecycleEventsEnabled"
 
 type SetLifecycleEventsEnabledParams struct {
@@ -708,7 +854,13 @@ type SetLifecycleEventsEnabledResult struct {
 
 }
 
+var SetLifecycleEventsEnabledCommand = cdp.Command[SetLifecycleEventsEnabledParams, SetLifecycleEventsEnabledResult]{Method: SetLifecycleEventsEnabled}
+
 // Toggles mouse event-based touch event emulation.
+//
+// Experimental: may be changed or removed without notice.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const SetTouchEmulationEnabled = "Page.setTouchEmulationEnabled"
 
 type SetTouchEmulationEnabledParams struct {
@@ -724,7 +876,11 @@ type SetTouchEmulationEnabledResult struct {
 
 }
 
+var SetTouchEmulationEnabledCommand = cdp.Command[SetTouchEmulationEnabledParams, SetTouchEmulationEnabledResult]{Method: SetTouchEmulationEnabled}
+
 // Starts sending each frame using the `screencastFrame` event.
+//
+// Experimental: may be changed or removed without notice.
 const StartScreencast = "Page.startScreencast"
 
 type StartScreencastParams struct {
@@ -749,6 +905,8 @@ type StartScreencastResult struct {
 
 }
 
+var StartScreencastCommand = cdp.Command[StartScreencastParams, StartScreencastResult]{Method: StartScreencast}
+
 // Force the page stop all navigations and pending resource fetches.
 const StopLoading = "Page.stopLoading"
 
@@ -759,7 +917,11 @@ type StopLoadingResult struct {
 
 }
 
+var StopLoadingCommand = cdp.Command[StopLoadingParams, StopLoadingResult]{Method: StopLoading}
+
 // Crashes renderer on the IO thread, generates minidumps.
+//
+// Experimental: may be changed or removed without notice.
 const Crash = "Page.crash"
 
 type CrashParams struct {
@@ -769,7 +931,11 @@ type CrashResult struct {
 
 }
 
+var CrashCommand = cdp.Command[CrashParams, CrashResult]{Method: Crash}
+
 // Tries to close page, running its beforeunload hooks, if any.
+//
+// Experimental: may be changed or removed without notice.
 const Close = "Page.close"
 
 type CloseParams struct {
@@ -779,9 +945,13 @@ type CloseResult struct {
 
 }
 
+var CloseCommand = cdp.Command[CloseParams, CloseResult]{Method: Close}
+
 // Tries to update the web lifecycle state of the page.
 // It will transition the page to the given state according to:
 // https://github.com/WICG/web-lifecycle/
+//
+// Experimental: may be changed or removed without notice.
 const SetWebLifecycleState = "Page.setWebLifecycleState"
 
 type SetWebLifecycleStateParams struct {
@@ -794,7 +964,11 @@ type SetWebLifecycleStateResult struct {
 
 }
 
+var SetWebLifecycleStateCommand = cdp.Command[SetWebLifecycleStateParams, SetWebLifecycleStateResult]{Method: SetWebLifecycleState}
+
 // Stops sending each frame in the `screencastFrame`.
+//
+// Experimental: may be changed or removed without notice.
 const StopScreencast = "Page.stopScreencast"
 
 type StopScreencastParams struct {
@@ -804,7 +978,11 @@ type StopScreencastResult struct {
 
 }
 
+var StopScreencastCommand = cdp.Command[StopScreencastParams, StopScreencastResult]{Method: StopScreencast}
+
 // Forces compilation cache to be generated for every subresource script.
+//
+// Experimental: may be changed or removed without notice.
 const SetProduceCompilationCache = "Page.setProduceCompilationCache"
 
 type SetProduceCompilationCacheParams struct {
@@ -817,8 +995,12 @@ type SetProduceCompilationCacheResult struct {
 
 }
 
+var SetProduceCompilationCacheCommand = cdp.Command[SetProduceCompilationCacheParams, SetProduceCompilationCacheResult]{Method: SetProduceCompilationCache}
+
 // Seeds compilation cache for given url. Compilation cache does not survive
 // cross-process navigation.
+//
+// Experimental: may be changed or removed without notice.
 const AddCompilationCache = "Page.addCompilationCache"
 
 type AddCompilationCacheParams struct {
@@ -834,7 +1016,11 @@ type AddCompilationCacheResult struct {
 
 }
 
+var AddCompilationCacheCommand = cdp.Command[AddCompilationCacheParams, AddCompilationCacheResult]{Method: AddCompilationCache}
+
 // Clears seeded compilation cache.
+//
+// Experimental: may be changed or removed without notice.
 const ClearCompilationCache = "Page.clearCompilationCache"
 
 type ClearCompilationCacheParams struct {
@@ -844,7 +1030,11 @@ type ClearCompilationCacheResult struct {
 
 }
 
+var ClearCompilationCacheCommand = cdp.Command[ClearCompilationCacheParams, ClearCompilationCacheResult]{Method: ClearCompilationCache}
+
 // Generates a report for testing.
+//
+// Experimental: may be changed or removed without notice.
 const GenerateTestReport = "Page.generateTestReport"
 
 type GenerateTestReportParams struct {
@@ -860,7 +1050,11 @@ type GenerateTestReportResult struct {
 
 }
 
+var GenerateTestReportCommand = cdp.Command[GenerateTestReportParams, GenerateTestReportResult]{Method: GenerateTestReport}
+
 // Pauses page execution. Can be resumed using generic Runtime.runIfWaitingForDebugger.
+//
+// Experimental: may be changed or removed without notice.
 const WaitForDebugger = "Page.waitForDebugger"
 
 type WaitForDebuggerParams struct {
@@ -870,10 +1064,14 @@ type WaitForDebuggerResult struct {
 
 }
 
+var WaitForDebuggerCommand = cdp.Command[WaitForDebuggerParams, WaitForDebuggerResult]{Method: WaitForDebugger}
+
 // Intercept file chooser requests and transfer control to protocol clients.
 // When file chooser interception is enabled, native file chooser dialog is not shown.
 // Instead, a protocol event `Page.fileChooserOpened` is emitted.
 // File chooser can be handled with `page.handleFileChooser` command.
+//
+// Experimental: may be changed or removed without notice.
 const SetInterceptFileChooserDialog = "Page.setInterceptFileChooserDialog"
 
 type SetInterceptFileChooserDialogParams struct {
@@ -886,7 +1084,11 @@ type SetInterceptFileChooserDialogResult struct {
 
 }
 
+var SetInterceptFileChooserDialogCommand = cdp.Command[SetInterceptFileChooserDialogParams, SetInterceptFileChooserDialogResult]{Method: SetInterceptFileChooserDialog}
+
 // Accepts or cancels an intercepted file chooser dialog.
+//
+// Experimental: may be changed or removed without notice.
 const HandleFileChooser = "Page.handleFileChooser"
 
 type HandleFileChooserParams struct {
@@ -900,4 +1102,6 @@ type HandleFileChooserParams struct {
 
 type HandleFileChooserResult struct {
 
-}
\ No newline at end of file
+}
+
+var HandleFileChooserCommand = cdp.Command[HandleFileChooserParams, HandleFileChooserResult]{Method: HandleFileChooser}
\ No newline at end of file
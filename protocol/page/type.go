@@ -1,8 +1,11 @@
+// Package page implements the Page domain of the DevTools protocol.
+//
+// Actions and events related to the inspected page belong to the page domain.
 package page
 
 import (
-	"github.com/diiyw/cuto/protocol/network"
 	"github.com/diiyw/cuto/protocol/cdp"
+	"github.com/diiyw/cuto/protocol/network"
 )
 
 // Unique frame identifier.
@@ -27,6 +30,8 @@ type Frame  struct {
 	Url	string	`json:"url"`
 
 	// Frame document's URL fragment including the '#'.
+	//
+	// Experimental: may be changed or removed without notice.
 	UrlFragment	string	`json:"urlFragment,omitempty"`
 
 	// Frame document's security origin.
@@ -36,10 +41,14 @@ type Frame  struct {
 	MimeType	string	`json:"mimeType"`
 
 	// If the frame failed to load, this contains the URL that could not be loaded. Note that unlike url above, this URL may contain a fragment.
+	//
+	// Experimental: may be changed or removed without notice.
 	UnreachableUrl	string	`json:"unreachableUrl,omitempty"`
 }
 
 // Information about the Resource on the page.
+//
+// Experimental: may be changed or removed without notice.
 type FrameResource  struct {
 
 	// Resource URL.
@@ -65,6 +74,8 @@ type FrameResource  struct {
 }
 
 // Information about the Frame hierarchy along with their cached resources.
+//
+// Experimental: may be changed or removed without notice.
 type FrameResourceTree  struct {
 
 	// Frame information for this tree item.
@@ -113,6 +124,8 @@ type NavigationEntry  struct {
 }
 
 // Screencast frame metadata.
+//
+// Experimental: may be changed or removed without notice.
 type ScreencastFrameMetadata  struct {
 
 	// Top offset in DIP.
@@ -220,6 +233,8 @@ type Viewport  struct {
 }
 
 // Generic font families collection.
+//
+// Experimental: may be changed or removed without notice.
 type FontFamilies  struct {
 
 	// The standard font-family.
@@ -245,6 +260,8 @@ type FontFamilies  struct {
 }
 
 // Default font sizes.
+//
+// Experimental: may be changed or removed without notice.
 type FontSizes  struct {
 
 	// Default standard font size.
@@ -255,4 +272,6 @@ type FontSizes  struct {
 }
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type ClientNavigationReason string
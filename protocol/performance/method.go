@@ -1,5 +1,10 @@
 package performance
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Disable collecting and reporting metrics.
 const Disable = "Performance.disable"
 
@@ -10,6 +15,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enable collecting and reporting metrics.
 const Enable = "Performance.enable"
 
@@ -20,9 +27,13 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Sets time domain to use for collecting and reporting duration metrics.
 // Note that this must be called before enabling metrics collection. Calling
 // this method while metrics collection is enabled returns an error.
+//
+// Experimental: may be changed or removed without notice.
 const SetTimeDomain = "Performance.setTimeDomain"
 
 type SetTimeDomainParams struct {
@@ -35,6 +46,8 @@ type SetTimeDomainResult struct {
 
 }
 
+var SetTimeDomainCommand = cdp.Command[SetTimeDomainParams, SetTimeDomainResult]{Method: SetTimeDomain}
+
 // Retrieve current values of run-time metrics.
 const GetMetrics = "Performance.getMetrics"
 
@@ -45,4 +58,6 @@ type GetMetricsResult struct {
 
 	// Current values for run-time metrics.
 	Metrics 	[]*Metric	`json:"metrics"`
-}
\ No newline at end of file
+}
+
+var GetMetricsCommand = cdp.Command[GetMetricsParams, GetMetricsResult]{Method: GetMetrics}
\ No newline at end of file
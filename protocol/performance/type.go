@@ -1,3 +1,4 @@
+// Package performance implements the Performance domain of the DevTools protocol.
 package performance
 // Run-time execution metric.
 type Metric  struct {
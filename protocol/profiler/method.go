@@ -1,5 +1,10 @@
 package profiler
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // 
 const Disable = "Profiler.disable"
 
@@ -10,6 +15,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // 
 const Enable = "Profiler.enable"
 
@@ -20,6 +27,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Collect coverage data for the current isolate. The coverage data may be incomplete due to
 // garbage collection.
 const GetBestEffortCoverage = "Profiler.getBestEffortCoverage"
@@ -33,6 +42,8 @@ type GetBestEffortCoverageResult struct {
 	Result 	[]*ScriptCoverage	`json:"result"`
 }
 
+var GetBestEffortCoverageCommand = cdp.Command[GetBestEffortCoverageParams, GetBestEffortCoverageResult]{Method: GetBestEffortCoverage}
+
 // Changes CPU profiler sampling interval. Must be called before CPU profiles recording started.
 const SetSamplingInterval = "Profiler.setSamplingInterval"
 
@@ -46,6 +57,8 @@ type SetSamplingIntervalResult struct {
 
 }
 
+var SetSamplingIntervalCommand = cdp.Command[SetSamplingIntervalParams, SetSamplingIntervalResult]{Method: SetSamplingInterval}
+
 // 
 const Start = "Profiler.start"
 
@@ -56,6 +69,8 @@ type StartResult struct {
 
 }
 
+var StartCommand = cdp.Command[StartParams, StartResult]{Method: Start}
+
 // Enable precise code coverage. Coverage data for JavaScript executed before enabling precise code
 // coverage may be incomplete. Enabling prevents running optimized code and resets execution
 // counters.
@@ -74,7 +89,11 @@ type StartPreciseCoverageResult struct {
 
 }
 
+var StartPreciseCoverageCommand = cdp.Command[StartPreciseCoverageParams, StartPreciseCoverageResult]{Method: StartPreciseCoverage}
+
 // Enable type profile.
+//
+// Experimental: may be changed or removed without notice.
 const StartTypeProfile = "Profiler.startTypeProfile"
 
 type StartTypeProfileParams struct {
@@ -84,6 +103,8 @@ type StartTypeProfileResult struct {
 
 }
 
+var StartTypeProfileCommand = cdp.Command[StartTypeProfileParams, StartTypeProfileResult]{Method: StartTypeProfile}
+
 // 
 const Stop = "Profiler.stop"
 
@@ -96,6 +117,8 @@ type StopResult struct {
 	Profile 	Profile	`json:"profile"`
 }
 
+var StopCommand = cdp.Command[StopParams, StopResult]{Method: Stop}
+
 // Disable precise code coverage. Disabling releases unnecessary execution count records and allows
 // executing optimized code.
 const StopPreciseCoverage = "Profiler.stopPreciseCoverage"
@@ -107,7 +130,11 @@ type StopPreciseCoverageResult struct {
 
 }
 
+var StopPreciseCoverageCommand = cdp.Command[StopPreciseCoverageParams, StopPreciseCoverageResult]{Method: StopPreciseCoverage}
+
 // Disable type profile. Disabling releases type profile data collected so far.
+//
+// Experimental: may be changed or removed without notice.
 const StopTypeProfile = "Profiler.stopTypeProfile"
 
 type StopTypeProfileParams struct {
@@ -117,6 +144,8 @@ type StopTypeProfileResult struct {
 
 }
 
+var StopTypeProfileCommand = cdp.Command[StopTypeProfileParams, StopTypeProfileResult]{Method: StopTypeProfile}
+
 // Collect coverage data for the current isolate, and resets execution counters. Precise code
 // coverage needs to have started.
 const TakePreciseCoverage = "Profiler.takePreciseCoverage"
@@ -130,7 +159,11 @@ type TakePreciseCoverageResult struct {
 	Result 	[]*ScriptCoverage	`json:"result"`
 }
 
+var TakePreciseCoverageCommand = cdp.Command[TakePreciseCoverageParams, TakePreciseCoverageResult]{Method: TakePreciseCoverage}
+
 // Collect type profile.
+//
+// Experimental: may be changed or removed without notice.
 const TakeTypeProfile = "Profiler.takeTypeProfile"
 
 type TakeTypeProfileParams struct {
@@ -140,4 +173,6 @@ type TakeTypeProfileResult struct {
 
 	// Type profile for all scripts since startTypeProfile() was turned on.
 	Result 	[]*ScriptTypeProfile	`json:"result"`
-}
\ No newline at end of file
+}
+
+var TakeTypeProfileCommand = cdp.Command[TakeTypeProfileParams, TakeTypeProfileResult]{Method: TakeTypeProfile}
\ No newline at end of file
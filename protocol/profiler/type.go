@@ -1,3 +1,4 @@
+// Package profiler implements the Profiler domain of the DevTools protocol.
 package profiler
 
 import (
@@ -97,6 +98,8 @@ type ScriptCoverage  struct {
 }
 
 // Describes a type collected during runtime.
+//
+// Experimental: may be changed or removed without notice.
 type TypeObject  struct {
 
 	// Name of a type collected with type profiling.
@@ -104,6 +107,8 @@ type TypeObject  struct {
 }
 
 // Source offset and types for a parameter or return value.
+//
+// Experimental: may be changed or removed without notice.
 type TypeProfileEntry  struct {
 
 	// Source offset of the parameter or end of function for return values.
@@ -114,6 +119,8 @@ type TypeProfileEntry  struct {
 }
 
 // Type profile data collected during runtime for a JavaScript script.
+//
+// Experimental: may be changed or removed without notice.
 type ScriptTypeProfile  struct {
 
 	// JavaScript script id.
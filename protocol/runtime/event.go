@@ -1,6 +1,8 @@
 package runtime
 
 // Notification is issued every time when binding is called.
+//
+// Experimental: may be changed or removed without notice.
 const BindingCalledEvent = "Runtime.bindingCalled"
 type BindingCalledParams struct {
 
@@ -32,6 +34,8 @@ type ConsoleAPICalledParams struct {
 	// Console context descriptor for calls on non-default console context (not console.*):
 	// 'anonymous#unique-logger-id' for call on unnamed context, 'name#unique-logger-id' for call
 	// on named context.
+	//
+	// Experimental: may be changed or removed without notice.
 	Context 	string}
 
 
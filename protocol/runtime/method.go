@@ -1,5 +1,10 @@
 package runtime
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Add handler to promise with given promise object id.
 const AwaitPromise = "Runtime.awaitPromise"
 
@@ -23,6 +28,8 @@ type AwaitPromiseResult struct {
 	ExceptionDetails 	ExceptionDetails	`json:"exceptionDetails"`
 }
 
+var AwaitPromiseCommand = cdp.Command[AwaitPromiseParams, AwaitPromiseResult]{Method: AwaitPromise}
+
 // Calls function with given declaration on the given object. Object group of the result is
 // inherited from the target object.
 const CallFunctionOn = "Runtime.callFunctionOn"
@@ -48,6 +55,8 @@ type CallFunctionOnParams struct {
 	ReturnByValue 	bool	`json:"returnByValue,omitempty"`
 
 	// Whether preview should be generated for the result.
+	//
+	// Experimental: may be changed or removed without notice.
 	GeneratePreview 	bool	`json:"generatePreview,omitempty"`
 
 	// Whether execution should be treated as initiated by user in the UI.
@@ -74,6 +83,8 @@ type CallFunctionOnResult struct {
 	ExceptionDetails 	ExceptionDetails	`json:"exceptionDetails"`
 }
 
+var CallFunctionOnCommand = cdp.Command[CallFunctionOnParams, CallFunctionOnResult]{Method: CallFunctionOn}
+
 // Compiles expression.
 const CompileScript = "Runtime.compileScript"
 
@@ -101,6 +112,8 @@ type CompileScriptResult struct {
 	ExceptionDetails 	ExceptionDetails	`json:"exceptionDetails"`
 }
 
+var CompileScriptCommand = cdp.Command[CompileScriptParams, CompileScriptResult]{Method: CompileScript}
+
 // Disables reporting of execution contexts creation.
 const Disable = "Runtime.disable"
 
@@ -111,6 +124,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Discards collected exceptions and console API calls.
 const DiscardConsoleEntries = "Runtime.discardConsoleEntries"
 
@@ -121,6 +136,8 @@ type DiscardConsoleEntriesResult struct {
 
 }
 
+var DiscardConsoleEntriesCommand = cdp.Command[DiscardConsoleEntriesParams, DiscardConsoleEntriesResult]{Method: DiscardConsoleEntries}
+
 // Enables reporting of execution contexts creation by means of `executionContextCreated` event.
 // When the reporting gets enabled the event will be sent immediately for each existing execution
 // context.
@@ -133,6 +150,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Evaluates expression on global object.
 const Evaluate = "Runtime.evaluate"
 
@@ -159,6 +178,8 @@ type EvaluateParams struct {
 	ReturnByValue 	bool	`json:"returnByValue,omitempty"`
 
 	// Whether preview should be generated for the result.
+	//
+	// Experimental: may be changed or removed without notice.
 	GeneratePreview 	bool	`json:"generatePreview,omitempty"`
 
 	// Whether execution should be treated as initiated by user in the UI.
@@ -170,12 +191,18 @@ type EvaluateParams struct {
 
 	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
 	// This implies `disableBreaks` below.
+	//
+	// Experimental: may be changed or removed without notice.
 	ThrowOnSideEffect 	bool	`json:"throwOnSideEffect,omitempty"`
 
 	// Terminate execution after timing out (number of milliseconds).
+	//
+	// Experimental: may be changed or removed without notice.
 	Timeout 	TimeDelta	`json:"timeout,omitempty"`
 
 	// Disable breakpoints during execution.
+	//
+	// Experimental: may be changed or removed without notice.
 	DisableBreaks 	bool	`json:"disableBreaks,omitempty"`
 }
 
@@ -187,7 +214,11 @@ type EvaluateResult struct {
 	ExceptionDetails 	ExceptionDetails	`json:"exceptionDetails"`
 }
 
+var EvaluateCommand = cdp.Command[EvaluateParams, EvaluateResult]{Method: Evaluate}
+
 // Returns the isolate id.
+//
+// Experimental: may be changed or removed without notice.
 const GetIsolateId = "Runtime.getIsolateId"
 
 type GetIsolateIdParams struct {
@@ -199,8 +230,12 @@ type GetIsolateIdResult struct {
 	Id 	string	`json:"id"`
 }
 
+var GetIsolateIdCommand = cdp.Command[GetIsolateIdParams, GetIsolateIdResult]{Method: GetIsolateId}
+
 // Returns the JavaScript heap usage.
 // It is the total usage of the corresponding isolate not scoped to a particular Runtime.
+//
+// Experimental: may be changed or removed without notice.
 const GetHeapUsage = "Runtime.getHeapUsage"
 
 type GetHeapUsageParams struct {
@@ -214,6 +249,8 @@ type GetHeapUsageResult struct {
 	TotalSize 	float64	`json:"totalSize"`
 }
 
+var GetHeapUsageCommand = cdp.Command[GetHeapUsageParams, GetHeapUsageResult]{Method: GetHeapUsage}
+
 // Returns properties of a given object. Object group of the result is inherited from the target
 // object.
 const GetProperties = "Runtime.getProperties"
@@ -229,9 +266,13 @@ type GetPropertiesParams struct {
 
 	// If true, returns accessor properties (with getter/setter) only; internal properties are not
 	// returned either.
+	//
+	// Experimental: may be changed or removed without notice.
 	AccessorPropertiesOnly 	bool	`json:"accessorPropertiesOnly,omitempty"`
 
 	// Whether preview should be generated for the results.
+	//
+	// Experimental: may be changed or removed without notice.
 	GeneratePreview 	bool	`json:"generatePreview,omitempty"`
 }
 
@@ -242,11 +283,15 @@ type GetPropertiesResult struct {
 	// Internal object properties (only of the element itself).
 	InternalProperties 	[]*InternalPropertyDescriptor	`json:"internalProperties"`
 	// Object private properties.
+	//
+	// Experimental: may be changed or removed without notice.
 	PrivateProperties 	[]*PrivatePropertyDescriptor	`json:"privateProperties"`
 	// Exception details.
 	ExceptionDetails 	ExceptionDetails	`json:"exceptionDetails"`
 }
 
+var GetPropertiesCommand = cdp.Command[GetPropertiesParams, GetPropertiesResult]{Method: GetProperties}
+
 // Returns all let, const and class variables from global scope.
 const GlobalLexicalScopeNames = "Runtime.globalLexicalScopeNames"
 
@@ -262,6 +307,8 @@ type GlobalLexicalScopeNamesResult struct {
 	Names 	[]string	`json:"names"`
 }
 
+var GlobalLexicalScopeNamesCommand = cdp.Command[GlobalLexicalScopeNamesParams, GlobalLexicalScopeNamesResult]{Method: GlobalLexicalScopeNames}
+
 // 
 const QueryObjects = "Runtime.queryObjects"
 
@@ -280,6 +327,8 @@ type QueryObjectsResult struct {
 	Objects 	RemoteObject	`json:"objects"`
 }
 
+var QueryObjectsCommand = cdp.Command[QueryObjectsParams, QueryObjectsResult]{Method: QueryObjects}
+
 // Releases remote object with given id.
 const ReleaseObject = "Runtime.releaseObject"
 
@@ -293,6 +342,8 @@ type ReleaseObjectResult struct {
 
 }
 
+var ReleaseObjectCommand = cdp.Command[ReleaseObjectParams, ReleaseObjectResult]{Method: ReleaseObject}
+
 // Releases all remote objects that belong to a given group.
 const ReleaseObjectGroup = "Runtime.releaseObjectGroup"
 
@@ -306,6 +357,8 @@ type ReleaseObjectGroupResult struct {
 
 }
 
+var ReleaseObjectGroupCommand = cdp.Command[ReleaseObjectGroupParams, ReleaseObjectGroupResult]{Method: ReleaseObjectGroup}
+
 // Tells inspected instance to run if it was waiting for debugger to attach.
 const RunIfWaitingForDebugger = "Runtime.runIfWaitingForDebugger"
 
@@ -316,6 +369,8 @@ type RunIfWaitingForDebuggerResult struct {
 
 }
 
+var RunIfWaitingForDebuggerCommand = cdp.Command[RunIfWaitingForDebuggerParams, RunIfWaitingForDebuggerResult]{Method: RunIfWaitingForDebugger}
+
 // Runs script with given id in a given context.
 const RunScript = "Runtime.runScript"
 
@@ -357,6 +412,8 @@ type RunScriptResult struct {
 	ExceptionDetails 	ExceptionDetails	`json:"exceptionDetails"`
 }
 
+var RunScriptCommand = cdp.Command[RunScriptParams, RunScriptResult]{Method: RunScript}
+
 // Enables or disables async call stacks tracking.
 const SetAsyncCallStackDepth = "Runtime.setAsyncCallStackDepth"
 
@@ -371,7 +428,11 @@ type SetAsyncCallStackDepthResult struct {
 
 }
 
+var SetAsyncCallStackDepthCommand = cdp.Command[SetAsyncCallStackDepthParams, SetAsyncCallStackDepthResult]{Method: SetAsyncCallStackDepth}
+
 // 
+//
+// Experimental: may be changed or removed without notice.
 const SetCustomObjectFormatterEnabled = "Runtime.setCustomObjectFormatterEnabled"
 
 type SetCustomObjectFormatterEnabledParams struct {
@@ -384,7 +445,11 @@ type SetCustomObjectFormatterEnabledResult struct {
 
 }
 
+var SetCustomObjectFormatterEnabledCommand = cdp.Command[SetCustomObjectFormatterEnabledParams, SetCustomObjectFormatterEnabledResult]{Method: SetCustomObjectFormatterEnabled}
+
 // 
+//
+// Experimental: may be changed or removed without notice.
 const SetMaxCallStackSizeToCapture = "Runtime.setMaxCallStackSizeToCapture"
 
 type SetMaxCallStackSizeToCaptureParams struct {
@@ -397,8 +462,12 @@ type SetMaxCallStackSizeToCaptureResult struct {
 
 }
 
+var SetMaxCallStackSizeToCaptureCommand = cdp.Command[SetMaxCallStackSizeToCaptureParams, SetMaxCallStackSizeToCaptureResult]{Method: SetMaxCallStackSizeToCapture}
+
 // Terminate current or next JavaScript execution.
 // Will cancel the termination when the outer-most script execution ends.
+//
+// Experimental: may be changed or removed without notice.
 const TerminateExecution = "Runtime.terminateExecution"
 
 type TerminateExecutionParams struct {
@@ -408,6 +477,8 @@ type TerminateExecutionResult struct {
 
 }
 
+var TerminateExecutionCommand = cdp.Command[TerminateExecutionParams, TerminateExecutionResult]{Method: TerminateExecution}
+
 // If executionContextId is empty, adds binding with the given name on the
 // global objects of all inspected contexts, including those created later,
 // bindings survive reloads.
@@ -416,6 +487,8 @@ type TerminateExecutionResult struct {
 // Binding function takes exactly one argument, this argument should be string,
 // in case of any other input, function throws an exception.
 // Each binding function call produces Runtime.bindingCalled notification.
+//
+// Experimental: may be changed or removed without notice.
 const AddBinding = "Runtime.addBinding"
 
 type AddBindingParams struct {
@@ -431,8 +504,12 @@ type AddBindingResult struct {
 
 }
 
+var AddBindingCommand = cdp.Command[AddBindingParams, AddBindingResult]{Method: AddBinding}
+
 // This method does not remove binding function from global object but
 // unsubscribes current runtime agent from Runtime.bindingCalled notifications.
+//
+// Experimental: may be changed or removed without notice.
 const RemoveBinding = "Runtime.removeBinding"
 
 type RemoveBindingParams struct {
@@ -443,4 +520,6 @@ type RemoveBindingParams struct {
 
 type RemoveBindingResult struct {
 
-}
\ No newline at end of file
+}
+
+var RemoveBindingCommand = cdp.Command[RemoveBindingParams, RemoveBindingResult]{Method: RemoveBinding}
\ No newline at end of file
@@ -1,3 +1,10 @@
+// Package runtime implements the Runtime domain of the DevTools protocol.
+//
+// Runtime domain exposes JavaScript runtime by means of remote evaluation and mirror objects.
+// Evaluation results are returned as mirror object that expose object type, string representation
+// and unique identifier that can be used for further object reference. Original objects are
+// maintained in memory unless they are either explicitly released or are released along with the
+// other objects in their object group.
 package runtime
 // Unique script identifier.
 type ScriptId string
@@ -35,13 +42,19 @@ type RemoteObject  struct {
 	ObjectId	RemoteObjectId	`json:"objectId,omitempty"`
 
 	// Preview containing abbreviated property values. Specified for `object` type values only.
+	//
+	// Experimental: may be changed or removed without notice.
 	Preview	ObjectPreview	`json:"preview,omitempty"`
 
 	// 
+	//
+	// Experimental: may be changed or removed without notice.
 	CustomPreview	CustomPreview	`json:"customPreview,omitempty"`
 }
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type CustomPreview  struct {
 
 	// The JSON-stringified result of formatter.header(object, config) call.
@@ -55,6 +68,8 @@ type CustomPreview  struct {
 }
 
 // Object containing abbreviated remote object value.
+//
+// Experimental: may be changed or removed without notice.
 type ObjectPreview  struct {
 
 	// Object type.
@@ -77,6 +92,8 @@ type ObjectPreview  struct {
 }
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type PropertyPreview  struct {
 
 	// Property name.
@@ -96,6 +113,8 @@ type PropertyPreview  struct {
 }
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type EntryPreview  struct {
 
 	// Preview of the key. Specified for map-like collection entries.
@@ -154,6 +173,8 @@ type InternalPropertyDescriptor  struct {
 }
 
 // Object private field descriptor.
+//
+// Experimental: may be changed or removed without notice.
 type PrivatePropertyDescriptor  struct {
 
 	// Private property name.
@@ -268,14 +289,20 @@ type StackTrace  struct {
 	Parent	*StackTrace	`json:"parent,omitempty"`
 
 	// Asynchronous JavaScript stack trace that preceded this stack, if available.
+	//
+	// Experimental: may be changed or removed without notice.
 	ParentId	StackTraceId	`json:"parentId,omitempty"`
 }
 
 // Unique identifier of current debugger.
+//
+// Experimental: may be changed or removed without notice.
 type UniqueDebuggerId string
 
 // If `debuggerId` is set stack trace comes from another debugger and can be resolved there. This
 	// allows to track cross-debugger calls. See `Runtime.StackTrace` and `Debugger.paused` for usages.
+//
+// Experimental: may be changed or removed without notice.
 type StackTraceId  struct {
 
 	// 
@@ -1,5 +1,10 @@
 package schema
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Returns supported domains.
 const GetDomains = "Schema.getDomains"
 
@@ -10,4 +15,6 @@ type GetDomainsResult struct {
 
 	// List of supported domains.
 	Domains 	[]*Domain	`json:"domains"`
-}
\ No newline at end of file
+}
+
+var GetDomainsCommand = cdp.Command[GetDomainsParams, GetDomainsResult]{Method: GetDomains}
\ No newline at end of file
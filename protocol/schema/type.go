@@ -1,3 +1,8 @@
+// Package schema implements the Schema domain of the DevTools protocol.
+//
+// This domain is deprecated.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 package schema
 // Description of the protocol domain.
 type Domain  struct {
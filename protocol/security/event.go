@@ -4,6 +4,8 @@ package security
 // handled with the `handleCertificateError` command. Note: this event does not fire if the
 // certificate error has been allowed internally. Only one client per target should override
 // certificate errors at the same time.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const CertificateErrorEvent = "Security.certificateError"
 type CertificateErrorParams struct {
 
@@ -17,6 +19,8 @@ type CertificateErrorParams struct {
 
 
 // The security state of the page changed.
+//
+// Experimental: may be changed or removed without notice.
 const VisibleSecurityStateChangedEvent = "Security.visibleSecurityStateChanged"
 type VisibleSecurityStateChangedParams struct {
 
@@ -32,11 +36,15 @@ type SecurityStateChangedParams struct {
 	// Security state.
 	SecurityState 	SecurityState
 	// True if the page was loaded over cryptographic transport such as HTTPS.
+	//
+	// Deprecated: marked as deprecated by the DevTools protocol.
 	SchemeIsCryptographic 	bool
 	// List of explanations for the security state. If the overall security state is `insecure` or
 	// `warning`, at least one corresponding explanation should be included.
 	Explanations 	[]*SecurityStateExplanation
 	// Information about insecure content on the page.
+	//
+	// Deprecated: marked as deprecated by the DevTools protocol.
 	InsecureContentStatus 	InsecureContentStatus
 	// Overrides user-visible description of the state.
 	Summary 	string}
@@ -1,5 +1,10 @@
 package security
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Disables tracking security state changes.
 const Disable = "Security.disable"
 
@@ -10,6 +15,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Enables tracking security state changes.
 const Enable = "Security.enable"
 
@@ -20,7 +27,11 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Enable/disable whether all certificate errors should be ignored.
+//
+// Experimental: may be changed or removed without notice.
 const SetIgnoreCertificateErrors = "Security.setIgnoreCertificateErrors"
 
 type SetIgnoreCertificateErrorsParams struct {
@@ -33,7 +44,11 @@ type SetIgnoreCertificateErrorsResult struct {
 
 }
 
+var SetIgnoreCertificateErrorsCommand = cdp.Command[SetIgnoreCertificateErrorsParams, SetIgnoreCertificateErrorsResult]{Method: SetIgnoreCertificateErrors}
+
 // Handles a certificate error that fired a certificateError event.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const HandleCertificateError = "Security.handleCertificateError"
 
 type HandleCertificateErrorParams struct {
@@ -49,8 +64,12 @@ type HandleCertificateErrorResult struct {
 
 }
 
+var HandleCertificateErrorCommand = cdp.Command[HandleCertificateErrorParams, HandleCertificateErrorResult]{Method: HandleCertificateError}
+
 // Enable/disable overriding certificate errors. If enabled, all certificate error events need to
 // be handled by the DevTools client and should be answered with `handleCertificateError` commands.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const SetOverrideCertificateErrors = "Security.setOverrideCertificateErrors"
 
 type SetOverrideCertificateErrorsParams struct {
@@ -61,4 +80,6 @@ type SetOverrideCertificateErrorsParams struct {
 
 type SetOverrideCertificateErrorsResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetOverrideCertificateErrorsCommand = cdp.Command[SetOverrideCertificateErrorsParams, SetOverrideCertificateErrorsResult]{Method: SetOverrideCertificateErrors}
\ No newline at end of file
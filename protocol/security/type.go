@@ -1,3 +1,6 @@
+// Package security implements the Security domain of the DevTools protocol.
+//
+// Security
 package security
 
 import (
@@ -15,6 +18,8 @@ type MixedContentType string
 type SecurityState string
 
 // Details about the security state of the page certificate.
+//
+// Experimental: may be changed or removed without notice.
 type CertificateSecurityState  struct {
 
 	// Protocol name (e.g. "TLS 1.2" or "QUIC").
@@ -67,6 +72,8 @@ type CertificateSecurityState  struct {
 }
 
 // Security state information about the page.
+//
+// Experimental: may be changed or removed without notice.
 type VisibleSecurityState  struct {
 
 	// The security level of the page.
@@ -105,6 +112,8 @@ type SecurityStateExplanation  struct {
 }
 
 // Information about insecure content on the page.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 type InsecureContentStatus  struct {
 
 	// Always false.
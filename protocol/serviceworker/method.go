@@ -1,5 +1,10 @@
 package serviceworker
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // 
 const DeliverPushMessage = "ServiceWorker.deliverPushMessage"
 
@@ -19,6 +24,8 @@ type DeliverPushMessageResult struct {
 
 }
 
+var DeliverPushMessageCommand = cdp.Command[DeliverPushMessageParams, DeliverPushMessageResult]{Method: DeliverPushMessage}
+
 // 
 const Disable = "ServiceWorker.disable"
 
@@ -29,6 +36,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // 
 const DispatchSyncEvent = "ServiceWorker.dispatchSyncEvent"
 
@@ -51,6 +60,8 @@ type DispatchSyncEventResult struct {
 
 }
 
+var DispatchSyncEventCommand = cdp.Command[DispatchSyncEventParams, DispatchSyncEventResult]{Method: DispatchSyncEvent}
+
 // 
 const DispatchPeriodicSyncEvent = "ServiceWorker.dispatchPeriodicSyncEvent"
 
@@ -70,6 +81,8 @@ type DispatchPeriodicSyncEventResult struct {
 
 }
 
+var DispatchPeriodicSyncEventCommand = cdp.Command[DispatchPeriodicSyncEventParams, DispatchPeriodicSyncEventResult]{Method: DispatchPeriodicSyncEvent}
+
 // 
 const Enable = "ServiceWorker.enable"
 
@@ -80,6 +93,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // 
 const InspectWorker = "ServiceWorker.inspectWorker"
 
@@ -93,6 +108,8 @@ type InspectWorkerResult struct {
 
 }
 
+var InspectWorkerCommand = cdp.Command[InspectWorkerParams, InspectWorkerResult]{Method: InspectWorker}
+
 // 
 const SetForceUpdateOnPageLoad = "ServiceWorker.setForceUpdateOnPageLoad"
 
@@ -106,6 +123,8 @@ type SetForceUpdateOnPageLoadResult struct {
 
 }
 
+var SetForceUpdateOnPageLoadCommand = cdp.Command[SetForceUpdateOnPageLoadParams, SetForceUpdateOnPageLoadResult]{Method: SetForceUpdateOnPageLoad}
+
 // 
 const SkipWaiting = "ServiceWorker.skipWaiting"
 
@@ -119,6 +138,8 @@ type SkipWaitingResult struct {
 
 }
 
+var SkipWaitingCommand = cdp.Command[SkipWaitingParams, SkipWaitingResult]{Method: SkipWaiting}
+
 // 
 const StartWorker = "ServiceWorker.startWorker"
 
@@ -132,6 +153,8 @@ type StartWorkerResult struct {
 
 }
 
+var StartWorkerCommand = cdp.Command[StartWorkerParams, StartWorkerResult]{Method: StartWorker}
+
 // 
 const StopAllWorkers = "ServiceWorker.stopAllWorkers"
 
@@ -142,6 +165,8 @@ type StopAllWorkersResult struct {
 
 }
 
+var StopAllWorkersCommand = cdp.Command[StopAllWorkersParams, StopAllWorkersResult]{Method: StopAllWorkers}
+
 // 
 const StopWorker = "ServiceWorker.stopWorker"
 
@@ -155,6 +180,8 @@ type StopWorkerResult struct {
 
 }
 
+var StopWorkerCommand = cdp.Command[StopWorkerParams, StopWorkerResult]{Method: StopWorker}
+
 // 
 const Unregister = "ServiceWorker.unregister"
 
@@ -168,6 +195,8 @@ type UnregisterResult struct {
 
 }
 
+var UnregisterCommand = cdp.Command[UnregisterParams, UnregisterResult]{Method: Unregister}
+
 // 
 const UpdateRegistration = "ServiceWorker.updateRegistration"
 
@@ -179,4 +208,6 @@ type UpdateRegistrationParams struct {
 
 type UpdateRegistrationResult struct {
 
-}
\ No newline at end of file
+}
+
+var UpdateRegistrationCommand = cdp.Command[UpdateRegistrationParams, UpdateRegistrationResult]{Method: UpdateRegistration}
\ No newline at end of file
@@ -1,3 +1,6 @@
+// Package serviceworker implements the ServiceWorker domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package serviceworker
 
 import (
@@ -0,0 +1,24 @@
+// Package browser exposes the stable subset of the Browser domain of the DevTools protocol.
+//
+// The Browser domain defines methods and events for browser managing.
+package browser
+
+import proto "github.com/diiyw/cuto/protocol/browser"
+
+// Close browser gracefully.
+const Close = proto.Close
+
+type CloseParams = proto.CloseParams
+
+type CloseResult = proto.CloseResult
+
+var CloseCommand = proto.CloseCommand
+
+// Returns version information.
+const GetVersion = proto.GetVersion
+
+type GetVersionParams = proto.GetVersionParams
+
+type GetVersionResult = proto.GetVersionResult
+
+var GetVersionCommand = proto.GetVersionCommand
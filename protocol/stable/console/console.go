@@ -0,0 +1,44 @@
+// Package console exposes the stable subset of the Console domain of the DevTools protocol.
+//
+// This domain is deprecated - use Runtime or Log instead.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+package console
+
+import proto "github.com/diiyw/cuto/protocol/console"
+
+// Console message.
+type ConsoleMessage = proto.ConsoleMessage
+
+// Does nothing.
+const ClearMessages = proto.ClearMessages
+
+type ClearMessagesParams = proto.ClearMessagesParams
+
+type ClearMessagesResult = proto.ClearMessagesResult
+
+var ClearMessagesCommand = proto.ClearMessagesCommand
+
+// Disables console domain, prevents further console messages from being reported to the client.
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// Enables console domain, sends the messages collected so far to the client by means of the
+// `messageAdded` notification.
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// Issued when new console message is added.
+const MessageAddedEvent = proto.MessageAddedEvent
+
+type MessageAddedParams = proto.MessageAddedParams
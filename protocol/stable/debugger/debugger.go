@@ -0,0 +1,277 @@
+// Package debugger exposes the stable subset of the Debugger domain of the DevTools protocol.
+//
+// Debugger domain exposes JavaScript debugging capabilities. It allows setting and removing
+// breakpoints, stepping through execution, exploring stack traces, etc.
+package debugger
+
+import proto "github.com/diiyw/cuto/protocol/debugger"
+
+// Breakpoint identifier.
+type BreakpointId = proto.BreakpointId
+
+// Call frame identifier.
+type CallFrameId = proto.CallFrameId
+
+// Location in the source code.
+type Location = proto.Location
+
+// JavaScript call frame. Array of call frames form the call stack.
+type CallFrame = proto.CallFrame
+
+// Scope description.
+type Scope = proto.Scope
+
+// Search match for resource.
+type SearchMatch = proto.SearchMatch
+
+// 
+type BreakLocation = proto.BreakLocation
+
+// Continues execution until specific location is reached.
+const ContinueToLocation = proto.ContinueToLocation
+
+type ContinueToLocationParams = proto.ContinueToLocationParams
+
+type ContinueToLocationResult = proto.ContinueToLocationResult
+
+var ContinueToLocationCommand = proto.ContinueToLocationCommand
+
+// Disables debugger for given page.
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// Enables debugger for the given page. Clients should not assume that the debugging has been
+// enabled until the result for this command is received.
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// Evaluates expression on a given call frame.
+const EvaluateOnCallFrame = proto.EvaluateOnCallFrame
+
+type EvaluateOnCallFrameParams = proto.EvaluateOnCallFrameParams
+
+type EvaluateOnCallFrameResult = proto.EvaluateOnCallFrameResult
+
+var EvaluateOnCallFrameCommand = proto.EvaluateOnCallFrameCommand
+
+// Returns possible locations for breakpoint. scriptId in start and end range locations should be
+// the same.
+const GetPossibleBreakpoints = proto.GetPossibleBreakpoints
+
+type GetPossibleBreakpointsParams = proto.GetPossibleBreakpointsParams
+
+type GetPossibleBreakpointsResult = proto.GetPossibleBreakpointsResult
+
+var GetPossibleBreakpointsCommand = proto.GetPossibleBreakpointsCommand
+
+// Returns source for the script with given id.
+const GetScriptSource = proto.GetScriptSource
+
+type GetScriptSourceParams = proto.GetScriptSourceParams
+
+type GetScriptSourceResult = proto.GetScriptSourceResult
+
+var GetScriptSourceCommand = proto.GetScriptSourceCommand
+
+// Returns bytecode for the WebAssembly script with given id.
+const GetWasmBytecode = proto.GetWasmBytecode
+
+type GetWasmBytecodeParams = proto.GetWasmBytecodeParams
+
+type GetWasmBytecodeResult = proto.GetWasmBytecodeResult
+
+var GetWasmBytecodeCommand = proto.GetWasmBytecodeCommand
+
+// Stops on the next JavaScript statement.
+const Pause = proto.Pause
+
+type PauseParams = proto.PauseParams
+
+type PauseResult = proto.PauseResult
+
+var PauseCommand = proto.PauseCommand
+
+// Removes JavaScript breakpoint.
+const RemoveBreakpoint = proto.RemoveBreakpoint
+
+type RemoveBreakpointParams = proto.RemoveBreakpointParams
+
+type RemoveBreakpointResult = proto.RemoveBreakpointResult
+
+var RemoveBreakpointCommand = proto.RemoveBreakpointCommand
+
+// Restarts particular call frame from the beginning.
+const RestartFrame = proto.RestartFrame
+
+type RestartFrameParams = proto.RestartFrameParams
+
+type RestartFrameResult = proto.RestartFrameResult
+
+var RestartFrameCommand = proto.RestartFrameCommand
+
+// Resumes JavaScript execution.
+const Resume = proto.Resume
+
+type ResumeParams = proto.ResumeParams
+
+type ResumeResult = proto.ResumeResult
+
+var ResumeCommand = proto.ResumeCommand
+
+// Searches for given string in script content.
+const SearchInContent = proto.SearchInContent
+
+type SearchInContentParams = proto.SearchInContentParams
+
+type SearchInContentResult = proto.SearchInContentResult
+
+var SearchInContentCommand = proto.SearchInContentCommand
+
+// Enables or disables async call stacks tracking.
+const SetAsyncCallStackDepth = proto.SetAsyncCallStackDepth
+
+type SetAsyncCallStackDepthParams = proto.SetAsyncCallStackDepthParams
+
+type SetAsyncCallStackDepthResult = proto.SetAsyncCallStackDepthResult
+
+var SetAsyncCallStackDepthCommand = proto.SetAsyncCallStackDepthCommand
+
+// Sets JavaScript breakpoint at a given location.
+const SetBreakpoint = proto.SetBreakpoint
+
+type SetBreakpointParams = proto.SetBreakpointParams
+
+type SetBreakpointResult = proto.SetBreakpointResult
+
+var SetBreakpointCommand = proto.SetBreakpointCommand
+
+// Sets instrumentation breakpoint.
+const SetInstrumentationBreakpoint = proto.SetInstrumentationBreakpoint
+
+type SetInstrumentationBreakpointParams = proto.SetInstrumentationBreakpointParams
+
+type SetInstrumentationBreakpointResult = proto.SetInstrumentationBreakpointResult
+
+var SetInstrumentationBreakpointCommand = proto.SetInstrumentationBreakpointCommand
+
+// Sets JavaScript breakpoint at given location specified either by URL or URL regex. Once this
+// command is issued, all existing parsed scripts will have breakpoints resolved and returned in
+// `locations` property. Further matching script parsing will result in subsequent
+// `breakpointResolved` events issued. This logical breakpoint will survive page reloads.
+const SetBreakpointByUrl = proto.SetBreakpointByUrl
+
+type SetBreakpointByUrlParams = proto.SetBreakpointByUrlParams
+
+type SetBreakpointByUrlResult = proto.SetBreakpointByUrlResult
+
+var SetBreakpointByUrlCommand = proto.SetBreakpointByUrlCommand
+
+// Activates / deactivates all breakpoints on the page.
+const SetBreakpointsActive = proto.SetBreakpointsActive
+
+type SetBreakpointsActiveParams = proto.SetBreakpointsActiveParams
+
+type SetBreakpointsActiveResult = proto.SetBreakpointsActiveResult
+
+var SetBreakpointsActiveCommand = proto.SetBreakpointsActiveCommand
+
+// Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions or
+// no exceptions. Initial pause on exceptions state is `none`.
+const SetPauseOnExceptions = proto.SetPauseOnExceptions
+
+type SetPauseOnExceptionsParams = proto.SetPauseOnExceptionsParams
+
+type SetPauseOnExceptionsResult = proto.SetPauseOnExceptionsResult
+
+var SetPauseOnExceptionsCommand = proto.SetPauseOnExceptionsCommand
+
+// Edits JavaScript source live.
+const SetScriptSource = proto.SetScriptSource
+
+type SetScriptSourceParams = proto.SetScriptSourceParams
+
+type SetScriptSourceResult = proto.SetScriptSourceResult
+
+var SetScriptSourceCommand = proto.SetScriptSourceCommand
+
+// Makes page not interrupt on any pauses (breakpoint, exception, dom exception etc).
+const SetSkipAllPauses = proto.SetSkipAllPauses
+
+type SetSkipAllPausesParams = proto.SetSkipAllPausesParams
+
+type SetSkipAllPausesResult = proto.SetSkipAllPausesResult
+
+var SetSkipAllPausesCommand = proto.SetSkipAllPausesCommand
+
+// Changes value of variable in a callframe. Object-based scopes are not supported and must be
+// mutated manually.
+const SetVariableValue = proto.SetVariableValue
+
+type SetVariableValueParams = proto.SetVariableValueParams
+
+type SetVariableValueResult = proto.SetVariableValueResult
+
+var SetVariableValueCommand = proto.SetVariableValueCommand
+
+// Steps into the function call.
+const StepInto = proto.StepInto
+
+type StepIntoParams = proto.StepIntoParams
+
+type StepIntoResult = proto.StepIntoResult
+
+var StepIntoCommand = proto.StepIntoCommand
+
+// Steps out of the function call.
+const StepOut = proto.StepOut
+
+type StepOutParams = proto.StepOutParams
+
+type StepOutResult = proto.StepOutResult
+
+var StepOutCommand = proto.StepOutCommand
+
+// Steps over the statement.
+const StepOver = proto.StepOver
+
+type StepOverParams = proto.StepOverParams
+
+type StepOverResult = proto.StepOverResult
+
+var StepOverCommand = proto.StepOverCommand
+
+// Fired when breakpoint is resolved to an actual script and location.
+const BreakpointResolvedEvent = proto.BreakpointResolvedEvent
+
+type BreakpointResolvedParams = proto.BreakpointResolvedParams
+
+// Fired when the virtual machine stopped on breakpoint or exception or any other stop criteria.
+const PausedEvent = proto.PausedEvent
+
+type PausedParams = proto.PausedParams
+
+// Fired when the virtual machine resumed execution.
+const ResumedEvent = proto.ResumedEvent
+
+type ResumedParams = proto.ResumedParams
+
+// Fired when virtual machine fails to parse the script.
+const ScriptFailedToParseEvent = proto.ScriptFailedToParseEvent
+
+type ScriptFailedToParseParams = proto.ScriptFailedToParseParams
+
+// Fired when virtual machine parses script. This event is also fired for all known and uncollected
+// scripts upon enabling debugger.
+const ScriptParsedEvent = proto.ScriptParsedEvent
+
+type ScriptParsedParams = proto.ScriptParsedParams
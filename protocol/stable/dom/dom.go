@@ -0,0 +1,338 @@
+// Package dom exposes the stable subset of the DOM domain of the DevTools protocol.
+//
+// This domain exposes DOM read/write operations. Each DOM Node is represented with its mirror object
+// that has an `id`. This `id` can be used to get additional information on the Node, resolve it into
+// the JavaScript object wrapper, etc. It is important that client receives DOM events only for the
+// nodes that are known to the client. Backend keeps track of the nodes that were sent to the client
+// and never sends the same node twice. It is client's responsibility to collect information about
+// the nodes that were sent to the client.<p>Note that `iframe` owner elements will return
+// corresponding document elements as their child nodes.</p>
+package dom
+
+import proto "github.com/diiyw/cuto/protocol/dom"
+
+// Unique DOM node identifier.
+type NodeId = proto.NodeId
+
+// Unique DOM node identifier used to reference a node that may not have been pushed to the
+// front-end.
+type BackendNodeId = proto.BackendNodeId
+
+// Backend node with a friendly name.
+type BackendNode = proto.BackendNode
+
+// Pseudo element type.
+type PseudoType = proto.PseudoType
+
+// Shadow root type.
+type ShadowRootType = proto.ShadowRootType
+
+// DOM interaction is implemented in terms of mirror objects that represent the actual DOM nodes.
+// DOMNode is a base node mirror type.
+type Node = proto.Node
+
+// A structure holding an RGBA color.
+type RGBA = proto.RGBA
+
+// An array of quad vertices, x immediately followed by y for each point, points clock-wise.
+type Quad = proto.Quad
+
+// Box model.
+type BoxModel = proto.BoxModel
+
+// CSS Shape Outside details.
+type ShapeOutsideInfo = proto.ShapeOutsideInfo
+
+// Rectangle.
+type Rect = proto.Rect
+
+// Describes node given its id, does not require domain to be enabled. Does not start tracking any
+// objects, can be used for automation.
+const DescribeNode = proto.DescribeNode
+
+type DescribeNodeParams = proto.DescribeNodeParams
+
+type DescribeNodeResult = proto.DescribeNodeResult
+
+var DescribeNodeCommand = proto.DescribeNodeCommand
+
+// Disables DOM agent for the given page.
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// Enables DOM agent for the given page.
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// Focuses the given element.
+const Focus = proto.Focus
+
+type FocusParams = proto.FocusParams
+
+type FocusResult = proto.FocusResult
+
+var FocusCommand = proto.FocusCommand
+
+// Returns attributes for the specified node.
+const GetAttributes = proto.GetAttributes
+
+type GetAttributesParams = proto.GetAttributesParams
+
+type GetAttributesResult = proto.GetAttributesResult
+
+var GetAttributesCommand = proto.GetAttributesCommand
+
+// Returns boxes for the given node.
+const GetBoxModel = proto.GetBoxModel
+
+type GetBoxModelParams = proto.GetBoxModelParams
+
+type GetBoxModelResult = proto.GetBoxModelResult
+
+var GetBoxModelCommand = proto.GetBoxModelCommand
+
+// Returns the root DOM node (and optionally the subtree) to the caller.
+const GetDocument = proto.GetDocument
+
+type GetDocumentParams = proto.GetDocumentParams
+
+type GetDocumentResult = proto.GetDocumentResult
+
+var GetDocumentCommand = proto.GetDocumentCommand
+
+// Returns the root DOM node (and optionally the subtree) to the caller.
+const GetFlattenedDocument = proto.GetFlattenedDocument
+
+type GetFlattenedDocumentParams = proto.GetFlattenedDocumentParams
+
+type GetFlattenedDocumentResult = proto.GetFlattenedDocumentResult
+
+var GetFlattenedDocumentCommand = proto.GetFlattenedDocumentCommand
+
+// Returns node id at given location. Depending on whether DOM domain is enabled, nodeId is
+// either returned or not.
+const GetNodeForLocation = proto.GetNodeForLocation
+
+type GetNodeForLocationParams = proto.GetNodeForLocationParams
+
+type GetNodeForLocationResult = proto.GetNodeForLocationResult
+
+var GetNodeForLocationCommand = proto.GetNodeForLocationCommand
+
+// Returns node's HTML markup.
+const GetOuterHTML = proto.GetOuterHTML
+
+type GetOuterHTMLParams = proto.GetOuterHTMLParams
+
+type GetOuterHTMLResult = proto.GetOuterHTMLResult
+
+var GetOuterHTMLCommand = proto.GetOuterHTMLCommand
+
+// Hides any highlight.
+const HideHighlight = proto.HideHighlight
+
+type HideHighlightParams = proto.HideHighlightParams
+
+type HideHighlightResult = proto.HideHighlightResult
+
+var HideHighlightCommand = proto.HideHighlightCommand
+
+// Highlights DOM node.
+const HighlightNode = proto.HighlightNode
+
+type HighlightNodeParams = proto.HighlightNodeParams
+
+type HighlightNodeResult = proto.HighlightNodeResult
+
+var HighlightNodeCommand = proto.HighlightNodeCommand
+
+// Highlights given rectangle.
+const HighlightRect = proto.HighlightRect
+
+type HighlightRectParams = proto.HighlightRectParams
+
+type HighlightRectResult = proto.HighlightRectResult
+
+var HighlightRectCommand = proto.HighlightRectCommand
+
+// Moves node into the new container, places it before the given anchor.
+const MoveTo = proto.MoveTo
+
+type MoveToParams = proto.MoveToParams
+
+type MoveToResult = proto.MoveToResult
+
+var MoveToCommand = proto.MoveToCommand
+
+// Executes `querySelector` on a given node.
+const QuerySelector = proto.QuerySelector
+
+type QuerySelectorParams = proto.QuerySelectorParams
+
+type QuerySelectorResult = proto.QuerySelectorResult
+
+var QuerySelectorCommand = proto.QuerySelectorCommand
+
+// Executes `querySelectorAll` on a given node.
+const QuerySelectorAll = proto.QuerySelectorAll
+
+type QuerySelectorAllParams = proto.QuerySelectorAllParams
+
+type QuerySelectorAllResult = proto.QuerySelectorAllResult
+
+var QuerySelectorAllCommand = proto.QuerySelectorAllCommand
+
+// Removes attribute with given name from an element with given id.
+const RemoveAttribute = proto.RemoveAttribute
+
+type RemoveAttributeParams = proto.RemoveAttributeParams
+
+type RemoveAttributeResult = proto.RemoveAttributeResult
+
+var RemoveAttributeCommand = proto.RemoveAttributeCommand
+
+// Removes node with given id.
+const RemoveNode = proto.RemoveNode
+
+type RemoveNodeParams = proto.RemoveNodeParams
+
+type RemoveNodeResult = proto.RemoveNodeResult
+
+var RemoveNodeCommand = proto.RemoveNodeCommand
+
+// Requests that children of the node with given id are returned to the caller in form of
+// `setChildNodes` events where not only immediate children are retrieved, but all children down to
+// the specified depth.
+const RequestChildNodes = proto.RequestChildNodes
+
+type RequestChildNodesParams = proto.RequestChildNodesParams
+
+type RequestChildNodesResult = proto.RequestChildNodesResult
+
+var RequestChildNodesCommand = proto.RequestChildNodesCommand
+
+// Requests that the node is sent to the caller given the JavaScript node object reference. All
+// nodes that form the path from the node to the root are also sent to the client as a series of
+// `setChildNodes` notifications.
+const RequestNode = proto.RequestNode
+
+type RequestNodeParams = proto.RequestNodeParams
+
+type RequestNodeResult = proto.RequestNodeResult
+
+var RequestNodeCommand = proto.RequestNodeCommand
+
+// Resolves the JavaScript node object for a given NodeId or BackendNodeId.
+const ResolveNode = proto.ResolveNode
+
+type ResolveNodeParams = proto.ResolveNodeParams
+
+type ResolveNodeResult = proto.ResolveNodeResult
+
+var ResolveNodeCommand = proto.ResolveNodeCommand
+
+// Sets attribute for an element with given id.
+const SetAttributeValue = proto.SetAttributeValue
+
+type SetAttributeValueParams = proto.SetAttributeValueParams
+
+type SetAttributeValueResult = proto.SetAttributeValueResult
+
+var SetAttributeValueCommand = proto.SetAttributeValueCommand
+
+// Sets attributes on element with given id. This method is useful when user edits some existing
+// attribute value and types in several attribute name/value pairs.
+const SetAttributesAsText = proto.SetAttributesAsText
+
+type SetAttributesAsTextParams = proto.SetAttributesAsTextParams
+
+type SetAttributesAsTextResult = proto.SetAttributesAsTextResult
+
+var SetAttributesAsTextCommand = proto.SetAttributesAsTextCommand
+
+// Sets files for the given file input element.
+const SetFileInputFiles = proto.SetFileInputFiles
+
+type SetFileInputFilesParams = proto.SetFileInputFilesParams
+
+type SetFileInputFilesResult = proto.SetFileInputFilesResult
+
+var SetFileInputFilesCommand = proto.SetFileInputFilesCommand
+
+// Sets node name for a node with given id.
+const SetNodeName = proto.SetNodeName
+
+type SetNodeNameParams = proto.SetNodeNameParams
+
+type SetNodeNameResult = proto.SetNodeNameResult
+
+var SetNodeNameCommand = proto.SetNodeNameCommand
+
+// Sets node value for a node with given id.
+const SetNodeValue = proto.SetNodeValue
+
+type SetNodeValueParams = proto.SetNodeValueParams
+
+type SetNodeValueResult = proto.SetNodeValueResult
+
+var SetNodeValueCommand = proto.SetNodeValueCommand
+
+// Sets node HTML markup, returns new node id.
+const SetOuterHTML = proto.SetOuterHTML
+
+type SetOuterHTMLParams = proto.SetOuterHTMLParams
+
+type SetOuterHTMLResult = proto.SetOuterHTMLResult
+
+var SetOuterHTMLCommand = proto.SetOuterHTMLCommand
+
+// Fired when `Element`'s attribute is modified.
+const AttributeModifiedEvent = proto.AttributeModifiedEvent
+
+type AttributeModifiedParams = proto.AttributeModifiedParams
+
+// Fired when `Element`'s attribute is removed.
+const AttributeRemovedEvent = proto.AttributeRemovedEvent
+
+type AttributeRemovedParams = proto.AttributeRemovedParams
+
+// Mirrors `DOMCharacterDataModified` event.
+const CharacterDataModifiedEvent = proto.CharacterDataModifiedEvent
+
+type CharacterDataModifiedParams = proto.CharacterDataModifiedParams
+
+// Fired when `Container`'s child node count has changed.
+const ChildNodeCountUpdatedEvent = proto.ChildNodeCountUpdatedEvent
+
+type ChildNodeCountUpdatedParams = proto.ChildNodeCountUpdatedParams
+
+// Mirrors `DOMNodeInserted` event.
+const ChildNodeInsertedEvent = proto.ChildNodeInsertedEvent
+
+type ChildNodeInsertedParams = proto.ChildNodeInsertedParams
+
+// Mirrors `DOMNodeRemoved` event.
+const ChildNodeRemovedEvent = proto.ChildNodeRemovedEvent
+
+type ChildNodeRemovedParams = proto.ChildNodeRemovedParams
+
+// Fired when `Document` has been totally updated. Node ids are no longer valid.
+const DocumentUpdatedEvent = proto.DocumentUpdatedEvent
+
+type DocumentUpdatedParams = proto.DocumentUpdatedParams
+
+// Fired when backend wants to provide client with the missing DOM structure. This happens upon
+// most of the calls requesting node ids.
+const SetChildNodesEvent = proto.SetChildNodesEvent
+
+type SetChildNodesParams = proto.SetChildNodesParams
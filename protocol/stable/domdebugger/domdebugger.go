@@ -0,0 +1,76 @@
+// Package domdebugger exposes the stable subset of the DOMDebugger domain of the DevTools protocol.
+//
+// DOM debugging allows setting breakpoints on particular DOM operations and events. JavaScript
+// execution will stop on these operations as if there was a regular breakpoint set.
+package domdebugger
+
+import proto "github.com/diiyw/cuto/protocol/domdebugger"
+
+// DOM breakpoint type.
+type DOMBreakpointType = proto.DOMBreakpointType
+
+// Object event listener.
+type EventListener = proto.EventListener
+
+// Returns event listeners of the given object.
+const GetEventListeners = proto.GetEventListeners
+
+type GetEventListenersParams = proto.GetEventListenersParams
+
+type GetEventListenersResult = proto.GetEventListenersResult
+
+var GetEventListenersCommand = proto.GetEventListenersCommand
+
+// Removes DOM breakpoint that was set using `setDOMBreakpoint`.
+const RemoveDOMBreakpoint = proto.RemoveDOMBreakpoint
+
+type RemoveDOMBreakpointParams = proto.RemoveDOMBreakpointParams
+
+type RemoveDOMBreakpointResult = proto.RemoveDOMBreakpointResult
+
+var RemoveDOMBreakpointCommand = proto.RemoveDOMBreakpointCommand
+
+// Removes breakpoint on particular DOM event.
+const RemoveEventListenerBreakpoint = proto.RemoveEventListenerBreakpoint
+
+type RemoveEventListenerBreakpointParams = proto.RemoveEventListenerBreakpointParams
+
+type RemoveEventListenerBreakpointResult = proto.RemoveEventListenerBreakpointResult
+
+var RemoveEventListenerBreakpointCommand = proto.RemoveEventListenerBreakpointCommand
+
+// Removes breakpoint from XMLHttpRequest.
+const RemoveXHRBreakpoint = proto.RemoveXHRBreakpoint
+
+type RemoveXHRBreakpointParams = proto.RemoveXHRBreakpointParams
+
+type RemoveXHRBreakpointResult = proto.RemoveXHRBreakpointResult
+
+var RemoveXHRBreakpointCommand = proto.RemoveXHRBreakpointCommand
+
+// Sets breakpoint on particular operation with DOM.
+const SetDOMBreakpoint = proto.SetDOMBreakpoint
+
+type SetDOMBreakpointParams = proto.SetDOMBreakpointParams
+
+type SetDOMBreakpointResult = proto.SetDOMBreakpointResult
+
+var SetDOMBreakpointCommand = proto.SetDOMBreakpointCommand
+
+// Sets breakpoint on particular DOM event.
+const SetEventListenerBreakpoint = proto.SetEventListenerBreakpoint
+
+type SetEventListenerBreakpointParams = proto.SetEventListenerBreakpointParams
+
+type SetEventListenerBreakpointResult = proto.SetEventListenerBreakpointResult
+
+var SetEventListenerBreakpointCommand = proto.SetEventListenerBreakpointCommand
+
+// Sets breakpoint on XMLHttpRequest.
+const SetXHRBreakpoint = proto.SetXHRBreakpoint
+
+type SetXHRBreakpointParams = proto.SetXHRBreakpointParams
+
+type SetXHRBreakpointResult = proto.SetXHRBreakpointResult
+
+var SetXHRBreakpointCommand = proto.SetXHRBreakpointCommand
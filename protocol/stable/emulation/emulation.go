@@ -0,0 +1,106 @@
+// Package emulation exposes the stable subset of the Emulation domain of the DevTools protocol.
+//
+// This domain emulates different environments for the page.
+package emulation
+
+import proto "github.com/diiyw/cuto/protocol/emulation"
+
+// Screen orientation.
+type ScreenOrientation = proto.ScreenOrientation
+
+// 
+type MediaFeature = proto.MediaFeature
+
+// Tells whether emulation is supported.
+const CanEmulate = proto.CanEmulate
+
+type CanEmulateParams = proto.CanEmulateParams
+
+type CanEmulateResult = proto.CanEmulateResult
+
+var CanEmulateCommand = proto.CanEmulateCommand
+
+// Clears the overriden device metrics.
+const ClearDeviceMetricsOverride = proto.ClearDeviceMetricsOverride
+
+type ClearDeviceMetricsOverrideParams = proto.ClearDeviceMetricsOverrideParams
+
+type ClearDeviceMetricsOverrideResult = proto.ClearDeviceMetricsOverrideResult
+
+var ClearDeviceMetricsOverrideCommand = proto.ClearDeviceMetricsOverrideCommand
+
+// Clears the overriden Geolocation Position and Error.
+const ClearGeolocationOverride = proto.ClearGeolocationOverride
+
+type ClearGeolocationOverrideParams = proto.ClearGeolocationOverrideParams
+
+type ClearGeolocationOverrideResult = proto.ClearGeolocationOverrideResult
+
+var ClearGeolocationOverrideCommand = proto.ClearGeolocationOverrideCommand
+
+// Sets or clears an override of the default background color of the frame. This override is used
+// if the content does not specify one.
+const SetDefaultBackgroundColorOverride = proto.SetDefaultBackgroundColorOverride
+
+type SetDefaultBackgroundColorOverrideParams = proto.SetDefaultBackgroundColorOverrideParams
+
+type SetDefaultBackgroundColorOverrideResult = proto.SetDefaultBackgroundColorOverrideResult
+
+var SetDefaultBackgroundColorOverrideCommand = proto.SetDefaultBackgroundColorOverrideCommand
+
+// Overrides the values of device screen dimensions (window.screen.width, window.screen.height,
+// window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media
+// query results).
+const SetDeviceMetricsOverride = proto.SetDeviceMetricsOverride
+
+type SetDeviceMetricsOverrideParams = proto.SetDeviceMetricsOverrideParams
+
+type SetDeviceMetricsOverrideResult = proto.SetDeviceMetricsOverrideResult
+
+var SetDeviceMetricsOverrideCommand = proto.SetDeviceMetricsOverrideCommand
+
+// Emulates the given media type or media feature for CSS media queries.
+const SetEmulatedMedia = proto.SetEmulatedMedia
+
+type SetEmulatedMediaParams = proto.SetEmulatedMediaParams
+
+type SetEmulatedMediaResult = proto.SetEmulatedMediaResult
+
+var SetEmulatedMediaCommand = proto.SetEmulatedMediaCommand
+
+// Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position
+// unavailable.
+const SetGeolocationOverride = proto.SetGeolocationOverride
+
+type SetGeolocationOverrideParams = proto.SetGeolocationOverrideParams
+
+type SetGeolocationOverrideResult = proto.SetGeolocationOverrideResult
+
+var SetGeolocationOverrideCommand = proto.SetGeolocationOverrideCommand
+
+// Switches script execution in the page.
+const SetScriptExecutionDisabled = proto.SetScriptExecutionDisabled
+
+type SetScriptExecutionDisabledParams = proto.SetScriptExecutionDisabledParams
+
+type SetScriptExecutionDisabledResult = proto.SetScriptExecutionDisabledResult
+
+var SetScriptExecutionDisabledCommand = proto.SetScriptExecutionDisabledCommand
+
+// Enables touch on platforms which do not support them.
+const SetTouchEmulationEnabled = proto.SetTouchEmulationEnabled
+
+type SetTouchEmulationEnabledParams = proto.SetTouchEmulationEnabledParams
+
+type SetTouchEmulationEnabledResult = proto.SetTouchEmulationEnabledResult
+
+var SetTouchEmulationEnabledCommand = proto.SetTouchEmulationEnabledCommand
+
+// Allows overriding user agent with the given string.
+const SetUserAgentOverride = proto.SetUserAgentOverride
+
+type SetUserAgentOverrideParams = proto.SetUserAgentOverrideParams
+
+type SetUserAgentOverrideResult = proto.SetUserAgentOverrideResult
+
+var SetUserAgentOverrideCommand = proto.SetUserAgentOverrideCommand
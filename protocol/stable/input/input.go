@@ -0,0 +1,46 @@
+// Package input exposes the stable subset of the Input domain of the DevTools protocol.
+package input
+
+import proto "github.com/diiyw/cuto/protocol/input"
+
+// 
+type TouchPoint = proto.TouchPoint
+
+// UTC time in seconds, counted from January 1, 1970.
+type TimeSinceEpoch = proto.TimeSinceEpoch
+
+// Dispatches a key event to the page.
+const DispatchKeyEvent = proto.DispatchKeyEvent
+
+type DispatchKeyEventParams = proto.DispatchKeyEventParams
+
+type DispatchKeyEventResult = proto.DispatchKeyEventResult
+
+var DispatchKeyEventCommand = proto.DispatchKeyEventCommand
+
+// Dispatches a mouse event to the page.
+const DispatchMouseEvent = proto.DispatchMouseEvent
+
+type DispatchMouseEventParams = proto.DispatchMouseEventParams
+
+type DispatchMouseEventResult = proto.DispatchMouseEventResult
+
+var DispatchMouseEventCommand = proto.DispatchMouseEventCommand
+
+// Dispatches a touch event to the page.
+const DispatchTouchEvent = proto.DispatchTouchEvent
+
+type DispatchTouchEventParams = proto.DispatchTouchEventParams
+
+type DispatchTouchEventResult = proto.DispatchTouchEventResult
+
+var DispatchTouchEventCommand = proto.DispatchTouchEventCommand
+
+// Ignores input events (useful while auditing page).
+const SetIgnoreInputEvents = proto.SetIgnoreInputEvents
+
+type SetIgnoreInputEventsParams = proto.SetIgnoreInputEventsParams
+
+type SetIgnoreInputEventsResult = proto.SetIgnoreInputEventsResult
+
+var SetIgnoreInputEventsCommand = proto.SetIgnoreInputEventsCommand
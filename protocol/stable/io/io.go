@@ -0,0 +1,37 @@
+// Package io exposes the stable subset of the IO domain of the DevTools protocol.
+//
+// Input/Output operations for streams produced by DevTools.
+package io
+
+import proto "github.com/diiyw/cuto/protocol/io"
+
+// This is either obtained from another method or specifed as `blob:&lt;uuid&gt;` where
+// `&lt;uuid&gt` is an UUID of a Blob.
+type StreamHandle = proto.StreamHandle
+
+// Close the stream, discard any temporary backing storage.
+const Close = proto.Close
+
+type CloseParams = proto.CloseParams
+
+type CloseResult = proto.CloseResult
+
+var CloseCommand = proto.CloseCommand
+
+// Read a chunk of the stream
+const Read = proto.Read
+
+type ReadParams = proto.ReadParams
+
+type ReadResult = proto.ReadResult
+
+var ReadCommand = proto.ReadCommand
+
+// Return UUID of Blob object specified by a remote object id.
+const ResolveBlob = proto.ResolveBlob
+
+type ResolveBlobParams = proto.ResolveBlobParams
+
+type ResolveBlobResult = proto.ResolveBlobResult
+
+var ResolveBlobCommand = proto.ResolveBlobCommand
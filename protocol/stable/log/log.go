@@ -0,0 +1,63 @@
+// Package log exposes the stable subset of the Log domain of the DevTools protocol.
+//
+// Provides access to log entries.
+package log
+
+import proto "github.com/diiyw/cuto/protocol/log"
+
+// Log entry.
+type LogEntry = proto.LogEntry
+
+// Violation configuration setting.
+type ViolationSetting = proto.ViolationSetting
+
+// Clears the log.
+const Clear = proto.Clear
+
+type ClearParams = proto.ClearParams
+
+type ClearResult = proto.ClearResult
+
+var ClearCommand = proto.ClearCommand
+
+// Disables log domain, prevents further log entries from being reported to the client.
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// Enables log domain, sends the entries collected so far to the client by means of the
+// `entryAdded` notification.
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// start violation reporting.
+const StartViolationsReport = proto.StartViolationsReport
+
+type StartViolationsReportParams = proto.StartViolationsReportParams
+
+type StartViolationsReportResult = proto.StartViolationsReportResult
+
+var StartViolationsReportCommand = proto.StartViolationsReportCommand
+
+// Stop violation reporting.
+const StopViolationsReport = proto.StopViolationsReport
+
+type StopViolationsReportParams = proto.StopViolationsReportParams
+
+type StopViolationsReportResult = proto.StopViolationsReportResult
+
+var StopViolationsReportCommand = proto.StopViolationsReportCommand
+
+// Issued when new message was logged.
+const EntryAddedEvent = proto.EntryAddedEvent
+
+type EntryAddedParams = proto.EntryAddedParams
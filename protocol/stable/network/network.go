@@ -0,0 +1,323 @@
+// Package network exposes the stable subset of the Network domain of the DevTools protocol.
+//
+// Network domain allows tracking network activities of the page. It exposes information about http,
+// file, data and other requests and responses, their headers, bodies, timing, etc.
+package network
+
+import proto "github.com/diiyw/cuto/protocol/network"
+
+// Resource type as it was perceived by the rendering engine.
+type ResourceType = proto.ResourceType
+
+// Unique loader identifier.
+type LoaderId = proto.LoaderId
+
+// Unique request identifier.
+type RequestId = proto.RequestId
+
+// Unique intercepted request identifier.
+type InterceptionId = proto.InterceptionId
+
+// Network level fetch failure reason.
+type ErrorReason = proto.ErrorReason
+
+// UTC time in seconds, counted from January 1, 1970.
+type TimeSinceEpoch = proto.TimeSinceEpoch
+
+// Monotonically increasing time in seconds since an arbitrary point in the past.
+type MonotonicTime = proto.MonotonicTime
+
+// Request / response headers as keys / values of JSON object.
+type Headers = proto.Headers
+
+// The underlying connection technology that the browser is supposedly using.
+type ConnectionType = proto.ConnectionType
+
+// Represents the cookie's 'SameSite' status:
+// https://tools.ietf.org/html/draft-west-first-party-cookies
+type CookieSameSite = proto.CookieSameSite
+
+// Timing information for the request.
+type ResourceTiming = proto.ResourceTiming
+
+// Loading priority of a resource request.
+type ResourcePriority = proto.ResourcePriority
+
+// HTTP request data.
+type Request = proto.Request
+
+// Details of a signed certificate timestamp (SCT).
+type SignedCertificateTimestamp = proto.SignedCertificateTimestamp
+
+// Security details about a request.
+type SecurityDetails = proto.SecurityDetails
+
+// Whether the request complied with Certificate Transparency policy.
+type CertificateTransparencyCompliance = proto.CertificateTransparencyCompliance
+
+// The reason why request was blocked.
+type BlockedReason = proto.BlockedReason
+
+// HTTP response data.
+type Response = proto.Response
+
+// WebSocket request data.
+type WebSocketRequest = proto.WebSocketRequest
+
+// WebSocket response data.
+type WebSocketResponse = proto.WebSocketResponse
+
+// WebSocket message data. This represents an entire WebSocket message, not just a fragmented frame as the name suggests.
+type WebSocketFrame = proto.WebSocketFrame
+
+// Information about the cached resource.
+type CachedResource = proto.CachedResource
+
+// Information about the request initiator.
+type Initiator = proto.Initiator
+
+// Cookie object
+type Cookie = proto.Cookie
+
+// Cookie parameter object
+type CookieParam = proto.CookieParam
+
+// Tells whether clearing browser cache is supported.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const CanClearBrowserCache = proto.CanClearBrowserCache
+
+type CanClearBrowserCacheParams = proto.CanClearBrowserCacheParams
+
+type CanClearBrowserCacheResult = proto.CanClearBrowserCacheResult
+
+var CanClearBrowserCacheCommand = proto.CanClearBrowserCacheCommand
+
+// Tells whether clearing browser cookies is supported.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const CanClearBrowserCookies = proto.CanClearBrowserCookies
+
+type CanClearBrowserCookiesParams = proto.CanClearBrowserCookiesParams
+
+type CanClearBrowserCookiesResult = proto.CanClearBrowserCookiesResult
+
+var CanClearBrowserCookiesCommand = proto.CanClearBrowserCookiesCommand
+
+// Tells whether emulation of network conditions is supported.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const CanEmulateNetworkConditions = proto.CanEmulateNetworkConditions
+
+type CanEmulateNetworkConditionsParams = proto.CanEmulateNetworkConditionsParams
+
+type CanEmulateNetworkConditionsResult = proto.CanEmulateNetworkConditionsResult
+
+var CanEmulateNetworkConditionsCommand = proto.CanEmulateNetworkConditionsCommand
+
+// Clears browser cache.
+const ClearBrowserCache = proto.ClearBrowserCache
+
+type ClearBrowserCacheParams = proto.ClearBrowserCacheParams
+
+type ClearBrowserCacheResult = proto.ClearBrowserCacheResult
+
+var ClearBrowserCacheCommand = proto.ClearBrowserCacheCommand
+
+// Clears browser cookies.
+const ClearBrowserCookies = proto.ClearBrowserCookies
+
+type ClearBrowserCookiesParams = proto.ClearBrowserCookiesParams
+
+type ClearBrowserCookiesResult = proto.ClearBrowserCookiesResult
+
+var ClearBrowserCookiesCommand = proto.ClearBrowserCookiesCommand
+
+// Deletes browser cookies with matching name and url or domain/path pair.
+const DeleteCookies = proto.DeleteCookies
+
+type DeleteCookiesParams = proto.DeleteCookiesParams
+
+type DeleteCookiesResult = proto.DeleteCookiesResult
+
+var DeleteCookiesCommand = proto.DeleteCookiesCommand
+
+// Disables network tracking, prevents network events from being sent to the client.
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// Activates emulation of network conditions.
+const EmulateNetworkConditions = proto.EmulateNetworkConditions
+
+type EmulateNetworkConditionsParams = proto.EmulateNetworkConditionsParams
+
+type EmulateNetworkConditionsResult = proto.EmulateNetworkConditionsResult
+
+var EmulateNetworkConditionsCommand = proto.EmulateNetworkConditionsCommand
+
+// Enables network tracking, network events will now be delivered to the client.
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// Returns all browser cookies. Depending on the backend support, will return detailed cookie
+// information in the `cookies` field.
+const GetAllCookies = proto.GetAllCookies
+
+type GetAllCookiesParams = proto.GetAllCookiesParams
+
+type GetAllCookiesResult = proto.GetAllCookiesResult
+
+var GetAllCookiesCommand = proto.GetAllCookiesCommand
+
+// Returns all browser cookies for the current URL. Depending on the backend support, will return
+// detailed cookie information in the `cookies` field.
+const GetCookies = proto.GetCookies
+
+type GetCookiesParams = proto.GetCookiesParams
+
+type GetCookiesResult = proto.GetCookiesResult
+
+var GetCookiesCommand = proto.GetCookiesCommand
+
+// Returns content served for the given request.
+const GetResponseBody = proto.GetResponseBody
+
+type GetResponseBodyParams = proto.GetResponseBodyParams
+
+type GetResponseBodyResult = proto.GetResponseBodyResult
+
+var GetResponseBodyCommand = proto.GetResponseBodyCommand
+
+// Returns post data sent with the request. Returns an error when no data was sent with the request.
+const GetRequestPostData = proto.GetRequestPostData
+
+type GetRequestPostDataParams = proto.GetRequestPostDataParams
+
+type GetRequestPostDataResult = proto.GetRequestPostDataResult
+
+var GetRequestPostDataCommand = proto.GetRequestPostDataCommand
+
+// Toggles ignoring cache for each request. If `true`, cache will not be used.
+const SetCacheDisabled = proto.SetCacheDisabled
+
+type SetCacheDisabledParams = proto.SetCacheDisabledParams
+
+type SetCacheDisabledResult = proto.SetCacheDisabledResult
+
+var SetCacheDisabledCommand = proto.SetCacheDisabledCommand
+
+// Sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist.
+const SetCookie = proto.SetCookie
+
+type SetCookieParams = proto.SetCookieParams
+
+type SetCookieResult = proto.SetCookieResult
+
+var SetCookieCommand = proto.SetCookieCommand
+
+// Sets given cookies.
+const SetCookies = proto.SetCookies
+
+type SetCookiesParams = proto.SetCookiesParams
+
+type SetCookiesResult = proto.SetCookiesResult
+
+var SetCookiesCommand = proto.SetCookiesCommand
+
+// Specifies whether to always send extra HTTP headers with the requests from this page.
+const SetExtraHTTPHeaders = proto.SetExtraHTTPHeaders
+
+type SetExtraHTTPHeadersParams = proto.SetExtraHTTPHeadersParams
+
+type SetExtraHTTPHeadersResult = proto.SetExtraHTTPHeadersResult
+
+var SetExtraHTTPHeadersCommand = proto.SetExtraHTTPHeadersCommand
+
+// Allows overriding user agent with the given string.
+const SetUserAgentOverride = proto.SetUserAgentOverride
+
+type SetUserAgentOverrideParams = proto.SetUserAgentOverrideParams
+
+type SetUserAgentOverrideResult = proto.SetUserAgentOverrideResult
+
+var SetUserAgentOverrideCommand = proto.SetUserAgentOverrideCommand
+
+// Fired when data chunk was received over the network.
+const DataReceivedEvent = proto.DataReceivedEvent
+
+type DataReceivedParams = proto.DataReceivedParams
+
+// Fired when EventSource message is received.
+const EventSourceMessageReceivedEvent = proto.EventSourceMessageReceivedEvent
+
+type EventSourceMessageReceivedParams = proto.EventSourceMessageReceivedParams
+
+// Fired when HTTP request has failed to load.
+const LoadingFailedEvent = proto.LoadingFailedEvent
+
+type LoadingFailedParams = proto.LoadingFailedParams
+
+// Fired when HTTP request has finished loading.
+const LoadingFinishedEvent = proto.LoadingFinishedEvent
+
+type LoadingFinishedParams = proto.LoadingFinishedParams
+
+// Fired if request ended up loading from cache.
+const RequestServedFromCacheEvent = proto.RequestServedFromCacheEvent
+
+type RequestServedFromCacheParams = proto.RequestServedFromCacheParams
+
+// Fired when page is about to send HTTP request.
+const RequestWillBeSentEvent = proto.RequestWillBeSentEvent
+
+type RequestWillBeSentParams = proto.RequestWillBeSentParams
+
+// Fired when HTTP response is available.
+const ResponseReceivedEvent = proto.ResponseReceivedEvent
+
+type ResponseReceivedParams = proto.ResponseReceivedParams
+
+// Fired when WebSocket is closed.
+const WebSocketClosedEvent = proto.WebSocketClosedEvent
+
+type WebSocketClosedParams = proto.WebSocketClosedParams
+
+// Fired upon WebSocket creation.
+const WebSocketCreatedEvent = proto.WebSocketCreatedEvent
+
+type WebSocketCreatedParams = proto.WebSocketCreatedParams
+
+// Fired when WebSocket message error occurs.
+const WebSocketFrameErrorEvent = proto.WebSocketFrameErrorEvent
+
+type WebSocketFrameErrorParams = proto.WebSocketFrameErrorParams
+
+// Fired when WebSocket message is received.
+const WebSocketFrameReceivedEvent = proto.WebSocketFrameReceivedEvent
+
+type WebSocketFrameReceivedParams = proto.WebSocketFrameReceivedParams
+
+// Fired when WebSocket message is sent.
+const WebSocketFrameSentEvent = proto.WebSocketFrameSentEvent
+
+type WebSocketFrameSentParams = proto.WebSocketFrameSentParams
+
+// Fired when WebSocket handshake response becomes available.
+const WebSocketHandshakeResponseReceivedEvent = proto.WebSocketHandshakeResponseReceivedEvent
+
+type WebSocketHandshakeResponseReceivedParams = proto.WebSocketHandshakeResponseReceivedParams
+
+// Fired when WebSocket is about to initiate handshake.
+const WebSocketWillSendHandshakeRequestEvent = proto.WebSocketWillSendHandshakeRequestEvent
+
+type WebSocketWillSendHandshakeRequestParams = proto.WebSocketWillSendHandshakeRequestParams
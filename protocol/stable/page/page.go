@@ -0,0 +1,310 @@
+// Package page exposes the stable subset of the Page domain of the DevTools protocol.
+//
+// Actions and events related to the inspected page belong to the page domain.
+package page
+
+import proto "github.com/diiyw/cuto/protocol/page"
+
+// Unique frame identifier.
+type FrameId = proto.FrameId
+
+// Information about the Frame on the page.
+type Frame = proto.Frame
+
+// Information about the Frame hierarchy.
+type FrameTree = proto.FrameTree
+
+// Unique script identifier.
+type ScriptIdentifier = proto.ScriptIdentifier
+
+// Transition type.
+type TransitionType = proto.TransitionType
+
+// Navigation history entry.
+type NavigationEntry = proto.NavigationEntry
+
+// Javascript dialog type.
+type DialogType = proto.DialogType
+
+// Error while paring app manifest.
+type AppManifestError = proto.AppManifestError
+
+// Layout viewport position and dimensions.
+type LayoutViewport = proto.LayoutViewport
+
+// Visual viewport position, dimensions, and scale.
+type VisualViewport = proto.VisualViewport
+
+// Viewport for capturing screenshot.
+type Viewport = proto.Viewport
+
+// Evaluates given script in every frame upon creation (before loading frame's scripts).
+const AddScriptToEvaluateOnNewDocument = proto.AddScriptToEvaluateOnNewDocument
+
+type AddScriptToEvaluateOnNewDocumentParams = proto.AddScriptToEvaluateOnNewDocumentParams
+
+type AddScriptToEvaluateOnNewDocumentResult = proto.AddScriptToEvaluateOnNewDocumentResult
+
+var AddScriptToEvaluateOnNewDocumentCommand = proto.AddScriptToEvaluateOnNewDocumentCommand
+
+// Brings page to front (activates tab).
+const BringToFront = proto.BringToFront
+
+type BringToFrontParams = proto.BringToFrontParams
+
+type BringToFrontResult = proto.BringToFrontResult
+
+var BringToFrontCommand = proto.BringToFrontCommand
+
+// Capture page screenshot.
+const CaptureScreenshot = proto.CaptureScreenshot
+
+type CaptureScreenshotParams = proto.CaptureScreenshotParams
+
+type CaptureScreenshotResult = proto.CaptureScreenshotResult
+
+var CaptureScreenshotCommand = proto.CaptureScreenshotCommand
+
+// Clears the overriden Geolocation Position and Error.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const ClearGeolocationOverride = proto.ClearGeolocationOverride
+
+type ClearGeolocationOverrideParams = proto.ClearGeolocationOverrideParams
+
+type ClearGeolocationOverrideResult = proto.ClearGeolocationOverrideResult
+
+var ClearGeolocationOverrideCommand = proto.ClearGeolocationOverrideCommand
+
+// Creates an isolated world for the given frame.
+const CreateIsolatedWorld = proto.CreateIsolatedWorld
+
+type CreateIsolatedWorldParams = proto.CreateIsolatedWorldParams
+
+type CreateIsolatedWorldResult = proto.CreateIsolatedWorldResult
+
+var CreateIsolatedWorldCommand = proto.CreateIsolatedWorldCommand
+
+// Disables page domain notifications.
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// Enables page domain notifications.
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// 
+const GetAppManifest = proto.GetAppManifest
+
+type GetAppManifestParams = proto.GetAppManifestParams
+
+type GetAppManifestResult = proto.GetAppManifestResult
+
+var GetAppManifestCommand = proto.GetAppManifestCommand
+
+// Returns present frame tree structure.
+const GetFrameTree = proto.GetFrameTree
+
+type GetFrameTreeParams = proto.GetFrameTreeParams
+
+type GetFrameTreeResult = proto.GetFrameTreeResult
+
+var GetFrameTreeCommand = proto.GetFrameTreeCommand
+
+// Returns metrics relating to the layouting of the page, such as viewport bounds/scale.
+const GetLayoutMetrics = proto.GetLayoutMetrics
+
+type GetLayoutMetricsParams = proto.GetLayoutMetricsParams
+
+type GetLayoutMetricsResult = proto.GetLayoutMetricsResult
+
+var GetLayoutMetricsCommand = proto.GetLayoutMetricsCommand
+
+// Returns navigation history for the current page.
+const GetNavigationHistory = proto.GetNavigationHistory
+
+type GetNavigationHistoryParams = proto.GetNavigationHistoryParams
+
+type GetNavigationHistoryResult = proto.GetNavigationHistoryResult
+
+var GetNavigationHistoryCommand = proto.GetNavigationHistoryCommand
+
+// Resets navigation history for the current page.
+const ResetNavigationHistory = proto.ResetNavigationHistory
+
+type ResetNavigationHistoryParams = proto.ResetNavigationHistoryParams
+
+type ResetNavigationHistoryResult = proto.ResetNavigationHistoryResult
+
+var ResetNavigationHistoryCommand = proto.ResetNavigationHistoryCommand
+
+// Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload).
+const HandleJavaScriptDialog = proto.HandleJavaScriptDialog
+
+type HandleJavaScriptDialogParams = proto.HandleJavaScriptDialogParams
+
+type HandleJavaScriptDialogResult = proto.HandleJavaScriptDialogResult
+
+var HandleJavaScriptDialogCommand = proto.HandleJavaScriptDialogCommand
+
+// Navigates current page to the given URL.
+const Navigate = proto.Navigate
+
+type NavigateParams = proto.NavigateParams
+
+type NavigateResult = proto.NavigateResult
+
+var NavigateCommand = proto.NavigateCommand
+
+// Navigates current page to the given history entry.
+const NavigateToHistoryEntry = proto.NavigateToHistoryEntry
+
+type NavigateToHistoryEntryParams = proto.NavigateToHistoryEntryParams
+
+type NavigateToHistoryEntryResult = proto.NavigateToHistoryEntryResult
+
+var NavigateToHistoryEntryCommand = proto.NavigateToHistoryEntryCommand
+
+// Print page as PDF.
+const PrintToPDF = proto.PrintToPDF
+
+type PrintToPDFParams = proto.PrintToPDFParams
+
+type PrintToPDFResult = proto.PrintToPDFResult
+
+var PrintToPDFCommand = proto.PrintToPDFCommand
+
+// Reloads given page optionally ignoring the cache.
+const Reload = proto.Reload
+
+type ReloadParams = proto.ReloadParams
+
+type ReloadResult = proto.ReloadResult
+
+var ReloadCommand = proto.ReloadCommand
+
+// Removes given script from the list.
+const RemoveScriptToEvaluateOnNewDocument = proto.RemoveScriptToEvaluateOnNewDocument
+
+type RemoveScriptToEvaluateOnNewDocumentParams = proto.RemoveScriptToEvaluateOnNewDocumentParams
+
+type RemoveScriptToEvaluateOnNewDocumentResult = proto.RemoveScriptToEvaluateOnNewDocumentResult
+
+var RemoveScriptToEvaluateOnNewDocumentCommand = proto.RemoveScriptToEvaluateOnNewDocumentCommand
+
+// Sets given markup as the document's HTML.
+const SetDocumentContent = proto.SetDocumentContent
+
+type SetDocumentContentParams = proto.SetDocumentContentParams
+
+type SetDocumentContentResult = proto.SetDocumentContentResult
+
+var SetDocumentContentCommand = proto.SetDocumentContentCommand
+
+// Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position
+// unavailable.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const SetGeolocationOverride = proto.SetGeolocationOverride
+
+type SetGeolocationOverrideParams = proto.SetGeolocationOverrideParams
+
+type SetGeolocationOverrideResult = proto.SetGeolocationOverrideResult
+
+var SetGeolocationOverrideCommand = proto.SetGeolocationOverrideCommand
+
+// Force the page stop all navigations and pending resource fetches.
+const StopLoading = proto.StopLoading
+
+type StopLoadingParams = proto.StopLoadingParams
+
+type StopLoadingResult = proto.StopLoadingResult
+
+var StopLoadingCommand = proto.StopLoadingCommand
+
+// 
+const DomContentEventFiredEvent = proto.DomContentEventFiredEvent
+
+type DomContentEventFiredParams = proto.DomContentEventFiredParams
+
+// Emitted only when `page.interceptFileChooser` is enabled.
+const FileChooserOpenedEvent = proto.FileChooserOpenedEvent
+
+type FileChooserOpenedParams = proto.FileChooserOpenedParams
+
+// Fired when frame has been attached to its parent.
+const FrameAttachedEvent = proto.FrameAttachedEvent
+
+type FrameAttachedParams = proto.FrameAttachedParams
+
+// Fired when frame no longer has a scheduled navigation.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const FrameClearedScheduledNavigationEvent = proto.FrameClearedScheduledNavigationEvent
+
+type FrameClearedScheduledNavigationParams = proto.FrameClearedScheduledNavigationParams
+
+// Fired when frame has been detached from its parent.
+const FrameDetachedEvent = proto.FrameDetachedEvent
+
+type FrameDetachedParams = proto.FrameDetachedParams
+
+// Fired once navigation of the frame has completed. Frame is now associated with the new loader.
+const FrameNavigatedEvent = proto.FrameNavigatedEvent
+
+type FrameNavigatedParams = proto.FrameNavigatedParams
+
+// Fired when frame schedules a potential navigation.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const FrameScheduledNavigationEvent = proto.FrameScheduledNavigationEvent
+
+type FrameScheduledNavigationParams = proto.FrameScheduledNavigationParams
+
+// Fired when interstitial page was hidden
+const InterstitialHiddenEvent = proto.InterstitialHiddenEvent
+
+type InterstitialHiddenParams = proto.InterstitialHiddenParams
+
+// Fired when interstitial page was shown
+const InterstitialShownEvent = proto.InterstitialShownEvent
+
+type InterstitialShownParams = proto.InterstitialShownParams
+
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been
+// closed.
+const JavascriptDialogClosedEvent = proto.JavascriptDialogClosedEvent
+
+type JavascriptDialogClosedParams = proto.JavascriptDialogClosedParams
+
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) is about to
+// open.
+const JavascriptDialogOpeningEvent = proto.JavascriptDialogOpeningEvent
+
+type JavascriptDialogOpeningParams = proto.JavascriptDialogOpeningParams
+
+// Fired for top level page lifecycle events such as navigation, load, paint, etc.
+const LifecycleEventEvent = proto.LifecycleEventEvent
+
+type LifecycleEventParams = proto.LifecycleEventParams
+
+// 
+const LoadEventFiredEvent = proto.LoadEventFiredEvent
+
+type LoadEventFiredParams = proto.LoadEventFiredParams
+
+// Fired when a new window is going to be opened, via window.open(), link click, form submission,
+// etc.
+const WindowOpenEvent = proto.WindowOpenEvent
+
+type WindowOpenParams = proto.WindowOpenParams
@@ -0,0 +1,39 @@
+// Package performance exposes the stable subset of the Performance domain of the DevTools protocol.
+package performance
+
+import proto "github.com/diiyw/cuto/protocol/performance"
+
+// Run-time execution metric.
+type Metric = proto.Metric
+
+// Disable collecting and reporting metrics.
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// Enable collecting and reporting metrics.
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// Retrieve current values of run-time metrics.
+const GetMetrics = proto.GetMetrics
+
+type GetMetricsParams = proto.GetMetricsParams
+
+type GetMetricsResult = proto.GetMetricsResult
+
+var GetMetricsCommand = proto.GetMetricsCommand
+
+// Current values of the metrics.
+const MetricsEvent = proto.MetricsEvent
+
+type MetricsParams = proto.MetricsParams
@@ -0,0 +1,118 @@
+// Package profiler exposes the stable subset of the Profiler domain of the DevTools protocol.
+package profiler
+
+import proto "github.com/diiyw/cuto/protocol/profiler"
+
+// Profile node. Holds callsite information, execution statistics and child nodes.
+type ProfileNode = proto.ProfileNode
+
+// Profile.
+type Profile = proto.Profile
+
+// Specifies a number of samples attributed to a certain source position.
+type PositionTickInfo = proto.PositionTickInfo
+
+// Coverage data for a source range.
+type CoverageRange = proto.CoverageRange
+
+// Coverage data for a JavaScript function.
+type FunctionCoverage = proto.FunctionCoverage
+
+// Coverage data for a JavaScript script.
+type ScriptCoverage = proto.ScriptCoverage
+
+// 
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// 
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// Collect coverage data for the current isolate. The coverage data may be incomplete due to
+// garbage collection.
+const GetBestEffortCoverage = proto.GetBestEffortCoverage
+
+type GetBestEffortCoverageParams = proto.GetBestEffortCoverageParams
+
+type GetBestEffortCoverageResult = proto.GetBestEffortCoverageResult
+
+var GetBestEffortCoverageCommand = proto.GetBestEffortCoverageCommand
+
+// Changes CPU profiler sampling interval. Must be called before CPU profiles recording started.
+const SetSamplingInterval = proto.SetSamplingInterval
+
+type SetSamplingIntervalParams = proto.SetSamplingIntervalParams
+
+type SetSamplingIntervalResult = proto.SetSamplingIntervalResult
+
+var SetSamplingIntervalCommand = proto.SetSamplingIntervalCommand
+
+// 
+const Start = proto.Start
+
+type StartParams = proto.StartParams
+
+type StartResult = proto.StartResult
+
+var StartCommand = proto.StartCommand
+
+// Enable precise code coverage. Coverage data for JavaScript executed before enabling precise code
+// coverage may be incomplete. Enabling prevents running optimized code and resets execution
+// counters.
+const StartPreciseCoverage = proto.StartPreciseCoverage
+
+type StartPreciseCoverageParams = proto.StartPreciseCoverageParams
+
+type StartPreciseCoverageResult = proto.StartPreciseCoverageResult
+
+var StartPreciseCoverageCommand = proto.StartPreciseCoverageCommand
+
+// 
+const Stop = proto.Stop
+
+type StopParams = proto.StopParams
+
+type StopResult = proto.StopResult
+
+var StopCommand = proto.StopCommand
+
+// Disable precise code coverage. Disabling releases unnecessary execution count records and allows
+// executing optimized code.
+const StopPreciseCoverage = proto.StopPreciseCoverage
+
+type StopPreciseCoverageParams = proto.StopPreciseCoverageParams
+
+type StopPreciseCoverageResult = proto.StopPreciseCoverageResult
+
+var StopPreciseCoverageCommand = proto.StopPreciseCoverageCommand
+
+// Collect coverage data for the current isolate, and resets execution counters. Precise code
+// coverage needs to have started.
+const TakePreciseCoverage = proto.TakePreciseCoverage
+
+type TakePreciseCoverageParams = proto.TakePreciseCoverageParams
+
+type TakePreciseCoverageResult = proto.TakePreciseCoverageResult
+
+var TakePreciseCoverageCommand = proto.TakePreciseCoverageCommand
+
+// 
+const ConsoleProfileFinishedEvent = proto.ConsoleProfileFinishedEvent
+
+type ConsoleProfileFinishedParams = proto.ConsoleProfileFinishedParams
+
+// Sent when new profile recording is started using console.profile() call.
+const ConsoleProfileStartedEvent = proto.ConsoleProfileStartedEvent
+
+type ConsoleProfileStartedParams = proto.ConsoleProfileStartedParams
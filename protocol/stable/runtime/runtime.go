@@ -0,0 +1,230 @@
+// Package runtime exposes the stable subset of the Runtime domain of the DevTools protocol.
+//
+// Runtime domain exposes JavaScript runtime by means of remote evaluation and mirror objects.
+// Evaluation results are returned as mirror object that expose object type, string representation
+// and unique identifier that can be used for further object reference. Original objects are
+// maintained in memory unless they are either explicitly released or are released along with the
+// other objects in their object group.
+package runtime
+
+import proto "github.com/diiyw/cuto/protocol/runtime"
+
+// Unique script identifier.
+type ScriptId = proto.ScriptId
+
+// Unique object identifier.
+type RemoteObjectId = proto.RemoteObjectId
+
+// Primitive value which cannot be JSON-stringified. Includes values `-0`, `NaN`, `Infinity`,
+// `-Infinity`, and bigint literals.
+type UnserializableValue = proto.UnserializableValue
+
+// Mirror object referencing original JavaScript object.
+type RemoteObject = proto.RemoteObject
+
+// Object property descriptor.
+type PropertyDescriptor = proto.PropertyDescriptor
+
+// Object internal property descriptor. This property isn't normally visible in JavaScript code.
+type InternalPropertyDescriptor = proto.InternalPropertyDescriptor
+
+// Represents function call argument. Either remote object id `objectId`, primitive `value`,
+// unserializable primitive value or neither of (for undefined) them should be specified.
+type CallArgument = proto.CallArgument
+
+// Id of an execution context.
+type ExecutionContextId = proto.ExecutionContextId
+
+// Description of an isolated world.
+type ExecutionContextDescription = proto.ExecutionContextDescription
+
+// Detailed information about exception (or error) that was thrown during script compilation or
+// execution.
+type ExceptionDetails = proto.ExceptionDetails
+
+// Number of milliseconds since epoch.
+type Timestamp = proto.Timestamp
+
+// Number of milliseconds.
+type TimeDelta = proto.TimeDelta
+
+// Stack entry for runtime errors and assertions.
+type CallFrame = proto.CallFrame
+
+// Call frames for assertions or error messages.
+type StackTrace = proto.StackTrace
+
+// Add handler to promise with given promise object id.
+const AwaitPromise = proto.AwaitPromise
+
+type AwaitPromiseParams = proto.AwaitPromiseParams
+
+type AwaitPromiseResult = proto.AwaitPromiseResult
+
+var AwaitPromiseCommand = proto.AwaitPromiseCommand
+
+// Calls function with given declaration on the given object. Object group of the result is
+// inherited from the target object.
+const CallFunctionOn = proto.CallFunctionOn
+
+type CallFunctionOnParams = proto.CallFunctionOnParams
+
+type CallFunctionOnResult = proto.CallFunctionOnResult
+
+var CallFunctionOnCommand = proto.CallFunctionOnCommand
+
+// Compiles expression.
+const CompileScript = proto.CompileScript
+
+type CompileScriptParams = proto.CompileScriptParams
+
+type CompileScriptResult = proto.CompileScriptResult
+
+var CompileScriptCommand = proto.CompileScriptCommand
+
+// Disables reporting of execution contexts creation.
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// Discards collected exceptions and console API calls.
+const DiscardConsoleEntries = proto.DiscardConsoleEntries
+
+type DiscardConsoleEntriesParams = proto.DiscardConsoleEntriesParams
+
+type DiscardConsoleEntriesResult = proto.DiscardConsoleEntriesResult
+
+var DiscardConsoleEntriesCommand = proto.DiscardConsoleEntriesCommand
+
+// Enables reporting of execution contexts creation by means of `executionContextCreated` event.
+// When the reporting gets enabled the event will be sent immediately for each existing execution
+// context.
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// Evaluates expression on global object.
+const Evaluate = proto.Evaluate
+
+type EvaluateParams = proto.EvaluateParams
+
+type EvaluateResult = proto.EvaluateResult
+
+var EvaluateCommand = proto.EvaluateCommand
+
+// Returns properties of a given object. Object group of the result is inherited from the target
+// object.
+const GetProperties = proto.GetProperties
+
+type GetPropertiesParams = proto.GetPropertiesParams
+
+type GetPropertiesResult = proto.GetPropertiesResult
+
+var GetPropertiesCommand = proto.GetPropertiesCommand
+
+// Returns all let, const and class variables from global scope.
+const GlobalLexicalScopeNames = proto.GlobalLexicalScopeNames
+
+type GlobalLexicalScopeNamesParams = proto.GlobalLexicalScopeNamesParams
+
+type GlobalLexicalScopeNamesResult = proto.GlobalLexicalScopeNamesResult
+
+var GlobalLexicalScopeNamesCommand = proto.GlobalLexicalScopeNamesCommand
+
+// 
+const QueryObjects = proto.QueryObjects
+
+type QueryObjectsParams = proto.QueryObjectsParams
+
+type QueryObjectsResult = proto.QueryObjectsResult
+
+var QueryObjectsCommand = proto.QueryObjectsCommand
+
+// Releases remote object with given id.
+const ReleaseObject = proto.ReleaseObject
+
+type ReleaseObjectParams = proto.ReleaseObjectParams
+
+type ReleaseObjectResult = proto.ReleaseObjectResult
+
+var ReleaseObjectCommand = proto.ReleaseObjectCommand
+
+// Releases all remote objects that belong to a given group.
+const ReleaseObjectGroup = proto.ReleaseObjectGroup
+
+type ReleaseObjectGroupParams = proto.ReleaseObjectGroupParams
+
+type ReleaseObjectGroupResult = proto.ReleaseObjectGroupResult
+
+var ReleaseObjectGroupCommand = proto.ReleaseObjectGroupCommand
+
+// Tells inspected instance to run if it was waiting for debugger to attach.
+const RunIfWaitingForDebugger = proto.RunIfWaitingForDebugger
+
+type RunIfWaitingForDebuggerParams = proto.RunIfWaitingForDebuggerParams
+
+type RunIfWaitingForDebuggerResult = proto.RunIfWaitingForDebuggerResult
+
+var RunIfWaitingForDebuggerCommand = proto.RunIfWaitingForDebuggerCommand
+
+// Runs script with given id in a given context.
+const RunScript = proto.RunScript
+
+type RunScriptParams = proto.RunScriptParams
+
+type RunScriptResult = proto.RunScriptResult
+
+var RunScriptCommand = proto.RunScriptCommand
+
+// Enables or disables async call stacks tracking.
+const SetAsyncCallStackDepth = proto.SetAsyncCallStackDepth
+
+type SetAsyncCallStackDepthParams = proto.SetAsyncCallStackDepthParams
+
+type SetAsyncCallStackDepthResult = proto.SetAsyncCallStackDepthResult
+
+var SetAsyncCallStackDepthCommand = proto.SetAsyncCallStackDepthCommand
+
+// Issued when console API was called.
+const ConsoleAPICalledEvent = proto.ConsoleAPICalledEvent
+
+type ConsoleAPICalledParams = proto.ConsoleAPICalledParams
+
+// Issued when unhandled exception was revoked.
+const ExceptionRevokedEvent = proto.ExceptionRevokedEvent
+
+type ExceptionRevokedParams = proto.ExceptionRevokedParams
+
+// Issued when exception was thrown and unhandled.
+const ExceptionThrownEvent = proto.ExceptionThrownEvent
+
+type ExceptionThrownParams = proto.ExceptionThrownParams
+
+// Issued when new execution context is created.
+const ExecutionContextCreatedEvent = proto.ExecutionContextCreatedEvent
+
+type ExecutionContextCreatedParams = proto.ExecutionContextCreatedParams
+
+// Issued when execution context is destroyed.
+const ExecutionContextDestroyedEvent = proto.ExecutionContextDestroyedEvent
+
+type ExecutionContextDestroyedParams = proto.ExecutionContextDestroyedParams
+
+// Issued when all executionContexts were cleared in browser
+const ExecutionContextsClearedEvent = proto.ExecutionContextsClearedEvent
+
+type ExecutionContextsClearedParams = proto.ExecutionContextsClearedParams
+
+// Issued when object should be inspected (for example, as a result of inspect() command line API
+// call).
+const InspectRequestedEvent = proto.InspectRequestedEvent
+
+type InspectRequestedParams = proto.InspectRequestedParams
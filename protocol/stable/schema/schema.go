@@ -0,0 +1,20 @@
+// Package schema exposes the stable subset of the Schema domain of the DevTools protocol.
+//
+// This domain is deprecated.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+package schema
+
+import proto "github.com/diiyw/cuto/protocol/schema"
+
+// Description of the protocol domain.
+type Domain = proto.Domain
+
+// Returns supported domains.
+const GetDomains = proto.GetDomains
+
+type GetDomainsParams = proto.GetDomainsParams
+
+type GetDomainsResult = proto.GetDomainsResult
+
+var GetDomainsCommand = proto.GetDomainsCommand
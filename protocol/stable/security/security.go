@@ -0,0 +1,84 @@
+// Package security exposes the stable subset of the Security domain of the DevTools protocol.
+//
+// Security
+package security
+
+import proto "github.com/diiyw/cuto/protocol/security"
+
+// An internal certificate ID value.
+type CertificateId = proto.CertificateId
+
+// A description of mixed content (HTTP resources on HTTPS pages), as defined by
+// https://www.w3.org/TR/mixed-content/#categories
+type MixedContentType = proto.MixedContentType
+
+// The security level of a page or resource.
+type SecurityState = proto.SecurityState
+
+// An explanation of an factor contributing to the security state.
+type SecurityStateExplanation = proto.SecurityStateExplanation
+
+// Information about insecure content on the page.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+type InsecureContentStatus = proto.InsecureContentStatus
+
+// The action to take when a certificate error occurs. continue will continue processing the
+// request and cancel will cancel the request.
+type CertificateErrorAction = proto.CertificateErrorAction
+
+// Disables tracking security state changes.
+const Disable = proto.Disable
+
+type DisableParams = proto.DisableParams
+
+type DisableResult = proto.DisableResult
+
+var DisableCommand = proto.DisableCommand
+
+// Enables tracking security state changes.
+const Enable = proto.Enable
+
+type EnableParams = proto.EnableParams
+
+type EnableResult = proto.EnableResult
+
+var EnableCommand = proto.EnableCommand
+
+// Handles a certificate error that fired a certificateError event.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const HandleCertificateError = proto.HandleCertificateError
+
+type HandleCertificateErrorParams = proto.HandleCertificateErrorParams
+
+type HandleCertificateErrorResult = proto.HandleCertificateErrorResult
+
+var HandleCertificateErrorCommand = proto.HandleCertificateErrorCommand
+
+// Enable/disable overriding certificate errors. If enabled, all certificate error events need to
+// be handled by the DevTools client and should be answered with `handleCertificateError` commands.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const SetOverrideCertificateErrors = proto.SetOverrideCertificateErrors
+
+type SetOverrideCertificateErrorsParams = proto.SetOverrideCertificateErrorsParams
+
+type SetOverrideCertificateErrorsResult = proto.SetOverrideCertificateErrorsResult
+
+var SetOverrideCertificateErrorsCommand = proto.SetOverrideCertificateErrorsCommand
+
+// There is a certificate error. If overriding certificate errors is enabled, then it should be
+// handled with the `handleCertificateError` command. Note: this event does not fire if the
+// certificate error has been allowed internally. Only one client per target should override
+// certificate errors at the same time.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const CertificateErrorEvent = proto.CertificateErrorEvent
+
+type CertificateErrorParams = proto.CertificateErrorParams
+
+// The security state of the page changed.
+const SecurityStateChangedEvent = proto.SecurityStateChangedEvent
+
+type SecurityStateChangedParams = proto.SecurityStateChangedParams
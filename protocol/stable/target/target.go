@@ -0,0 +1,119 @@
+// Package target exposes the stable subset of the Target domain of the DevTools protocol.
+//
+// Supports additional targets discovery and allows to attach to them.
+package target
+
+import proto "github.com/diiyw/cuto/protocol/target"
+
+// 
+type TargetID = proto.TargetID
+
+// Unique identifier of attached debugging session.
+type SessionID = proto.SessionID
+
+// 
+type TargetInfo = proto.TargetInfo
+
+// Activates (focuses) the target.
+const ActivateTarget = proto.ActivateTarget
+
+type ActivateTargetParams = proto.ActivateTargetParams
+
+type ActivateTargetResult = proto.ActivateTargetResult
+
+var ActivateTargetCommand = proto.ActivateTargetCommand
+
+// Attaches to the target with given id.
+const AttachToTarget = proto.AttachToTarget
+
+type AttachToTargetParams = proto.AttachToTargetParams
+
+type AttachToTargetResult = proto.AttachToTargetResult
+
+var AttachToTargetCommand = proto.AttachToTargetCommand
+
+// Closes the target. If the target is a page that gets closed too.
+const CloseTarget = proto.CloseTarget
+
+type CloseTargetParams = proto.CloseTargetParams
+
+type CloseTargetResult = proto.CloseTargetResult
+
+var CloseTargetCommand = proto.CloseTargetCommand
+
+// Creates a new page.
+const CreateTarget = proto.CreateTarget
+
+type CreateTargetParams = proto.CreateTargetParams
+
+type CreateTargetResult = proto.CreateTargetResult
+
+var CreateTargetCommand = proto.CreateTargetCommand
+
+// Detaches session with given id.
+const DetachFromTarget = proto.DetachFromTarget
+
+type DetachFromTargetParams = proto.DetachFromTargetParams
+
+type DetachFromTargetResult = proto.DetachFromTargetResult
+
+var DetachFromTargetCommand = proto.DetachFromTargetCommand
+
+// Retrieves a list of available targets.
+const GetTargets = proto.GetTargets
+
+type GetTargetsParams = proto.GetTargetsParams
+
+type GetTargetsResult = proto.GetTargetsResult
+
+var GetTargetsCommand = proto.GetTargetsCommand
+
+// Sends protocol message over session with given id.
+// Consider using flat mode instead; see commands attachToTarget, setAutoAttach,
+// and crbug.com/991325.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
+const SendMessageToTarget = proto.SendMessageToTarget
+
+type SendMessageToTargetParams = proto.SendMessageToTargetParams
+
+type SendMessageToTargetResult = proto.SendMessageToTargetResult
+
+var SendMessageToTargetCommand = proto.SendMessageToTargetCommand
+
+// Controls whether to discover available targets and notify via
+// `targetCreated/targetInfoChanged/targetDestroyed` events.
+const SetDiscoverTargets = proto.SetDiscoverTargets
+
+type SetDiscoverTargetsParams = proto.SetDiscoverTargetsParams
+
+type SetDiscoverTargetsResult = proto.SetDiscoverTargetsResult
+
+var SetDiscoverTargetsCommand = proto.SetDiscoverTargetsCommand
+
+// Notifies about a new protocol message received from the session (as reported in
+// `attachedToTarget` event).
+const ReceivedMessageFromTargetEvent = proto.ReceivedMessageFromTargetEvent
+
+type ReceivedMessageFromTargetParams = proto.ReceivedMessageFromTargetParams
+
+// Issued when a possible inspection target is created.
+const TargetCreatedEvent = proto.TargetCreatedEvent
+
+type TargetCreatedParams = proto.TargetCreatedParams
+
+// Issued when a target is destroyed.
+const TargetDestroyedEvent = proto.TargetDestroyedEvent
+
+type TargetDestroyedParams = proto.TargetDestroyedParams
+
+// Issued when a target has crashed.
+const TargetCrashedEvent = proto.TargetCrashedEvent
+
+type TargetCrashedParams = proto.TargetCrashedParams
+
+// Issued when some information about a target has changed. This only happens between
+// `targetCreated` and `targetDestroyed`.
+const TargetInfoChangedEvent = proto.TargetInfoChangedEvent
+
+type TargetInfoChangedParams = proto.TargetInfoChangedParams
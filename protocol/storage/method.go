@@ -1,5 +1,10 @@
 package storage
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Clears storage for origin.
 const ClearDataForOrigin = "Storage.clearDataForOrigin"
 
@@ -16,6 +21,8 @@ type ClearDataForOriginResult struct {
 
 }
 
+var ClearDataForOriginCommand = cdp.Command[ClearDataForOriginParams, ClearDataForOriginResult]{Method: ClearDataForOrigin}
+
 // Returns usage and quota in bytes.
 const GetUsageAndQuota = "Storage.getUsageAndQuota"
 
@@ -35,6 +42,8 @@ type GetUsageAndQuotaResult struct {
 	UsageBreakdown 	[]*UsageForType	`json:"usageBreakdown"`
 }
 
+var GetUsageAndQuotaCommand = cdp.Command[GetUsageAndQuotaParams, GetUsageAndQuotaResult]{Method: GetUsageAndQuota}
+
 // Registers origin to be notified when an update occurs to its cache storage list.
 const TrackCacheStorageForOrigin = "Storage.trackCacheStorageForOrigin"
 
@@ -48,6 +57,8 @@ type TrackCacheStorageForOriginResult struct {
 
 }
 
+var TrackCacheStorageForOriginCommand = cdp.Command[TrackCacheStorageForOriginParams, TrackCacheStorageForOriginResult]{Method: TrackCacheStorageForOrigin}
+
 // Registers origin to be notified when an update occurs to its IndexedDB.
 const TrackIndexedDBForOrigin = "Storage.trackIndexedDBForOrigin"
 
@@ -61,6 +72,8 @@ type TrackIndexedDBForOriginResult struct {
 
 }
 
+var TrackIndexedDBForOriginCommand = cdp.Command[TrackIndexedDBForOriginParams, TrackIndexedDBForOriginResult]{Method: TrackIndexedDBForOrigin}
+
 // Unregisters origin from receiving notifications for cache storage.
 const UntrackCacheStorageForOrigin = "Storage.untrackCacheStorageForOrigin"
 
@@ -74,6 +87,8 @@ type UntrackCacheStorageForOriginResult struct {
 
 }
 
+var UntrackCacheStorageForOriginCommand = cdp.Command[UntrackCacheStorageForOriginParams, UntrackCacheStorageForOriginResult]{Method: UntrackCacheStorageForOrigin}
+
 // Unregisters origin from receiving notifications for IndexedDB.
 const UntrackIndexedDBForOrigin = "Storage.untrackIndexedDBForOrigin"
 
@@ -85,4 +100,6 @@ type UntrackIndexedDBForOriginParams struct {
 
 type UntrackIndexedDBForOriginResult struct {
 
-}
\ No newline at end of file
+}
+
+var UntrackIndexedDBForOriginCommand = cdp.Command[UntrackIndexedDBForOriginParams, UntrackIndexedDBForOriginResult]{Method: UntrackIndexedDBForOrigin}
\ No newline at end of file
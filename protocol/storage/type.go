@@ -1,3 +1,6 @@
+// Package storage implements the Storage domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package storage
 // Enum of possible storage types.
 type StorageType string
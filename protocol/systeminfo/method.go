@@ -1,5 +1,10 @@
 package systeminfo
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Returns information about the system.
 const GetInfo = "SystemInfo.getInfo"
 
@@ -21,6 +26,8 @@ type GetInfoResult struct {
 	CommandLine 	string	`json:"commandLine"`
 }
 
+var GetInfoCommand = cdp.Command[GetInfoParams, GetInfoResult]{Method: GetInfo}
+
 // Returns information about all running processes.
 const GetProcessInfo = "SystemInfo.getProcessInfo"
 
@@ -31,4 +38,6 @@ type GetProcessInfoResult struct {
 
 	// An array of process info blocks.
 	ProcessInfo 	[]*ProcessInfo	`json:"processInfo"`
-}
\ No newline at end of file
+}
+
+var GetProcessInfoCommand = cdp.Command[GetProcessInfoParams, GetProcessInfoResult]{Method: GetProcessInfo}
\ No newline at end of file
@@ -1,3 +1,8 @@
+// Package systeminfo implements the SystemInfo domain of the DevTools protocol.
+//
+// The SystemInfo domain defines methods and events for querying low-level system information.
+//
+// Experimental: may be changed or removed without notice.
 package systeminfo
 // Describes a single graphics processor (GPU).
 type GPUDevice  struct {
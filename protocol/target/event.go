@@ -1,6 +1,8 @@
 package target
 
 // Issued when attached to target because of auto-attach or `attachToTarget` command.
+//
+// Experimental: may be changed or removed without notice.
 const AttachedToTargetEvent = "Target.attachedToTarget"
 type AttachedToTargetParams struct {
 
@@ -15,12 +17,16 @@ type AttachedToTargetParams struct {
 
 // Issued when detached from target for any reason (including `detachFromTarget` command). Can be
 // issued multiple times per target if multiple sessions have been attached to it.
+//
+// Experimental: may be changed or removed without notice.
 const DetachedFromTargetEvent = "Target.detachedFromTarget"
 type DetachedFromTargetParams struct {
 
 	// Detached session identifier.
 	SessionId 	SessionID
 	// Deprecated.
+	//
+	// Deprecated: marked as deprecated by the DevTools protocol.
 	TargetId 	TargetID}
 
 
@@ -35,6 +41,8 @@ type ReceivedMessageFromTargetParams struct {
 	// 
 	Message 	string
 	// Deprecated.
+	//
+	// Deprecated: marked as deprecated by the DevTools protocol.
 	TargetId 	TargetID}
 
 
@@ -1,5 +1,10 @@
 package target
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Activates (focuses) the target.
 const ActivateTarget = "Target.activateTarget"
 
@@ -13,6 +18,8 @@ type ActivateTargetResult struct {
 
 }
 
+var ActivateTargetCommand = cdp.Command[ActivateTargetParams, ActivateTargetResult]{Method: ActivateTarget}
+
 // Attaches to the target with given id.
 const AttachToTarget = "Target.attachToTarget"
 
@@ -33,7 +40,11 @@ type AttachToTargetResult struct {
 	SessionId 	SessionID	`json:"sessionId"`
 }
 
+var AttachToTargetCommand = cdp.Command[AttachToTargetParams, AttachToTargetResult]{Method: AttachToTarget}
+
 // Attaches to the browser target, only uses flat sessionId mode.
+//
+// Experimental: may be changed or removed without notice.
 const AttachToBrowserTarget = "Target.attachToBrowserTarget"
 
 type AttachToBrowserTargetParams struct {
@@ -45,6 +56,8 @@ type AttachToBrowserTargetResult struct {
 	SessionId 	SessionID	`json:"sessionId"`
 }
 
+var AttachToBrowserTargetCommand = cdp.Command[AttachToBrowserTargetParams, AttachToBrowserTargetResult]{Method: AttachToBrowserTarget}
+
 // Closes the target. If the target is a page that gets closed too.
 const CloseTarget = "Target.closeTarget"
 
@@ -60,6 +73,8 @@ type CloseTargetResult struct {
 	Success 	bool	`json:"success"`
 }
 
+var CloseTargetCommand = cdp.Command[CloseTargetParams, CloseTargetResult]{Method: CloseTarget}
+
 // Inject object to the target's main frame that provides a communication
 // channel with browser target.
 // 
@@ -68,6 +83,8 @@ type CloseTargetResult struct {
 // The object has the follwing API:
 // - `binding.send(json)` - a method to send messages over the remote debugging protocol
 // - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.
+//
+// Experimental: may be changed or removed without notice.
 const ExposeDevToolsProtocol = "Target.exposeDevToolsProtocol"
 
 type ExposeDevToolsProtocolParams struct {
@@ -83,8 +100,12 @@ type ExposeDevToolsProtocolResult struct {
 
 }
 
+var ExposeDevToolsProtocolCommand = cdp.Command[ExposeDevToolsProtocolParams, ExposeDevToolsProtocolResult]{Method: ExposeDevToolsProtocol}
+
 // Creates a new empty BrowserContext. Similar to an incognito profile but you can have more than
 // one.
+//
+// Experimental: may be changed or removed without notice.
 const CreateBrowserContext = "Target.createBrowserContext"
 
 type CreateBrowserContextParams struct {
@@ -96,7 +117,11 @@ type CreateBrowserContextResult struct {
 	BrowserContextId 	BrowserContextID	`json:"browserContextId"`
 }
 
+var CreateBrowserContextCommand = cdp.Command[CreateBrowserContextParams, CreateBrowserContextResult]{Method: CreateBrowserContext}
+
 // Returns all browser contexts created with `Target.createBrowserContext` method.
+//
+// Experimental: may be changed or removed without notice.
 const GetBrowserContexts = "Target.getBrowserContexts"
 
 type GetBrowserContextsParams struct {
@@ -108,6 +133,8 @@ type GetBrowserContextsResult struct {
 	BrowserContextIds 	[]*BrowserContextID	`json:"browserContextIds"`
 }
 
+var GetBrowserContextsCommand = cdp.Command[GetBrowserContextsParams, GetBrowserContextsResult]{Method: GetBrowserContexts}
+
 // Creates a new page.
 const CreateTarget = "Target.createTarget"
 
@@ -127,6 +154,8 @@ type CreateTargetParams struct {
 
 	// Whether BeginFrames for this target will be controlled via DevTools (headless chrome only,
 	// not supported on MacOS yet, false by default).
+	//
+	// Experimental: may be changed or removed without notice.
 	EnableBeginFrameControl 	bool	`json:"enableBeginFrameControl,omitempty"`
 
 	// Whether to create a new Window or Tab (chrome-only, false by default).
@@ -143,6 +172,8 @@ type CreateTargetResult struct {
 	TargetId 	TargetID	`json:"targetId"`
 }
 
+var CreateTargetCommand = cdp.Command[CreateTargetParams, CreateTargetResult]{Method: CreateTarget}
+
 // Detaches session with given id.
 const DetachFromTarget = "Target.detachFromTarget"
 
@@ -152,6 +183,8 @@ type DetachFromTargetParams struct {
 	SessionId 	SessionID	`json:"sessionId,omitempty"`
 
 	// Deprecated.
+	//
+	// Deprecated: marked as deprecated by the DevTools protocol.
 	TargetId 	TargetID	`json:"targetId,omitempty"`
 }
 
@@ -159,8 +192,12 @@ type DetachFromTargetResult struct {
 
 }
 
+var DetachFromTargetCommand = cdp.Command[DetachFromTargetParams, DetachFromTargetResult]{Method: DetachFromTarget}
+
 // Deletes a BrowserContext. All the belonging pages will be closed without calling their
 // beforeunload hooks.
+//
+// Experimental: may be changed or removed without notice.
 const DisposeBrowserContext = "Target.disposeBrowserContext"
 
 type DisposeBrowserContextParams struct {
@@ -173,7 +210,11 @@ type DisposeBrowserContextResult struct {
 
 }
 
+var DisposeBrowserContextCommand = cdp.Command[DisposeBrowserContextParams, DisposeBrowserContextResult]{Method: DisposeBrowserContext}
+
 // Returns information about a target.
+//
+// Experimental: may be changed or removed without notice.
 const GetTargetInfo = "Target.getTargetInfo"
 
 type GetTargetInfoParams struct {
@@ -188,6 +229,8 @@ type GetTargetInfoResult struct {
 	TargetInfo 	TargetInfo	`json:"targetInfo"`
 }
 
+var GetTargetInfoCommand = cdp.Command[GetTargetInfoParams, GetTargetInfoResult]{Method: GetTargetInfo}
+
 // Retrieves a list of available targets.
 const GetTargets = "Target.getTargets"
 
@@ -200,9 +243,13 @@ type GetTargetsResult struct {
 	TargetInfos 	[]*TargetInfo	`json:"targetInfos"`
 }
 
+var GetTargetsCommand = cdp.Command[GetTargetsParams, GetTargetsResult]{Method: GetTargets}
+
 // Sends protocol message over session with given id.
 // Consider using flat mode instead; see commands attachToTarget, setAutoAttach,
 // and crbug.com/991325.
+//
+// Deprecated: marked as deprecated by the DevTools protocol.
 const SendMessageToTarget = "Target.sendMessageToTarget"
 
 type SendMessageToTargetParams struct {
@@ -214,6 +261,8 @@ type SendMessageToTargetParams struct {
 	SessionId 	SessionID	`json:"sessionId,omitempty"`
 
 	// Deprecated.
+	//
+	// Deprecated: marked as deprecated by the DevTools protocol.
 	TargetId 	TargetID	`json:"targetId,omitempty"`
 }
 
@@ -221,9 +270,13 @@ type SendMessageToTargetResult struct {
 
 }
 
+var SendMessageToTargetCommand = cdp.Command[SendMessageToTargetParams, SendMessageToTargetResult]{Method: SendMessageToTarget}
+
 // Controls whether to automatically attach to new targets which are considered to be related to
 // this one. When turned on, attaches to all existing related targets as well. When turned off,
 // automatically detaches from all currently attached targets.
+//
+// Experimental: may be changed or removed without notice.
 const SetAutoAttach = "Target.setAutoAttach"
 
 type SetAutoAttachParams struct {
@@ -241,6 +294,8 @@ type SetAutoAttachParams struct {
 	Flatten 	bool	`json:"flatten,omitempty"`
 
 	// Auto-attach to the targets created via window.open from current target.
+	//
+	// Experimental: may be changed or removed without notice.
 	WindowOpen 	bool	`json:"windowOpen,omitempty"`
 }
 
@@ -248,6 +303,8 @@ type SetAutoAttachResult struct {
 
 }
 
+var SetAutoAttachCommand = cdp.Command[SetAutoAttachParams, SetAutoAttachResult]{Method: SetAutoAttach}
+
 // Controls whether to discover available targets and notify via
 // `targetCreated/targetInfoChanged/targetDestroyed` events.
 const SetDiscoverTargets = "Target.setDiscoverTargets"
@@ -262,8 +319,12 @@ type SetDiscoverTargetsResult struct {
 
 }
 
+var SetDiscoverTargetsCommand = cdp.Command[SetDiscoverTargetsParams, SetDiscoverTargetsResult]{Method: SetDiscoverTargets}
+
 // Enables target discovery for the specified locations, when `setDiscoverTargets` was set to
 // `true`.
+//
+// Experimental: may be changed or removed without notice.
 const SetRemoteLocations = "Target.setRemoteLocations"
 
 type SetRemoteLocationsParams struct {
@@ -274,4 +335,6 @@ type SetRemoteLocationsParams struct {
 
 type SetRemoteLocationsResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetRemoteLocationsCommand = cdp.Command[SetRemoteLocationsParams, SetRemoteLocationsResult]{Method: SetRemoteLocations}
\ No newline at end of file
@@ -1,3 +1,6 @@
+// Package target implements the Target domain of the DevTools protocol.
+//
+// Supports additional targets discovery and allows to attach to them.
 package target
 // 
 type TargetID string
@@ -6,6 +9,8 @@ type TargetID string
 type SessionID string
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type BrowserContextID string
 
 // 
@@ -30,10 +35,14 @@ type TargetInfo  struct {
 	OpenerId	TargetID	`json:"openerId,omitempty"`
 
 	// 
+	//
+	// Experimental: may be changed or removed without notice.
 	BrowserContextId	BrowserContextID	`json:"browserContextId,omitempty"`
 }
 
 // 
+//
+// Experimental: may be changed or removed without notice.
 type RemoteLocation  struct {
 
 	// 
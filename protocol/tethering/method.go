@@ -1,5 +1,10 @@
 package tethering
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Request browser port binding.
 const Bind = "Tethering.bind"
 
@@ -13,6 +18,8 @@ type BindResult struct {
 
 }
 
+var BindCommand = cdp.Command[BindParams, BindResult]{Method: Bind}
+
 // Request browser port unbinding.
 const Unbind = "Tethering.unbind"
 
@@ -24,4 +31,6 @@ type UnbindParams struct {
 
 type UnbindResult struct {
 
-}
\ No newline at end of file
+}
+
+var UnbindCommand = cdp.Command[UnbindParams, UnbindResult]{Method: Unbind}
\ No newline at end of file
@@ -1 +1,6 @@
+// Package tethering implements the Tethering domain of the DevTools protocol.
+//
+// The Tethering domain defines methods and events for browser port binding.
+//
+// Experimental: may be changed or removed without notice.
 package tethering
\ No newline at end of file
@@ -1,5 +1,10 @@
 package tracing
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Stop trace events collection.
 const End = "Tracing.end"
 
@@ -10,6 +15,8 @@ type EndResult struct {
 
 }
 
+var EndCommand = cdp.Command[EndParams, EndResult]{Method: End}
+
 // Gets supported tracing categories.
 const GetCategories = "Tracing.getCategories"
 
@@ -22,6 +29,8 @@ type GetCategoriesResult struct {
 	Categories 	[]string	`json:"categories"`
 }
 
+var GetCategoriesCommand = cdp.Command[GetCategoriesParams, GetCategoriesResult]{Method: GetCategories}
+
 // Record a clock sync marker in the trace.
 const RecordClockSyncMarker = "Tracing.recordClockSyncMarker"
 
@@ -35,6 +44,8 @@ type RecordClockSyncMarkerResult struct {
 
 }
 
+var RecordClockSyncMarkerCommand = cdp.Command[RecordClockSyncMarkerParams, RecordClockSyncMarkerResult]{Method: RecordClockSyncMarker}
+
 // Request a global memory dump.
 const RequestMemoryDump = "Tracing.requestMemoryDump"
 
@@ -52,15 +63,21 @@ type RequestMemoryDumpResult struct {
 	Success 	bool	`json:"success"`
 }
 
+var RequestMemoryDumpCommand = cdp.Command[RequestMemoryDumpParams, RequestMemoryDumpResult]{Method: RequestMemoryDump}
+
 // Start trace events collection.
 const Start = "Tracing.start"
 
 type StartParams struct {
 
 	// Category/tag filter
+	//
+	// Deprecated: marked as deprecated by the DevTools protocol.
 	Categories 	string	`json:"categories,omitempty"`
 
 	// Tracing options
+	//
+	// Deprecated: marked as deprecated by the DevTools protocol.
 	Options 	string	`json:"options,omitempty"`
 
 	// If set, the agent will issue bufferUsage events at this interval, specified in milliseconds
@@ -84,4 +101,6 @@ type StartParams struct {
 
 type StartResult struct {
 
-}
\ No newline at end of file
+}
+
+var StartCommand = cdp.Command[StartParams, StartResult]{Method: Start}
\ No newline at end of file
@@ -1,3 +1,6 @@
+// Package tracing implements the Tracing domain of the DevTools protocol.
+//
+// Experimental: may be changed or removed without notice.
 package tracing
 // Configuration for memory dump. Used only when "memory-infra" category is enabled.
 type MemoryDumpConfig interface{}
@@ -1,5 +1,10 @@
 package webaudio
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Enables the WebAudio domain and starts sending context lifetime events.
 const Enable = "WebAudio.enable"
 
@@ -10,6 +15,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Disables the WebAudio domain.
 const Disable = "WebAudio.disable"
 
@@ -20,6 +27,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Fetch the realtime data from the registered contexts.
 const GetRealtimeData = "WebAudio.getRealtimeData"
 
@@ -33,4 +42,6 @@ type GetRealtimeDataResult struct {
 
 	// 
 	RealtimeData 	ContextRealtimeData	`json:"realtimeData"`
-}
\ No newline at end of file
+}
+
+var GetRealtimeDataCommand = cdp.Command[GetRealtimeDataParams, GetRealtimeDataResult]{Method: GetRealtimeData}
\ No newline at end of file
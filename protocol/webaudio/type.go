@@ -1,3 +1,9 @@
+// Package webaudio implements the WebAudio domain of the DevTools protocol.
+//
+// This domain allows inspection of Web Audio API.
+// https://webaudio.github.io/web-audio-api/
+//
+// Experimental: may be changed or removed without notice.
 package webaudio
 // An unique ID for a graph object (AudioContext, AudioNode, AudioParam) in Web Audio API
 type GraphObjectId string
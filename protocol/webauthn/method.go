@@ -1,5 +1,10 @@
 package webauthn
 
+import (
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+
 // Enable the WebAuthn domain and start intercepting credential storage and
 // retrieval with a virtual authenticator.
 const Enable = "WebAuthn.enable"
@@ -11,6 +16,8 @@ type EnableResult struct {
 
 }
 
+var EnableCommand = cdp.Command[EnableParams, EnableResult]{Method: Enable}
+
 // Disable the WebAuthn domain.
 const Disable = "WebAuthn.disable"
 
@@ -21,6 +28,8 @@ type DisableResult struct {
 
 }
 
+var DisableCommand = cdp.Command[DisableParams, DisableResult]{Method: Disable}
+
 // Creates and adds a virtual authenticator.
 const AddVirtualAuthenticator = "WebAuthn.addVirtualAuthenticator"
 
@@ -36,6 +45,8 @@ type AddVirtualAuthenticatorResult struct {
 	AuthenticatorId 	AuthenticatorId	`json:"authenticatorId"`
 }
 
+var AddVirtualAuthenticatorCommand = cdp.Command[AddVirtualAuthenticatorParams, AddVirtualAuthenticatorResult]{Method: AddVirtualAuthenticator}
+
 // Removes the given authenticator.
 const RemoveVirtualAuthenticator = "WebAuthn.removeVirtualAuthenticator"
 
@@ -49,6 +60,8 @@ type RemoveVirtualAuthenticatorResult struct {
 
 }
 
+var RemoveVirtualAuthenticatorCommand = cdp.Command[RemoveVirtualAuthenticatorParams, RemoveVirtualAuthenticatorResult]{Method: RemoveVirtualAuthenticator}
+
 // Adds the credential to the specified authenticator.
 const AddCredential = "WebAuthn.addCredential"
 
@@ -65,6 +78,8 @@ type AddCredentialResult struct {
 
 }
 
+var AddCredentialCommand = cdp.Command[AddCredentialParams, AddCredentialResult]{Method: AddCredential}
+
 // Returns a single credential stored in the given virtual authenticator that
 // matches the credential ID.
 const GetCredential = "WebAuthn.getCredential"
@@ -84,6 +99,8 @@ type GetCredentialResult struct {
 	Credential 	Credential	`json:"credential"`
 }
 
+var GetCredentialCommand = cdp.Command[GetCredentialParams, GetCredentialResult]{Method: GetCredential}
+
 // Returns all the credentials stored in the given virtual authenticator.
 const GetCredentials = "WebAuthn.getCredentials"
 
@@ -99,6 +116,8 @@ type GetCredentialsResult struct {
 	Credentials 	[]*Credential	`json:"credentials"`
 }
 
+var GetCredentialsCommand = cdp.Command[GetCredentialsParams, GetCredentialsResult]{Method: GetCredentials}
+
 // Removes a credential from the authenticator.
 const RemoveCredential = "WebAuthn.removeCredential"
 
@@ -115,6 +134,8 @@ type RemoveCredentialResult struct {
 
 }
 
+var RemoveCredentialCommand = cdp.Command[RemoveCredentialParams, RemoveCredentialResult]{Method: RemoveCredential}
+
 // Clears all the credentials from the specified device.
 const ClearCredentials = "WebAuthn.clearCredentials"
 
@@ -128,6 +149,8 @@ type ClearCredentialsResult struct {
 
 }
 
+var ClearCredentialsCommand = cdp.Command[ClearCredentialsParams, ClearCredentialsResult]{Method: ClearCredentials}
+
 // Sets whether User Verification succeeds or fails for an authenticator.
 // The default is true.
 const SetUserVerified = "WebAuthn.setUserVerified"
@@ -143,4 +166,6 @@ type SetUserVerifiedParams struct {
 
 type SetUserVerifiedResult struct {
 
-}
\ No newline at end of file
+}
+
+var SetUserVerifiedCommand = cdp.Command[SetUserVerifiedParams, SetUserVerifiedResult]{Method: SetUserVerified}
\ No newline at end of file
@@ -1,3 +1,9 @@
+// Package webauthn implements the WebAuthn domain of the DevTools protocol.
+//
+// This domain allows configuring virtual authenticators to test the WebAuthn
+// API.
+//
+// Experimental: may be changed or removed without notice.
 package webauthn
 // 
 type AuthenticatorId string
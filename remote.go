@@ -0,0 +1,350 @@
+package cuto
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+// 默认的转换深度
+const defaultDepth = 3
+
+// 对象组的序号
+var inspectGroups int64
+
+// converter turns remote objects into Go values: objects become
+// map[string]interface{}, arrays and sets []interface{}, numbers float64
+// and bigints *big.Int. Objects deeper than depth are converted from
+// their preview, or to their description when there is none.
+type converter struct {
+	frame *Frame
+	ctx   context.Context
+	// 转换时产生的远程对象，转换后释放
+	objects []runtime.RemoteObjectId
+}
+
+// 转换远程对象为Go的值，depth为展开的层数，小于0时使用默认值
+func (tab *Tab) Convert(object runtime.RemoteObject, depth int) (interface{}, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	return main.Convert(object, depth)
+}
+
+// 转换框架中的远程对象为Go的值，depth为展开的层数，小于0时使用默认值
+func (f *Frame) Convert(object runtime.RemoteObject, depth int) (interface{}, error) {
+	ctx, cancel := f.tab.context()
+	defer cancel()
+	c := &converter{frame: f, ctx: f.route(ctx)}
+	defer c.release()
+	if depth < 0 {
+		depth = defaultDepth
+	}
+	return c.convert(object, depth)
+}
+
+// 在主框架中运行Javascript，结果按层数转换为Go的值，适用于不能按值返回的对象
+func (tab *Tab) Inspect(expression string, depth int, options ...EvalOption) (interface{}, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	return main.Inspect(expression, depth, options...)
+}
+
+// 在框架中运行Javascript，结果按层数转换为Go的值，适用于不能按值返回的对象
+func (f *Frame) Inspect(expression string, depth int, options ...EvalOption) (interface{}, error) {
+	var o = newEvalOptions(options)
+	ctx, cancel := f.tab.context()
+	defer cancel()
+	var id = o.context
+	if id == 0 {
+		var err error
+		if id, err = f.executionContext(ctx, o.world); err != nil {
+			return nil, err
+		}
+	}
+	ctx = f.route(ctx)
+	group := "cuto-inspect-" + strconv.FormatInt(atomic.AddInt64(&inspectGroups, 1), 10)
+	result, err := Do(ctx, f.tab, runtime.EvaluateCommand, runtime.EvaluateParams{
+		Expression:      expression,
+		ContextId:       id,
+		ObjectGroup:     group,
+		AwaitPromise:    true,
+		GeneratePreview: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer Do(ctx, f.tab, runtime.ReleaseObjectGroupCommand, runtime.ReleaseObjectGroupParams{ObjectGroup: group})
+	if err := exceptionError(result.ExceptionDetails); err != nil {
+		return nil, err
+	}
+	if depth < 0 {
+		depth = defaultDepth
+	}
+	c := &converter{frame: f, ctx: ctx}
+	return c.convert(result.Result, depth)
+}
+
+func (c *converter) release() {
+	for _, id := range c.objects {
+		_, _ = Do(c.ctx, c.frame.tab, runtime.ReleaseObjectCommand, runtime.ReleaseObjectParams{ObjectId: id})
+	}
+}
+
+func (c *converter) convert(object runtime.RemoteObject, depth int) (interface{}, error) {
+	if object.UnserializableValue != "" {
+		return unserializable(string(object.UnserializableValue)), nil
+	}
+	switch object.Type {
+	case "undefined":
+		return nil, nil
+	case "string", "number", "boolean":
+		return object.Value, nil
+	case "bigint":
+		return unserializable(object.Description + "n"), nil
+	case "function", "symbol":
+		return object.Description, nil
+	}
+	if object.Subtype == "null" || object.ObjectId == "" {
+		return object.Value, nil
+	}
+	switch object.Subtype {
+	case "node", "regexp", "date", "error", "promise", "proxy", "weakmap", "weakset":
+		return object.Description, nil
+	}
+	if depth <= 0 {
+		if object.Preview.Type != "" {
+			return previewValue(object.Preview), nil
+		}
+		return object.Description, nil
+	}
+	properties, err := Do(c.ctx, c.frame.tab, runtime.GetPropertiesCommand, runtime.GetPropertiesParams{
+		ObjectId:        object.ObjectId,
+		OwnProperties:   true,
+		GeneratePreview: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range properties.Result {
+		c.track(p.Value)
+	}
+	for _, p := range properties.InternalProperties {
+		c.track(p.Value)
+	}
+	switch object.Subtype {
+	case "map", "set":
+		return c.entries(object.Subtype, properties.InternalProperties, depth)
+	case "array", "typedarray":
+		return c.array(properties.Result, depth)
+	}
+	var m = make(map[string]interface{})
+	for _, p := range properties.Result {
+		// 跳过访问器和不可枚举的属性
+		if !p.Enumerable || p.Value.Type == "" {
+			continue
+		}
+		v, err := c.convert(p.Value, depth-1)
+		if err != nil {
+			return nil, err
+		}
+		m[p.Name] = v
+	}
+	return m, nil
+}
+
+// track remembers objects created by getProperties to release them.
+func (c *converter) track(object runtime.RemoteObject) {
+	if object.ObjectId != "" {
+		c.objects = append(c.objects, object.ObjectId)
+	}
+}
+
+func (c *converter) array(properties []*runtime.PropertyDescriptor, depth int) (interface{}, error) {
+	var items = make(map[int]runtime.RemoteObject)
+	var indexes []int
+	for _, p := range properties {
+		i, err := strconv.Atoi(p.Name)
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, i)
+		items[i] = p.Value
+	}
+	sort.Ints(indexes)
+	var values = make([]interface{}, 0, len(indexes))
+	for _, i := range indexes {
+		v, err := c.convert(items[i], depth-1)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// entries converts the [[Entries]] of a Map to a map keyed by the string
+// form of the keys and the ones of a Set to a slice.
+func (c *converter) entries(subtype string, internal []*runtime.InternalPropertyDescriptor, depth int) (interface{}, error) {
+	var list runtime.RemoteObject
+	for _, p := range internal {
+		if p.Name == "[[Entries]]" {
+			list = p.Value
+		}
+	}
+	var values []interface{}
+	var m = make(map[string]interface{})
+	if list.ObjectId == "" {
+		if subtype == "map" {
+			return m, nil
+		}
+		return values, nil
+	}
+	properties, err := Do(c.ctx, c.frame.tab, runtime.GetPropertiesCommand, runtime.GetPropertiesParams{ObjectId: list.ObjectId, OwnProperties: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range properties.Result {
+		c.track(p.Value)
+	}
+	// 每个条目是含key和value的内部对象
+	for _, p := range properties.Result {
+		if _, err := strconv.Atoi(p.Name); err != nil || p.Value.ObjectId == "" {
+			continue
+		}
+		entry, err := Do(c.ctx, c.frame.tab, runtime.GetPropertiesCommand, runtime.GetPropertiesParams{ObjectId: p.Value.ObjectId, OwnProperties: true})
+		if err != nil {
+			return nil, err
+		}
+		var key, value interface{}
+		for _, e := range entry.Result {
+			c.track(e.Value)
+			v, err := c.convert(e.Value, depth-1)
+			if err != nil {
+				return nil, err
+			}
+			switch e.Name {
+			case "key":
+				key = v
+			case "value":
+				value = v
+			}
+		}
+		if subtype == "map" {
+			m[keyString(key)] = value
+		} else {
+			values = append(values, value)
+		}
+	}
+	if subtype == "map" {
+		return m, nil
+	}
+	return values, nil
+}
+
+// keyString formats a Map key as a map key.
+func keyString(key interface{}) string {
+	switch k := key.(type) {
+	case string:
+		return k
+	case float64:
+		return strconv.FormatFloat(k, 'g', -1, 64)
+	case nil:
+		return "null"
+	}
+	return fmt.Sprint(key)
+}
+
+// unserializable converts values JSON cannot represent.
+func unserializable(value string) interface{} {
+	switch value {
+	case "NaN":
+		return math.NaN()
+	case "Infinity":
+		return math.Inf(1)
+	case "-Infinity":
+		return math.Inf(-1)
+	case "-0":
+		return math.Copysign(0, -1)
+	}
+	if strings.HasSuffix(value, "n") {
+		if i, ok := new(big.Int).SetString(strings.TrimSuffix(value, "n"), 10); ok {
+			return i
+		}
+	}
+	return value
+}
+
+// previewValue converts the preview of an object, previews only hold a
+// few properties and abbreviate nested objects.
+func previewValue(preview runtime.ObjectPreview) interface{} {
+	switch preview.Subtype {
+	case "map":
+		var m = make(map[string]interface{})
+		for _, e := range preview.Entries {
+			m[keyString(previewValue(e.Key))] = previewValue(e.Value)
+		}
+		return m
+	case "set":
+		var values = make([]interface{}, 0, len(preview.Entries))
+		for _, e := range preview.Entries {
+			values = append(values, previewValue(e.Value))
+		}
+		return values
+	case "array", "typedarray":
+		var values = make([]interface{}, 0, len(preview.Properties))
+		for _, p := range preview.Properties {
+			if _, err := strconv.Atoi(p.Name); err == nil {
+				values = append(values, propertyValue(p))
+			}
+		}
+		return values
+	}
+	if preview.Type != "object" {
+		return propertyValue(&runtime.PropertyPreview{Type: preview.Type, Value: preview.Description})
+	}
+	if preview.Subtype != "" {
+		return preview.Description
+	}
+	var m = make(map[string]interface{})
+	for _, p := range preview.Properties {
+		m[p.Name] = propertyValue(p)
+	}
+	return m
+}
+
+func propertyValue(p *runtime.PropertyPreview) interface{} {
+	switch p.Type {
+	case "undefined":
+		return nil
+	case "string":
+		return p.Value
+	case "boolean":
+		return p.Value == "true"
+	case "number":
+		if f, err := strconv.ParseFloat(p.Value, 64); err == nil {
+			return f
+		}
+		return unserializable(p.Value)
+	case "bigint":
+		return unserializable(p.Value)
+	case "object":
+		if p.Subtype == "null" {
+			return nil
+		}
+		if p.ValuePreview.Type != "" {
+			return previewValue(p.ValuePreview)
+		}
+	}
+	return p.Value
+}
@@ -0,0 +1,135 @@
+package cuto
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestInspect(t *testing.T) {
+	var mu sync.Mutex
+	var released []interface{}
+	var group interface{}
+	object := func(id, subtype string) map[string]interface{} {
+		return map[string]interface{}{"type": "object", "subtype": subtype, "objectId": id}
+	}
+	property := func(name string, value map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{"name": name, "value": value, "enumerable": true}
+	}
+	properties := map[string]interface{}{
+		"obj": map[string]interface{}{"result": []interface{}{
+			property("name", map[string]interface{}{"type": "string", "value": "百度"}),
+			property("big", map[string]interface{}{"type": "bigint", "unserializableValue": "12n"}),
+			property("nan", map[string]interface{}{"type": "number", "unserializableValue": "NaN"}),
+			property("when", map[string]interface{}{"type": "object", "subtype": "date", "objectId": "date", "description": "Mon Oct 19 2026"}),
+			property("list", object("arr", "array")),
+			property("tags", object("map", "map")),
+			property("deep", object("deep", "")),
+			map[string]interface{}{"name": "hidden", "value": map[string]interface{}{"type": "number", "value": 1}},
+		}},
+		"arr": map[string]interface{}{"result": []interface{}{
+			property("1", map[string]interface{}{"type": "number", "value": 2}),
+			property("0", map[string]interface{}{"type": "object", "subtype": "null", "value": nil}),
+			map[string]interface{}{"name": "length", "value": map[string]interface{}{"type": "number", "value": 2}},
+		}},
+		"map": map[string]interface{}{"result": []interface{}{}, "internalProperties": []interface{}{
+			map[string]interface{}{"name": "[[Entries]]", "value": object("entries", "array")},
+		}},
+		"entries": map[string]interface{}{"result": []interface{}{property("0", object("entry", "internal#entry"))}},
+		"entry": map[string]interface{}{"result": []interface{}{
+			property("key", map[string]interface{}{"type": "string", "value": "hot"}),
+			property("value", map[string]interface{}{"type": "boolean", "value": true}),
+		}},
+		"deep": map[string]interface{}{"result": []interface{}{
+			property("x", map[string]interface{}{"type": "object", "objectId": "deeper", "preview": map[string]interface{}{
+				"type": "object", "overflow": false,
+				"properties": []interface{}{map[string]interface{}{"name": "y", "type": "number", "value": "3"}},
+			}}),
+		}},
+	}
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		switch method {
+		case runtime.Evaluate:
+			if params["returnByValue"] == true || params["objectGroup"] == nil {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			mu.Lock()
+			group = params["objectGroup"]
+			mu.Unlock()
+			return map[string]interface{}{"result": object("obj", "")}, nil
+		case runtime.GetProperties:
+			return properties[params["objectId"].(string)], nil
+		case runtime.ReleaseObjectGroup:
+			mu.Lock()
+			released = append(released, params["objectGroup"])
+			mu.Unlock()
+		case runtime.ReleaseObject:
+			mu.Lock()
+			released = append(released, params["objectId"])
+			mu.Unlock()
+		}
+		return map[string]interface{}{}, nil
+	}))
+	v, err := tab.Inspect("window.state", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, _ := v.(map[string]interface{})
+	if f, ok := m["nan"].(float64); !ok || !math.IsNaN(f) {
+		t.Errorf("nan = %v", m["nan"])
+	}
+	if b, ok := m["big"].(*big.Int); !ok || b.Int64() != 12 {
+		t.Errorf("big = %v", m["big"])
+	}
+	delete(m, "nan")
+	delete(m, "big")
+	expected := map[string]interface{}{
+		"name": "百度",
+		"when": "Mon Oct 19 2026",
+		"list": []interface{}{nil, float64(2)},
+		"tags": map[string]interface{}{"hot": true},
+		"deep": map[string]interface{}{"x": map[string]interface{}{"y": float64(3)}},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("Inspect() = %#v", m)
+	}
+	mu.Lock()
+	if group == nil || len(released) != 1 || released[0] != group {
+		t.Errorf("released %v", released)
+	}
+	released = nil
+	mu.Unlock()
+
+	v, err = tab.Convert(runtime.RemoteObject{Type: "object", Subtype: "array", ObjectId: "arr"}, -1)
+	if err != nil || !reflect.DeepEqual(v, []interface{}{nil, float64(2)}) {
+		t.Errorf("Convert() = %#v, %v", v, err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(released) != 0 {
+		t.Errorf("released %v", released)
+	}
+}
+
+func TestPreviewValue(t *testing.T) {
+	preview := runtime.ObjectPreview{Type: "object", Subtype: "map", Entries: []*runtime.EntryPreview{
+		{Key: runtime.ObjectPreview{Type: "number", Description: "1"}, Value: runtime.ObjectPreview{Type: "string", Description: "one"}},
+	}}
+	if v := previewValue(preview); !reflect.DeepEqual(v, map[string]interface{}{"1": "one"}) {
+		t.Errorf("previewValue() = %#v", v)
+	}
+	preview = runtime.ObjectPreview{Type: "object", Subtype: "array", Properties: []*runtime.PropertyPreview{
+		{Name: "0", Type: "number", Value: "Infinity"},
+		{Name: "1", Type: "object", Value: "Object", ValuePreview: runtime.ObjectPreview{Type: "object",
+			Properties: []*runtime.PropertyPreview{{Name: "ok", Type: "boolean", Value: "true"}}}},
+	}}
+	v := previewValue(preview)
+	if list, ok := v.([]interface{}); !ok || len(list) != 2 || !math.IsInf(list[0].(float64), 1) ||
+		!reflect.DeepEqual(list[1], map[string]interface{}{"ok": true}) {
+		t.Errorf("previewValue() = %#v", v)
+	}
+}
@@ -0,0 +1,148 @@
+package cuto
+
+import (
+	"strings"
+
+	"github.com/diiyw/cuto/protocol/dom"
+)
+
+// 选择器的引擎前缀，无前缀时为CSS
+var selectorEngines = []string{"css=", "xpath=", "text=", "id="}
+
+// queryAllScript returns the elements under root matching a selector,
+// the engine is picked by its prefix:
+//
+//	css=    a CSS selector, the default without prefix
+//	xpath=  an XPath expression evaluated from root
+//	text=   the innermost elements containing the text, ignoring case and
+//	        extra whitespace; a quoted text matches the whole text exactly
+//	id=     the elements with the id
+//
+// Except XPath, the engines also look into open shadow roots.
+const queryAllScript = `(selector, root) => {
+	const m = /^(css|xpath|text|id)=/.exec(selector);
+	const engine = m ? m[1] : 'css';
+	const body = m ? selector.slice(m[0].length) : selector;
+	if (engine === 'xpath') {
+		const result = [];
+		const r = document.evaluate(body, root, null, XPathResult.ORDERED_NODE_SNAPSHOT_TYPE, null);
+		for (let i = 0; i < r.snapshotLength; i++) result.push(r.snapshotItem(i));
+		return result.filter(n => n.nodeType === Node.ELEMENT_NODE);
+	}
+	const scopes = [];
+	const walk = scope => {
+		scopes.push(scope);
+		for (const e of scope.querySelectorAll('*')) if (e.shadowRoot) walk(e.shadowRoot);
+	};
+	walk(root);
+	if (root.shadowRoot) walk(root.shadowRoot);
+	const all = () => scopes.flatMap(s => Array.from(s.querySelectorAll('*')));
+	switch (engine) {
+	case 'css':
+		return Array.from(new Set(scopes.flatMap(s => Array.from(s.querySelectorAll(body)))));
+	case 'id':
+		return all().filter(e => e.id === body);
+	case 'text': {
+		const norm = s => (s || '').replace(/\s+/g, ' ').trim().toLowerCase();
+		const exact = body.length > 1 && body[0] === '"' && body[body.length - 1] === '"';
+		const text = norm(exact ? body.slice(1, -1) : body);
+		const matches = e => exact ? norm(e.textContent) === text : norm(e.textContent).includes(text);
+		return all().filter(e => matches(e) && !Array.from(e.children).some(c => matches(c)));
+	}
+	}
+}`
+
+// queryFunction returns the elements of the frame matching a selector.
+const queryFunction = "function(s){return (" + queryAllScript + ")(s, document)}"
+
+// queryFirstFunction returns the first element of the frame matching a
+// selector, in an array which is empty when there is none.
+const queryFirstFunction = "function(s){return (" + queryAllScript + ")(s, document).slice(0, 1)}"
+
+// queryElementFunction returns the elements under the element this.
+const queryElementFunction = "function(s){return (" + queryAllScript + ")(s, this)}"
+
+// hasEngine reports whether the selector names its engine.
+func hasEngine(selector string) bool {
+	for _, prefix := range selectorEngines {
+		if strings.HasPrefix(selector, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// 查询主框架中匹配选择器的节点，选择器见Frame.Query
+func (tab *Tab) Query(selector string) ([]*dom.NodeId, error) {
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	return main.Query(selector)
+}
+
+// 使用开发者工具的搜索查询节点，query可以是文本、CSS选择器或XPath
+func (tab *Tab) Search(query string) ([]*dom.NodeId, error) {
+	ctx, cancel := tab.context()
+	defer cancel()
+	main, err := tab.MainFrame()
+	if err != nil {
+		return nil, err
+	}
+	// 节点ID只在获取文档后下发
+	if err := main.requestDocument(ctx); err != nil {
+		return nil, err
+	}
+	// 开始搜素节点
+	searchResult, err := Do(ctx, tab, dom.PerformSearchCommand, dom.PerformSearchParams{
+		Query:                     query,
+		IncludeUserAgentShadowDOM: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer Do(ctx, tab, dom.DiscardSearchResultsCommand, dom.DiscardSearchResultsParams{SearchId: searchResult.SearchId})
+	if searchResult.ResultCount == 0 {
+		return nil, nil
+	}
+	// 获取节点结果
+	getResult, err := Do(ctx, tab, dom.GetSearchResultsCommand, dom.GetSearchResultsParams{
+		SearchId:  searchResult.SearchId,
+		FromIndex: 0,
+		ToIndex:   searchResult.ResultCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return getResult.NodeIds, nil
+}
+
+// 查询框架中匹配选择器的节点，选择器可带引擎前缀css=、xpath=、text=、id=，
+// 无前缀时为CSS，除XPath外都会查询开放的shadow root
+func (f *Frame) Query(selector string) ([]*dom.NodeId, error) {
+	elements, err := f.Elements(selector)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, e := range elements {
+			_ = e.Release()
+		}
+	}()
+	ctx, cancel := f.tab.context()
+	defer cancel()
+	if err := f.requestDocument(ctx); err != nil {
+		return nil, err
+	}
+	ctx = f.route(ctx)
+	var nodes = make([]*dom.NodeId, 0, len(elements))
+	for _, e := range elements {
+		result, err := Do(ctx, f.tab, dom.RequestNodeCommand, dom.RequestNodeParams{ObjectId: e.object})
+		if err != nil {
+			return nil, err
+		}
+		id := result.NodeId
+		nodes = append(nodes, &id)
+	}
+	return nodes, nil
+}
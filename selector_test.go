@@ -0,0 +1,103 @@
+package cuto
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestQuery(t *testing.T) {
+	var mu sync.Mutex
+	var released, discarded []interface{}
+	var documents int
+	tab := devtools(t, &Browser{}, mainFrameHandler(func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case runtime.CallFunctionOn:
+			arguments := params["arguments"].([]interface{})
+			if params["functionDeclaration"] != queryFunction || arguments[0].(map[string]interface{})["value"] != "text=百度一下" {
+				return nil, &RemoteError{Code: -32602, Message: "Invalid parameters"}
+			}
+			return map[string]interface{}{"result": map[string]interface{}{"type": "object", "subtype": "array", "objectId": "array"}}, nil
+		case runtime.GetProperties:
+			return map[string]interface{}{"result": []interface{}{
+				map[string]interface{}{"name": "1", "value": map[string]interface{}{"type": "object", "subtype": "node", "objectId": "span"}},
+				map[string]interface{}{"name": "0", "value": map[string]interface{}{"type": "object", "subtype": "node", "objectId": "button"}},
+				map[string]interface{}{"name": "length", "value": map[string]interface{}{"type": "number", "value": 2}},
+			}}, nil
+		case dom.GetDocument:
+			documents++
+		case dom.RequestNode:
+			return map[string]interface{}{"nodeId": map[string]int{"button": 5, "span": 9}[params["objectId"].(string)]}, nil
+		case runtime.ReleaseObject:
+			released = append(released, params["objectId"])
+		case dom.PerformSearch:
+			if params["query"] != "百度" {
+				return map[string]interface{}{"searchId": "s2", "resultCount": 0}, nil
+			}
+			return map[string]interface{}{"searchId": "s1", "resultCount": 1}, nil
+		case dom.GetSearchResults:
+			return map[string]interface{}{"nodeIds": []int{7}}, nil
+		case dom.DiscardSearchResults:
+			discarded = append(discarded, params["searchId"])
+		}
+		return map[string]interface{}{}, nil
+	}))
+	nodes, err := tab.Query("text=百度一下")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 2 || *nodes[0] != 5 || *nodes[1] != 9 {
+		t.Errorf("Query() = %v", nodes)
+	}
+	nodes, err = tab.Search("百度")
+	if err != nil || len(nodes) != 1 || *nodes[0] != 7 {
+		t.Errorf("Search() = %v, %v", nodes, err)
+	}
+	if nodes, err = tab.Search("nothing"); err != nil || len(nodes) != 0 {
+		t.Errorf("Search() = %v, %v", nodes, err)
+	}
+	// 再次获取文档会使已下发的节点ID失效
+	mu.Lock()
+	if documents != 1 {
+		t.Errorf("document requested %d times", documents)
+	}
+	mu.Unlock()
+	tab.emit("", dom.DocumentUpdatedEvent, nil)
+	if _, err := tab.Query("text=百度一下"); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if documents != 2 {
+		t.Errorf("document requested %d times after it was updated", documents)
+	}
+	if !reflect.DeepEqual(released, []interface{}{"array", "button", "span", "array", "button", "span"}) {
+		t.Errorf("released %v", released)
+	}
+	if !reflect.DeepEqual(discarded, []interface{}{"s1", "s2"}) {
+		t.Errorf("discarded %v", discarded)
+	}
+}
+
+func TestSelectorEngines(t *testing.T) {
+	for selector, expected := range map[string]bool{
+		"#kw": false, "xpath=//input": true, "text=百度": true, "id=kw": true, "css=a": true, "button[type=submit]": false,
+	} {
+		if hasEngine(selector) != expected {
+			t.Errorf("hasEngine(%q) = %v", selector, !expected)
+		}
+	}
+	l := (&Locator{}).Locator("xpath=//form").Locator("input").ByText("百度")
+	if s := l.String(); s != "xpath=//form >> css=input >> text=百度" {
+		t.Errorf("String() = %q", s)
+	}
+	if !strings.Contains(elementFunction("return e"), queryAllScript) || !strings.Contains(locateScript, queryAllScript) {
+		t.Error("selector engine not shared")
+	}
+}
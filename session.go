@@ -0,0 +1,128 @@
+package cuto
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+	"github.com/diiyw/cuto/protocol/target"
+)
+
+// 会话在context中的键
+type sessionKey struct{}
+
+// withSession sends the commands run with ctx to a child session of the
+// tab, the root session is used by default.
+func withSession(ctx context.Context, sid target.SessionID) context.Context {
+	if sid == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, sessionKey{}, sid)
+}
+
+func sessionOf(ctx context.Context) target.SessionID {
+	sid, _ := ctx.Value(sessionKey{}).(target.SessionID)
+	return sid
+}
+
+// session attached to the tab, e.g. of an out-of-process iframe
+type session struct {
+	parent target.SessionID
+	// 根框架，即iframe本身
+	frame page.FrameId
+	offs  []func()
+}
+
+// autoAttach makes the browser attach related targets of a session to the
+// tab. New targets are paused until they are set up.
+func (tab *Tab) autoAttach(ctx context.Context, sid target.SessionID) error {
+	_, err := Do(withSession(ctx, sid), tab, target.SetAutoAttachCommand, target.SetAutoAttachParams{
+		AutoAttach:             true,
+		WaitForDebuggerOnStart: true,
+		Flatten:                true,
+	})
+	return err
+}
+
+func (tab *Tab) attachedToTarget(parent target.SessionID, params json.RawMessage) {
+	var attached target.AttachedToTargetParams
+	if err := json.Unmarshal(params, &attached); err != nil {
+		return
+	}
+	sid := attached.SessionId
+	var s = &session{parent: parent}
+	if attached.TargetInfo.Type == "iframe" {
+		// 在读取连接的协程中注册，不会漏掉子会话的事件
+		s.frame = page.FrameId(attached.TargetInfo.TargetId)
+		s.offs = tab.watchSession(sid)
+	}
+	tab.frames.mu.Lock()
+	tab.frames.sessions[sid] = s
+	tab.frames.mu.Unlock()
+	go func() {
+		ctx, cancel := tab.context()
+		defer cancel()
+		ctx = withSession(ctx, sid)
+		if s.offs != nil {
+			_ = tab.setupFrameSession(ctx, sid)
+		}
+		// 其他类型的目标(如worker)不跟踪，恢复运行即可
+		_, _ = Do(ctx, tab, runtime.RunIfWaitingForDebuggerCommand, runtime.RunIfWaitingForDebuggerParams{})
+	}()
+}
+
+// setupFrameSession tracks the frames of an out-of-process iframe, which
+// belong to the child session from then on.
+func (tab *Tab) setupFrameSession(ctx context.Context, sid target.SessionID) error {
+	if _, err := Do(ctx, tab, page.EnableCommand, page.EnableParams{}); err != nil {
+		return err
+	}
+	if err := tab.loadFrameTree(ctx, sid); err != nil {
+		return err
+	}
+	if _, err := Do(ctx, tab, runtime.EnableCommand, runtime.EnableParams{}); err != nil {
+		return err
+	}
+	return tab.autoAttach(ctx, sid)
+}
+
+func (tab *Tab) detachedFromTarget(params json.RawMessage) {
+	var detached target.DetachedFromTargetParams
+	if err := json.Unmarshal(params, &detached); err != nil {
+		return
+	}
+	tab.frames.mu.Lock()
+	defer tab.frames.mu.Unlock()
+	tab.detachSessionLocked(detached.SessionId)
+	tab.notifyLocked()
+}
+
+// detachSessionLocked gives the root frame of a session back to the parent
+// session, the iframe stays in the parent document, e.g. when it navigates
+// to the same origin again. The other frames of the session are removed.
+func (tab *Tab) detachSessionLocked(sid target.SessionID) {
+	s, ok := tab.frames.sessions[sid]
+	if !ok {
+		return
+	}
+	delete(tab.frames.sessions, sid)
+	delete(tab.frames.documents, sid)
+	for _, off := range s.offs {
+		off()
+	}
+	for child, cs := range tab.frames.sessions {
+		if cs.parent == sid {
+			tab.detachSessionLocked(child)
+		}
+	}
+	if f, ok := tab.frames.all[s.frame]; ok && f.session == sid {
+		f.session = s.parent
+		f.resetContexts()
+	}
+	for _, f := range tab.frames.all {
+		if f.session == sid {
+			tab.removeFrameLocked(f.id)
+		}
+	}
+}
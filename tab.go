@@ -1,7 +1,7 @@
 package cuto
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/diiyw/cuto/protocol/dom"
@@ -14,38 +14,12 @@ import (
 	math "math"
 	"path"
 	"strings"
+	"sync"
 	"time"
 )
 
-type (
-	Return struct {
-		Id     int
-		Result interface{}
-	}
-
-	Error struct {
-		Id    int
-		Error struct {
-			Code    int
-			Data    string
-			Message string
-		}
-	}
-
-	Event struct {
-		Method string
-		Params interface{}
-	}
-)
-
-type Channel struct {
-	*websocket.Conn
-
-	id      int
-	events  chan []byte
-	returns chan []byte
-	errors  chan []byte
-}
+// 命令默认超时时间
+const defaultTimeout = 15 * time.Second
 
 type Tab struct {
 	Id                   string  `json:"id"`
@@ -57,27 +31,47 @@ type Tab struct {
 
 	Channel              Channel `json:"-"`
 
-	debug bool `json:"-"`
+	debug        bool          `json:"-"`
+	validate     bool          `json:"-"`
+	interceptors []Interceptor `json:"-"`
+
+	events  events
+	domains domains
+	frames  frames
+	mouse       Mouse
+	keyboard    Keyboard
+	touchscreen Touchscreen
+	bindings    bindings
+	scripts     tabScripts
+
+	// 最后一次Send的结果
+	mu      sync.Mutex
+	last    json.RawMessage
+	lastErr error
 }
 
-func (tab *Tab) init(body io.Reader, debug bool) error {
-	tab.debug = debug
+func (tab *Tab) init(body io.Reader, b *Browser) error {
 	if err := json.NewDecoder(body).Decode(&tab); err != nil {
 		return err
 	}
+	return tab.connect(b)
+}
+
+// 连接调试地址
+func (tab *Tab) connect(b *Browser) error {
+	tab.debug = b.debug
+	tab.validate = b.validate
+	tab.interceptors = b.interceptors
+	tab.mouse.tab = tab
+	tab.keyboard.tab = tab
+	tab.touchscreen.tab = tab
 	conn, _, err := websocket.DefaultDialer.Dial(tab.WebSocketDebuggerUrl, nil)
 	if err != nil {
 		return err
 	}
-	tab.Channel.Conn = conn
-	tab.Channel.events = make(chan []byte, 1024)
-	tab.Channel.returns = make(chan []byte, 1024)
-	tab.Channel.errors = make(chan []byte, 1024)
-	_ = tab.Send(dom.Enable, dom.EnableParams{})
-	_ = tab.Send(page.Enable, page.EnableParams{})
-	_ = tab.Send(runtime.Enable, nil)
+	tab.Channel.init(conn, tab.debug)
 	go func() {
-		if err := tab.handle(); err != nil {
+		if err := tab.Channel.read(tab.dispatch); err != nil {
 			log.Println("Error:", err)
 			return
 		}
@@ -85,121 +79,80 @@ func (tab *Tab) init(body io.Reader, debug bool) error {
 	return nil
 }
 
-// 等待页面加载完成
-func (tab *Tab) Wait() error {
-	if err := tab.PollEvent(page.LoadEventFiredEvent, nil); err != nil {
-		return err
-	}
-	return nil
+// 默认超时的上下文
+func (tab *Tab) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), defaultTimeout)
 }
 
-// 跳转地址
-func (tab *Tab) Jump(url string) error {
-	if err := tab.Send(page.Navigate, page.NavigateParams{Url: url}); err != nil {
-		return err
-	}
-	var result = page.NavigateResult{}
-	if err := tab.GetResult(&result); err != nil {
-		return err
-	}
-	var frameNavigatedParams = page.FrameNavigatedParams{}
-	if err := tab.PollEvent(page.FrameNavigatedEvent, &frameNavigatedParams); err != nil {
+// 等待页面加载完成
+func (tab *Tab) Wait() error {
+	ctx, cancel := tab.context()
+	defer cancel()
+	release, err := tab.acquire(ctx, "Page")
+	if err != nil {
 		return err
 	}
-	var frameStoppedLoadingParams = page.FrameStoppedLoadingParams{}
-	if err := tab.PollEvent(page.FrameStoppedLoadingEvent, &frameStoppedLoadingParams); err != nil {
+	defer release()
+	events := tab.subscribe(page.LoadEventFiredEvent)
+	defer events.close()
+	// 已加载完成的页面不会再有事件
+	state, err := Do(ctx, tab, runtime.EvaluateCommand, runtime.EvaluateParams{
+		Expression:    "document.readyState",
+		ReturnByValue: true,
+	})
+	if err != nil {
 		return err
 	}
-	if frameNavigatedParams.Frame.Id == frameStoppedLoadingParams.FrameId {
+	if state.Result.Value == "complete" {
 		return nil
 	}
-	return errors.New("jump error")
+	_, err = events.next(ctx)
+	return err
 }
 
-// 查询节点
-func (tab *Tab) Query(selector string) ([]*dom.NodeId, error) {
-	// 初始化整个节点
-	if err := tab.Send(dom.GetDocument, dom.GetDocumentParams{}); err != nil {
-		return nil, err
-	}
-	var getDocument = dom.GetDocumentResult{}
-	if err := tab.GetResult(&getDocument); err != nil {
-		return nil, err
-	}
-	// 开始搜素节点
-	var searchParams = dom.PerformSearchParams{
-		Query:                     selector,
-		IncludeUserAgentShadowDOM: true,
-	}
-	if err := tab.Send(dom.PerformSearch, searchParams); err != nil {
-		return nil, err
-	}
-	var searchResult = dom.PerformSearchResult{}
-	if err := tab.GetResult(&searchResult); err != nil {
-		return nil, err
-	}
-	// 获取节点结果
-	var result = dom.GetSearchResultsParams{
-		SearchId:  searchResult.SearchId,
-		FromIndex: 0,
-		ToIndex:   searchResult.ResultCount,
-	}
-	if err := tab.Send(dom.GetSearchResults, result); err != nil {
-		return nil, err
-	}
-	var getResult = dom.GetSearchResultsResult{}
-	if err := tab.GetResult(&getResult); err != nil {
-		return nil, err
-	}
-	return getResult.NodeIds, nil
+// 跳转地址
+func (tab *Tab) Jump(url string) error {
+	_, err := tab.Navigate(url, WaitUntil(Load))
+	return err
 }
 
-// 输入值
+// 清空后用键盘输入值
 func (tab *Tab) Input(selector, v string) error {
-	if _, err := tab.Js("document.querySelector('"+selector+"').value=\""+v+"\"", 1000); err != nil {
-		return nil
+	e, err := tab.Element(selector)
+	if err != nil {
+		return err
 	}
-	return nil
+	defer e.Release()
+	return e.Input(v)
 }
 
 // 获取文本信息
 func (tab *Tab) Text(selector string) string {
-	if obj, err := tab.Js("document.querySelector('"+selector+"').textContent", 1000); err != nil {
-		return obj.Value.(string)
+	var text string
+	if obj, err := tab.helper(elementFunction("return e.textContent"), selector); err == nil {
+		_ = decodeValue(obj, &text)
 	}
-	return ""
+	return text
 }
 
 // 元素值
 func (tab *Tab) Value(selector string) string {
-	if obj, err := tab.Js("document.querySelector('"+selector+"').value", 1000); err != nil {
-		return obj.Value.(string)
+	var value string
+	if obj, err := tab.helper(elementFunction("return e.value"), selector); err == nil {
+		_ = decodeValue(obj, &value)
 	}
-	return ""
+	return value
 }
 
-// 选择
+// 勾选或取消勾选复选框、单选框，触发input和change事件，取消勾选单选框时清除其选中状态
 func (tab *Tab) Check(selector string, checked bool) error {
-	str := "false"
-	if checked {
-		str = "true"
-	}
-	obj, err := tab.Js("document.querySelector('"+selector+"').checked = "+str, 1000)
-	if err != nil {
-		return err
-	}
-	if obj.Type == "boolean" && obj.Value.(bool) == checked {
-		return nil
-	}
-	return nil
+	_, err := tab.helper(checkFunction, selector, checked)
+	return err
 }
 
-// 下拉框
+// 按值或显示的文字选择下拉框的选项
 func (tab *Tab) Select(selector, v string) error {
-	var expression = "var selector = document.querySelector('" + selector + "');" +
-		";for(var i = 0; i <selector.length; i++){" +
-		"if(selector[i].value == \"" + v + "\"){selector[i] = true;return;}}"
-	_, err := tab.Js(expression, 1000)
+	_, err := tab.helper(elementFunction("("+setControlScript+")([e], a[0])"), selector, v)
 	if err != nil {
 		return err
 	}
@@ -208,41 +161,50 @@ func (tab *Tab) Select(selector, v string) error {
 
 // 元素点击
 func (tab *Tab) Click(selector string) error {
-	if _, err := tab.Js("document.querySelector('"+selector+"').click()", 1000); err != nil {
+	e, err := tab.Element(selector)
+	if err != nil {
 		return err
 	}
-	return nil
+	defer e.Release()
+	return e.Click()
 }
 
-// 运行Javascript
-func (tab *Tab) Js(js string, timeout runtime.TimeDelta) (object runtime.RemoteObject, err error) {
-	var rParams = runtime.EvaluateParams{
+// 运行Javascript，默认在页面环境中运行
+func (tab *Tab) Js(js string, timeout runtime.TimeDelta, options ...EvalOption) (object runtime.RemoteObject, err error) {
+	var o = newEvalOptions(options)
+	ctx, cancel := tab.context()
+	defer cancel()
+	var params = runtime.EvaluateParams{
 		Expression:            js,
 		IncludeCommandLineAPI: true,
 		Timeout:               timeout,
 	}
-	if err := tab.Send(runtime.Evaluate, rParams); err != nil {
-		return object, err
+	if o.world == IsolatedWorld {
+		main, err := tab.MainFrame()
+		if err != nil {
+			return object, err
+		}
+		if params.ContextId, err = main.executionContext(ctx, IsolatedWorld); err != nil {
+			return object, err
+		}
 	}
-	var evalResult = runtime.EvaluateResult{}
-	if err := tab.GetResult(&evalResult); err != nil {
+	evalResult, err := Do(ctx, tab, runtime.EvaluateCommand, params)
+	if err != nil {
 		return object, err
 	}
-	return evalResult.Result, nil
+	return evalResult.Result, exceptionError(evalResult.ExceptionDetails)
 }
 
 // 页面刷新
 func (tab *Tab) Refresh() error {
-	var reload = page.ReloadParams{}
-	if err := tab.Send(page.Reload, reload); err != nil {
-		return err
-	}
-	return tab.Wait()
+	return tab.Reload()
 }
 
 // 关闭标签
 func (tab *Tab) Close() error {
-	if err := tab.Send(page.Close, page.CloseParams{}); err != nil {
+	ctx, cancel := tab.context()
+	defer cancel()
+	if _, err := Do(ctx, tab, page.CloseCommand, page.CloseParams{}); err != nil {
 		return err
 	}
 	err := tab.Channel.Close()
@@ -254,16 +216,14 @@ func (tab *Tab) Close() error {
 
 // 页面截图
 func (tab *Tab) Capture(filename string, quality int, viewport page.Viewport) error {
-	var capture = page.CaptureScreenshotParams{
+	ctx, cancel := tab.context()
+	defer cancel()
+	captureScreenshotResult, err := Do(ctx, tab, page.CaptureScreenshotCommand, page.CaptureScreenshotParams{
 		Format:  strings.Trim(path.Ext(filename), "."),
 		Clip:    viewport,
 		Quality: quality,
-	}
-	if err := tab.Send(page.CaptureScreenshot, capture); err != nil {
-		return err
-	}
-	var captureScreenshotResult = page.CaptureScreenshotResult{}
-	if err := tab.GetResult(&captureScreenshotResult); err != nil {
+	})
+	if err != nil {
 		return err
 	}
 	return ioutil.WriteFile(filename, captureScreenshotResult.Data, 0777)
@@ -275,13 +235,12 @@ func (tab *Tab) DOMCapture(filename string, quality int, selector string) error
 	if err != nil || len(nodes) == 0 {
 		return err
 	}
-	if err := tab.Send(dom.GetBoxModel, dom.GetBoxModelParams{
+	ctx, cancel := tab.context()
+	defer cancel()
+	box, err := Do(ctx, tab, dom.GetBoxModelCommand, dom.GetBoxModelParams{
 		NodeId: *nodes[len(nodes)-1],
-	}); err != nil {
-		return err
-	}
-	var box dom.GetBoxModelResult
-	if err := tab.GetResult(&box); err != nil {
+	})
+	if err != nil {
 		return err
 	}
 	return tab.Capture(filename, quality, page.Viewport{
@@ -299,11 +258,10 @@ func (tab *Tab) DOMCapture(filename string, quality int, selector string) error
 
 // 获取整个页面的截图
 func (tab *Tab) FullCapture(filename string, quality int) error {
-	if err := tab.Send(page.GetLayoutMetrics, page.GetLayoutMetricsParams{}); err != nil {
-		return err
-	}
-	var metric page.GetLayoutMetricsResult
-	if err := tab.GetResult(&metric); err != nil {
+	ctx, cancel := tab.context()
+	defer cancel()
+	metric, err := Do(ctx, tab, page.GetLayoutMetricsCommand, page.GetLayoutMetricsParams{})
+	if err != nil {
 		return err
 	}
 	width, height := math.Ceil(metric.ContentSize.Width), math.Ceil(metric.ContentSize.Height)
@@ -316,86 +274,55 @@ func (tab *Tab) FullCapture(filename string, quality int) error {
 	})
 }
 
-// 发起命令
+// 发起命令，结果由GetResult获取
 func (tab *Tab) Send(method string, params interface{}) error {
-	tab.Channel.id++
-	var request = map[string]interface{}{
-		"id":     tab.Channel.id,
-		"method": method,
-		"params": params,
-	}
-	data, _ := json.Marshal(request)
-	if tab.debug {
-		log.Println("Send:", string(data))
-	}
-	if err := tab.Channel.WriteJSON(request); err != nil {
-		return err
-	}
-	return nil
+	ctx, cancel := tab.context()
+	defer cancel()
+	result, err := tab.invoke(ctx, method, params)
+	tab.mu.Lock()
+	tab.last, tab.lastErr = result, err
+	tab.mu.Unlock()
+	return err
 }
 
-func (tab *Tab) handle() error {
-	for {
-		_, b, err := tab.Channel.ReadMessage()
-		if err != nil {
-			return err
-		}
-		// Error
-		if bytes.Contains(b, []byte(`"error"`)) {
-			if tab.debug {
-				log.Println("Error:", string(b))
-			}
-			tab.Channel.errors <- b
-			continue
-		}
-		// Event
-		if bytes.Contains(b, []byte(`"params"`)) && bytes.Contains(b, []byte(`"method"`)) {
-			tab.Channel.events <- b
-			continue
-		}
-		// Result
-		tab.Channel.returns <- b
+// 发起命令并等待结果
+func (tab *Tab) call(ctx context.Context, method string, params, result interface{}) error {
+	data, err := tab.invoke(ctx, method, params)
+	if err != nil {
+		return err
+	}
+	if result == nil || len(data) == 0 {
+		return nil
 	}
+	return json.Unmarshal(data, result)
 }
 
+// 获取最后一次Send的结果
 func (tab *Tab) GetResult(returns interface{}) error {
-	timeout := time.After(time.Second * 15)
-	for {
-		select {
-		case b := <-tab.Channel.returns:
-			if tab.debug {
-				log.Println("Result:", string(b))
-			}
-			var ret = Return{Result: returns}
-			err := json.Unmarshal(b, &ret)
-			if err == nil {
-				if ret.Id == tab.Channel.id {
-					return nil
-				}
-			}
-		case <-timeout:
-			return errors.New("Handle result timeout ")
-		}
+	tab.mu.Lock()
+	data, err := tab.last, tab.lastErr
+	tab.last, tab.lastErr = nil, nil
+	tab.mu.Unlock()
+	if err != nil {
+		return err
 	}
+	if data == nil {
+		return errors.New("cuto: no command sent")
+	}
+	return json.Unmarshal(data, returns)
 }
 
+// 取出最早收到的未取出的事件，没有时等待下一个。连接后每种事件保留最新的128个，
+// 事件所属的域须已启用，如EnableDomain("Page")
 func (tab *Tab) PollEvent(method string, params interface{}) error {
-	timeout := time.After(time.Second * 15)
-	for {
-		select {
-		case b := <-tab.Channel.events:
-			if tab.debug {
-				log.Println("Event:", string(b))
-			}
-			var event = Event{Params: params}
-			err := json.Unmarshal(b, &event)
-			if err == nil {
-				if event.Method == method {
-					return nil
-				}
-			}
-		case <-timeout:
-			return errors.New("Handle " + method + " timeout.")
-		}
+	ctx, cancel := tab.context()
+	defer cancel()
+	data, err := tab.poll(ctx, method)
+	if err != nil {
+		return errors.New("Handle " + method + " timeout.")
+	}
+	if params == nil {
+		return nil
 	}
+	return json.Unmarshal(data, params)
 }
@@ -21,7 +21,7 @@ func TestTab(t *testing.T) {
 	if err := tab.Wait(); err != nil {
 		log.Println(err)
 	}
-	nodes, err := tab.Query("//*[@id=\"kw\"]")
+	nodes, err := tab.Query("xpath=//*[@id=\"kw\"]")
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -36,7 +36,10 @@ func TestTab(t *testing.T) {
 	if err := tab.Click("#su"); err != nil {
 		log.Println(err)
 	}
-	time.Sleep(5 * time.Second)
+	// 等待搜索结果
+	if err := tab.Locator("#content_left").Wait(); err != nil {
+		log.Fatal(err)
+	}
 }
 
 func TestTabJump(t *testing.T) {
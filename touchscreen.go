@@ -0,0 +1,168 @@
+package cuto
+
+import (
+	"context"
+	"sync"
+
+	"github.com/diiyw/cuto/protocol/emulation"
+	"github.com/diiyw/cuto/protocol/input"
+)
+
+// 支持的最大触点数
+const maxTouchPoints = 5
+
+// Touch is a finger on the touchscreen, Id tells fingers apart within a
+// sequence.
+type Touch struct {
+	Id   int
+	X, Y float64
+}
+
+// Touchscreen emulates touch input, coordinates are CSS pixels relative to
+// the viewport of the main frame. Touch emulation is enabled on first use.
+type Touchscreen struct {
+	tab *Tab
+
+	start   sync.Mutex
+	enabled bool
+
+	mu      sync.Mutex
+	touches []Touch
+}
+
+// 标签的触摸屏
+func (tab *Tab) Touchscreen() *Touchscreen {
+	return &tab.touchscreen
+}
+
+func (t *Touchscreen) enable(ctx context.Context) error {
+	t.start.Lock()
+	defer t.start.Unlock()
+	if t.enabled {
+		return nil
+	}
+	_, err := Do(ctx, t.tab, emulation.SetTouchEmulationEnabledCommand, emulation.SetTouchEmulationEnabledParams{
+		Enabled:        true,
+		MaxTouchPoints: maxTouchPoints,
+	})
+	t.enabled = err == nil
+	return err
+}
+
+func (t *Touchscreen) tap(ctx context.Context, x, y float64) error {
+	if err := t.enable(ctx); err != nil {
+		return err
+	}
+	_, err := Do(ctx, t.tab, input.SynthesizeTapGestureCommand, input.SynthesizeTapGestureParams{
+		X:                 x,
+		Y:                 y,
+		GestureSourceType: "touch",
+	})
+	return err
+}
+
+// 轻触
+func (t *Touchscreen) Tap(x, y float64) error {
+	ctx, cancel := t.tab.context()
+	defer cancel()
+	return t.tap(ctx, x, y)
+}
+
+// 从(x, y)滑动手指(dx, dy)，页面随之反向滚动
+func (t *Touchscreen) Swipe(x, y, dx, dy float64) error {
+	ctx, cancel := t.tab.context()
+	defer cancel()
+	if err := t.enable(ctx); err != nil {
+		return err
+	}
+	_, err := Do(ctx, t.tab, input.SynthesizeScrollGestureCommand, input.SynthesizeScrollGestureParams{
+		X:                 x,
+		Y:                 y,
+		XDistance:         dx,
+		YDistance:         dy,
+		GestureSourceType: "touch",
+	})
+	return err
+}
+
+// 以(x, y)为中心双指缩放，scale大于1放大
+func (t *Touchscreen) Pinch(x, y, scale float64) error {
+	ctx, cancel := t.tab.context()
+	defer cancel()
+	if err := t.enable(ctx); err != nil {
+		return err
+	}
+	_, err := Do(ctx, t.tab, input.SynthesizePinchGestureCommand, input.SynthesizePinchGestureParams{
+		X:                 x,
+		Y:                 y,
+		ScaleFactor:       scale,
+		GestureSourceType: "touch",
+	})
+	return err
+}
+
+// dispatch sends a touch event with the touches on the screen.
+func (t *Touchscreen) dispatch(event string, touches []Touch) error {
+	ctx, cancel := t.tab.context()
+	defer cancel()
+	if err := t.enable(ctx); err != nil {
+		return err
+	}
+	var points = make([]*input.TouchPoint, 0, len(touches))
+	for _, touch := range touches {
+		points = append(points, &input.TouchPoint{X: touch.X, Y: touch.Y, Id: float64(touch.Id)})
+	}
+	_, err := Do(ctx, t.tab, input.DispatchTouchEventCommand, input.DispatchTouchEventParams{
+		Type:        event,
+		TouchPoints: points,
+		Modifiers:   int(t.tab.keyboard.current()),
+	})
+	return err
+}
+
+// 手指按下，已按下的手指保持不动
+func (t *Touchscreen) Start(touches ...Touch) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	active := append(append([]Touch(nil), t.touches...), touches...)
+	if err := t.dispatch("touchStart", active); err != nil {
+		return err
+	}
+	t.touches = active
+	return nil
+}
+
+// 移动手指，Id相同的手指移动到新位置
+func (t *Touchscreen) Move(touches ...Touch) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	active := append([]Touch(nil), t.touches...)
+	for _, touch := range touches {
+		for i := range active {
+			if active[i].Id == touch.Id {
+				active[i] = touch
+			}
+		}
+	}
+	if err := t.dispatch("touchMove", active); err != nil {
+		return err
+	}
+	t.touches = active
+	return nil
+}
+
+// 抬起所有手指
+func (t *Touchscreen) End() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.touches = nil
+	return t.dispatch("touchEnd", nil)
+}
+
+// 取消触摸
+func (t *Touchscreen) Cancel() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.touches = nil
+	return t.dispatch("touchCancel", nil)
+}
@@ -0,0 +1,53 @@
+package cuto
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/emulation"
+	"github.com/diiyw/cuto/protocol/input"
+)
+
+func TestTouchscreen(t *testing.T) {
+	var mu sync.Mutex
+	var commands []string
+	tab := devtools(t, &Browser{}, func(emit func(string, interface{}), method string, params map[string]interface{}) (interface{}, *RemoteError) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case input.DispatchTouchEvent:
+			points, _ := json.Marshal(params["touchPoints"])
+			commands = append(commands, params["type"].(string)+" "+string(points))
+		default:
+			commands = append(commands, method)
+		}
+		return map[string]interface{}{}, nil
+	})
+	touchscreen := tab.Touchscreen()
+	if err := touchscreen.Tap(10, 20); err != nil {
+		t.Fatal(err)
+	}
+	if err := touchscreen.Start(Touch{Id: 1, X: 10, Y: 10}, Touch{Id: 2, X: 50, Y: 50}); err != nil {
+		t.Fatal(err)
+	}
+	if err := touchscreen.Move(Touch{Id: 2, X: 80, Y: 90}); err != nil {
+		t.Fatal(err)
+	}
+	if err := touchscreen.End(); err != nil {
+		t.Fatal(err)
+	}
+	var expected = []string{
+		emulation.SetTouchEmulationEnabled,
+		input.SynthesizeTapGesture,
+		`touchStart [{"id":1,"x":10,"y":10},{"id":2,"x":50,"y":50}]`,
+		`touchMove [{"id":1,"x":10,"y":10},{"id":2,"x":80,"y":90}]`,
+		`touchEnd []`,
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !reflect.DeepEqual(commands, expected) {
+		t.Errorf("sent\n%q\nexpected\n%q", commands, expected)
+	}
+}
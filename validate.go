@@ -0,0 +1,141 @@
+package cuto
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/diiyw/cuto/protocol/cdp"
+)
+
+// ValidationError reports params rejected by the protocol schema before
+// they were sent to the browser.
+type ValidationError struct {
+	Method string
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return "cuto: " + e.Method + ": " + e.Reason
+	}
+	return "cuto: " + e.Method + ": param " + e.Field + " " + e.Reason
+}
+
+// validate checks params of a command against the schema generated from
+// protocol.json: required fields, enum membership and the types of values
+// and array items.
+func validate(method string, params interface{}) error {
+	schema, ok := cdp.Commands[method]
+	if !ok {
+		return &ValidationError{Method: method, Reason: "unknown command"}
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return &ValidationError{Method: method, Reason: err.Error()}
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return &ValidationError{Method: method, Reason: err.Error()}
+	}
+	if value == nil {
+		value = map[string]interface{}{}
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return &ValidationError{Method: method, Reason: "params must be an object"}
+	}
+	if field, reason := checkProperties("", schema, object); reason != "" {
+		return &ValidationError{Method: method, Field: field, Reason: reason}
+	}
+	return nil
+}
+
+func checkProperties(path string, properties []cdp.Param, object map[string]interface{}) (string, string) {
+	for _, p := range properties {
+		field := p.Name
+		if path != "" {
+			field = path + "." + p.Name
+		}
+		v, ok := object[p.Name]
+		if !ok {
+			if !p.Optional {
+				return field, "is required"
+			}
+			continue
+		}
+		if field, reason := checkValue(field, p, v); reason != "" {
+			return field, reason
+		}
+	}
+	return "", ""
+}
+
+func checkValue(field string, p cdp.Param, v interface{}) (string, string) {
+	if p.Ref != "" {
+		t, ok := cdp.Types[p.Ref]
+		if !ok {
+			return "", ""
+		}
+		p = t
+	}
+	switch p.Type {
+	case "string", "binary":
+		s, ok := v.(string)
+		if !ok {
+			return field, "must be a string"
+		}
+		if len(p.Enum) != 0 && !contains(p.Enum, s) {
+			return field, fmt.Sprintf("must be one of %s, got %q", strings.Join(p.Enum, ", "), s)
+		}
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return field, "must be an integer"
+		}
+		if _, err := n.Int64(); err != nil {
+			return field, "must be an integer"
+		}
+	case "number":
+		if _, ok := v.(json.Number); !ok {
+			return field, "must be a number"
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return field, "must be a boolean"
+		}
+	case "object":
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return field, "must be an object"
+		}
+		return checkProperties(field, p.Properties, object)
+	case "array":
+		items, ok := v.([]interface{})
+		if !ok {
+			return field, "must be an array"
+		}
+		if p.Items == nil {
+			return "", ""
+		}
+		for i, item := range items {
+			if field, reason := checkValue(fmt.Sprintf("%s[%d]", field, i), *p.Items, item); reason != "" {
+				return field, reason
+			}
+		}
+	}
+	return "", ""
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package cuto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/diiyw/cuto/protocol/dom"
+	"github.com/diiyw/cuto/protocol/page"
+	"github.com/diiyw/cuto/protocol/runtime"
+)
+
+func TestValidate(t *testing.T) {
+	var tests = []struct {
+		method string
+		params interface{}
+		valid  bool
+		field  string
+	}{
+		{page.Navigate, page.NavigateParams{Url: "https://www.baidu.com"}, true, ""},
+		{runtime.Enable, nil, true, ""},
+		{page.Navigate, nil, false, "url"},
+		{page.Navigate, map[string]interface{}{"url": 1}, false, "url"},
+		{page.Navigate, page.NavigateParams{Url: "about:blank", TransitionType: "jump"}, false, "transitionType"},
+		{page.CaptureScreenshot, page.CaptureScreenshotParams{Format: "jpg"}, false, "format"},
+		{dom.GetSearchResults, map[string]interface{}{"searchId": "1", "fromIndex": 0.5, "toIndex": 1}, false, "fromIndex"},
+		{dom.PushNodesByBackendIdsToFrontend, map[string]interface{}{"backendNodeIds": []interface{}{1, "2"}}, false, "backendNodeIds[1]"},
+		{"Page.jump", nil, false, ""},
+	}
+	for _, test := range tests {
+		err := validate(test.method, test.params)
+		if test.valid {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.method, err)
+			}
+			continue
+		}
+		var v *ValidationError
+		if !errors.As(err, &v) {
+			t.Errorf("%s: expected validation error, got %v", test.method, err)
+			continue
+		}
+		if v.Method != test.method || v.Field != test.field {
+			t.Errorf("%s: error names %s %q, expected field %q", test.method, v.Method, v.Field, test.field)
+		}
+	}
+}
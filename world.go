@@ -0,0 +1,46 @@
+package cuto
+
+import "github.com/diiyw/cuto/protocol/runtime"
+
+// World is a JavaScript environment of a frame. Scripts of the page run
+// in the main world, the isolated world shares the DOM with the page but
+// not its globals, so page scripts cannot see or change cuto's helpers.
+type World int
+
+const (
+	MainWorld World = iota
+	IsolatedWorld
+)
+
+// 隔离环境的名称
+const utilityWorld = "__cuto_utility__"
+
+type EvalOption func(o *evalOptions)
+
+type evalOptions struct {
+	world   World
+	context runtime.ExecutionContextId
+}
+
+func newEvalOptions(options []EvalOption) evalOptions {
+	var o = evalOptions{world: MainWorld}
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
+
+// InWorld evaluates the script in the given world, MainWorld by default.
+func InWorld(world World) EvalOption {
+	return func(o *evalOptions) {
+		o.world = world
+	}
+}
+
+// InContext evaluates the script in the given execution context, e.g. one
+// reported by Runtime.executionContextCreated, instead of a world.
+func InContext(id runtime.ExecutionContextId) EvalOption {
+	return func(o *evalOptions) {
+		o.context = id
+	}
+}